@@ -2,23 +2,30 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	"github.com/runoshun/git-crew/v2/internal/domain"
 	"github.com/runoshun/git-crew/v2/internal/infra/config"
+	"github.com/runoshun/git-crew/v2/internal/infra/eventlog"
 	"github.com/runoshun/git-crew/v2/internal/infra/executor"
 	"github.com/runoshun/git-crew/v2/internal/infra/filestore"
 	"github.com/runoshun/git-crew/v2/internal/infra/git"
+	"github.com/runoshun/git-crew/v2/internal/infra/github"
 	"github.com/runoshun/git-crew/v2/internal/infra/gitstore"
 	"github.com/runoshun/git-crew/v2/internal/infra/jsonstore"
 	"github.com/runoshun/git-crew/v2/internal/infra/logging"
+	"github.com/runoshun/git-crew/v2/internal/infra/ptysession"
+	"github.com/runoshun/git-crew/v2/internal/infra/recording"
 	"github.com/runoshun/git-crew/v2/internal/infra/runner"
 	"github.com/runoshun/git-crew/v2/internal/infra/tmux"
 	"github.com/runoshun/git-crew/v2/internal/infra/worktree"
 	"github.com/runoshun/git-crew/v2/internal/usecase"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
 )
 
 // Config holds the application configuration paths.
@@ -51,6 +58,7 @@ type Container struct {
 	// Ports (interfaces bound to implementations)
 	Tasks            domain.TaskRepository
 	StoreInitializer domain.StoreInitializer
+	Events           domain.EventLog
 	Clock            domain.Clock
 	Git              domain.Git
 	Worktrees        domain.WorktreeManager
@@ -60,7 +68,7 @@ type Container struct {
 	Logger           domain.Logger
 	Runner           domain.ScriptRunner
 	Executor         domain.CommandExecutor
-	// GitHub    domain.GitHub          // TODO: implement in later phase
+	GitHub           domain.GitHub
 
 	// Configuration
 	Config Config
@@ -94,12 +102,6 @@ func NewWithWarningWriter(dir string, warningWriter io.Writer) (*Container, erro
 		appConfig = domain.NewDefaultConfig()
 	}
 
-	// Create task repository (file store)
-	namespace := resolveNamespace(appConfig, gitClient)
-	fileStore := filestore.New(cfg.CrewDir, namespace)
-	var taskRepo domain.TaskRepository = fileStore
-	var storeInit domain.StoreInitializer = fileStore
-
 	// Create logger
 	logLevel := logging.ParseLevel(appConfig.Log.Level)
 	logger := logging.New(cfg.CrewDir, logLevel)
@@ -107,8 +109,21 @@ func NewWithWarningWriter(dir string, warningWriter io.Writer) (*Container, erro
 	// Create worktree manager
 	worktreeClient := worktree.NewClient(cfg.RepoRoot, cfg.WorktreeDir)
 
+	// Create command executor
+	commandExecutor := executor.NewClient()
+
+	// Create task repository (file store) recording state changes in the event log
+	// and running the [hooks] commands they trigger
+	namespace := resolveNamespace(appConfig, gitClient)
+	fileStore := filestore.New(cfg.CrewDir, namespace)
+	events := eventlog.New(domain.EventsPath(cfg.CrewDir))
+	hooks := shared.NewHookRunner(configLoader, worktreeClient, commandExecutor, logger, cfg.RepoRoot, cfg.GitDir).
+		WithLauncher(detachedHookLauncher{})
+	var taskRepo domain.TaskRepository = eventlog.NewRepository(fileStore, events, domain.RealClock{}, logger, resolveEventActor(gitClient, namespace)).WithHandler(hooks)
+	var storeInit domain.StoreInitializer = fileStore
+
 	// Create session manager
-	sessionClient := tmux.NewClient(cfg.SocketPath, cfg.CrewDir)
+	sessionClient := newSessionManager(cfg, appConfig)
 
 	// Create config manager
 	configManager := config.NewManager(cfg.CrewDir, cfg.RepoRoot)
@@ -116,12 +131,13 @@ func NewWithWarningWriter(dir string, warningWriter io.Writer) (*Container, erro
 	// Create script runner
 	scriptRunner := runner.NewClient()
 
-	// Create command executor
-	commandExecutor := executor.NewClient()
+	// Create GitHub client (gh CLI)
+	githubClient := github.NewClient(commandExecutor, cfg.RepoRoot)
 
 	return &Container{
 		Tasks:            taskRepo,
 		StoreInitializer: storeInit,
+		Events:           events,
 		Clock:            domain.RealClock{},
 		Git:              gitClient,
 		Worktrees:        worktreeClient,
@@ -131,6 +147,7 @@ func NewWithWarningWriter(dir string, warningWriter io.Writer) (*Container, erro
 		Logger:           logger,
 		Runner:           scriptRunner,
 		Executor:         commandExecutor,
+		GitHub:           githubClient,
 		Config:           cfg,
 	}, nil
 }
@@ -156,7 +173,12 @@ func (c *Container) InitRepoUseCase() *usecase.InitRepo {
 
 // NewTaskUseCase returns a new NewTask use case.
 func (c *Container) NewTaskUseCase() *usecase.NewTask {
-	return usecase.NewNewTask(c.Tasks, c.Git, c.ConfigLoader, c.Clock, c.Logger)
+	return usecase.NewNewTask(c.Tasks, c.Git, c.ConfigLoader, c.Clock, c.Logger).WithGitHub(c.GitHub)
+}
+
+// ImportIssuesUseCase returns a new ImportIssues use case.
+func (c *Container) ImportIssuesUseCase() *usecase.ImportIssues {
+	return usecase.NewImportIssues(c.Tasks, c.Git, c.GitHub, c.ConfigLoader, c.Clock, c.Logger)
 }
 
 // CreateTasksFromFileUseCase returns a new CreateTasksFromFile use case.
@@ -179,6 +201,11 @@ func (c *Container) ListCommentsUseCase() *usecase.ListComments {
 	return usecase.NewListComments(c.Tasks)
 }
 
+// StatsCostUseCase returns a new StatsCost use case.
+func (c *Container) StatsCostUseCase() *usecase.StatsCost {
+	return usecase.NewStatsCost(c.Tasks)
+}
+
 // EditTaskUseCase returns a new EditTask use case.
 func (c *Container) EditTaskUseCase() *usecase.EditTask {
 	return usecase.NewEditTask(c.Tasks)
@@ -223,6 +250,11 @@ func (c *Container) StartTaskUseCase() *usecase.StartTask {
 	return usecase.NewStartTask(c.Tasks, c.Sessions, c.Worktrees, c.ConfigLoader, c.Git, c.Clock, c.Logger, c.Runner, c.Config.CrewDir, c.Config.RepoRoot)
 }
 
+// RouteTaskUseCase returns a new RouteTask use case.
+func (c *Container) RouteTaskUseCase() *usecase.RouteTask {
+	return usecase.NewRouteTask(c.Tasks, c.ConfigLoader)
+}
+
 // AttachSessionUseCase returns a new AttachSession use case.
 func (c *Container) AttachSessionUseCase() *usecase.AttachSession {
 	return usecase.NewAttachSession(c.Tasks, c.Sessions)
@@ -240,7 +272,51 @@ func (c *Container) PeekSessionUseCase() *usecase.PeekSession {
 
 // SessionEndedUseCase returns a new SessionEnded use case.
 func (c *Container) SessionEndedUseCase() *usecase.SessionEnded {
-	return usecase.NewSessionEnded(c.Tasks, c.Config.CrewDir)
+	return usecase.NewSessionEnded(c.Tasks, c.Clock, c.Config.CrewDir).WithConfig(c.ConfigLoader)
+}
+
+// FallbackTaskUseCase returns a new FallbackTask use case.
+func (c *Container) FallbackTaskUseCase() *usecase.FallbackTask {
+	return usecase.NewFallbackTask(c.Tasks, c.Sessions, c.StartTaskUseCase(), c.ConfigLoader, c.Clock, c.Logger)
+}
+
+// RestartSessionUseCase returns a new RestartSession use case.
+func (c *Container) RestartSessionUseCase() *usecase.RestartSession {
+	return usecase.NewRestartSession(c.Tasks, c.Sessions, c.StartTaskUseCase(), c.ConfigLoader, c.Logger)
+}
+
+// StartFallback restarts a task with its next fallback agent in a detached
+// crew process, which outlives the session that ended in error.
+func (c *Container) StartFallback(taskID int, agent string, exitCode int) error {
+	return startRecovery("_fallback", taskID, agent, exitCode)
+}
+
+// StartRestart restarts a task with the same agent after its restart backoff,
+// in a detached crew process like StartFallback.
+func (c *Container) StartRestart(taskID int, agent string, exitCode int) error {
+	return startRecovery("_restart", taskID, agent, exitCode)
+}
+
+// startRecovery runs an internal recovery command for a failed session in a detached crew process.
+func startRecovery(command string, taskID int, agent string, exitCode int) error {
+	return executor.StartDetached(domain.NewCommand(crewExecutable(),
+		[]string{command, strconv.Itoa(taskID), agent, strconv.Itoa(exitCode)}, ""))
+}
+
+// ExecHook runs a rendered hook command for a task and waits for it.
+// Failures are logged, not returned.
+func (c *Container) ExecHook(taskID int, hook domain.Hook, command, dir string) {
+	c.hookRunner().Exec(taskID, hook, command, dir)
+}
+
+// detachedHookLauncher runs hook commands in a detached crew process, so that
+// saving a task never waits for its hooks and they outlive short crew commands.
+type detachedHookLauncher struct{}
+
+// LaunchHook starts the internal _hook command for a rendered hook command.
+func (detachedHookLauncher) LaunchHook(taskID int, hook domain.Hook, command, dir string) error {
+	return executor.StartDetached(domain.NewCommand(crewExecutable(),
+		[]string{"_hook", strconv.Itoa(taskID), string(hook), dir, command}, ""))
 }
 
 // ShowConfigUseCase returns a new ShowConfig use case.
@@ -261,12 +337,28 @@ func (c *Container) ShowConfigTemplateUseCase() *usecase.ShowConfigTemplate {
 // CompleteTaskUseCase returns a new CompleteTask use case.
 // stdout and stderr are used for review output when auto-starting review.
 func (c *Container) CompleteTaskUseCase(stdout, stderr io.Writer) *usecase.CompleteTask {
-	return usecase.NewCompleteTask(c.Tasks, c.Sessions, c.Worktrees, c.Git, c.ConfigLoader, c.Clock, c.Logger, c.Executor, stderr, c.Config.CrewDir, c.Config.RepoRoot)
+	return usecase.NewCompleteTask(c.Tasks, c.Sessions, c.Worktrees, c.Git, c.ConfigLoader, c.Clock, c.Logger, c.Executor, stderr, c.Config.CrewDir, c.Config.RepoRoot).
+		WithHooks(c.hookRunner()).
+		WithConflictResolver(c.conflictResolver(stderr))
 }
 
 // MergeTaskUseCase returns a new MergeTask use case.
-func (c *Container) MergeTaskUseCase() *usecase.MergeTask {
-	return usecase.NewMergeTask(c.Tasks, c.Sessions, c.Worktrees, c.Git, c.Clock, c.Config.CrewDir)
+// stderr receives conflict resolver progress.
+func (c *Container) MergeTaskUseCase(stderr io.Writer) *usecase.MergeTask {
+	return usecase.NewMergeTask(c.Tasks, c.Sessions, c.Worktrees, c.Git, c.Clock, c.Config.CrewDir).
+		WithConfig(c.ConfigLoader).
+		WithConflictResolver(c.conflictResolver(stderr)).
+		WithLogger(c.Logger)
+}
+
+// RebaseTasksUseCase returns a new RebaseTasks use case.
+func (c *Container) RebaseTasksUseCase() *usecase.RebaseTasks {
+	return usecase.NewRebaseTasks(c.Tasks, c.Sessions, c.Worktrees, c.Git)
+}
+
+// CreatePRUseCase returns a new CreatePR use case.
+func (c *Container) CreatePRUseCase() *usecase.CreatePR {
+	return usecase.NewCreatePR(c.Tasks, c.Git, c.GitHub)
 }
 
 // ShowDiffUseCase returns a new ShowDiff use case.
@@ -281,6 +373,11 @@ func (c *Container) ShowDiffUseCaseForCommand() *usecase.ShowDiff {
 	return usecase.NewShowDiff(c.Tasks, c.Worktrees, c.Git, c.ConfigLoader, c.Executor, nil, nil)
 }
 
+// StreamEventsUseCase returns a new StreamEvents use case.
+func (c *Container) StreamEventsUseCase(stdout io.Writer) *usecase.StreamEvents {
+	return usecase.NewStreamEvents(c.Events, stdout)
+}
+
 // StopTaskUseCase returns a new StopTask use case.
 func (c *Container) StopTaskUseCase() *usecase.StopTask {
 	return usecase.NewStopTask(c.Tasks, c.Sessions, c.Config.CrewDir)
@@ -291,6 +388,41 @@ func (c *Container) PruneTasksUseCase() *usecase.PruneTasks {
 	return usecase.NewPruneTasks(c.Tasks, c.Worktrees, c.Git)
 }
 
+// SaveSnapshotUseCase returns a new SaveSnapshot use case.
+func (c *Container) SaveSnapshotUseCase() *usecase.SaveSnapshot {
+	return usecase.NewSaveSnapshot(c.Tasks, c.Git, c.Logger)
+}
+
+// ListSnapshotsUseCase returns a new ListSnapshots use case.
+func (c *Container) ListSnapshotsUseCase() *usecase.ListSnapshots {
+	return usecase.NewListSnapshots(c.Tasks)
+}
+
+// RestoreSnapshotUseCase returns a new RestoreSnapshot use case.
+func (c *Container) RestoreSnapshotUseCase() *usecase.RestoreSnapshot {
+	return usecase.NewRestoreSnapshot(c.Tasks, c.Git, c.Logger)
+}
+
+// PruneSnapshotsUseCase returns a new PruneSnapshots use case.
+func (c *Container) PruneSnapshotsUseCase() *usecase.PruneSnapshots {
+	return usecase.NewPruneSnapshots(c.Tasks)
+}
+
+// PushTasksUseCase returns a new PushTasks use case.
+func (c *Container) PushTasksUseCase() *usecase.PushTasks {
+	return usecase.NewPushTasks(c.Tasks, c.Logger)
+}
+
+// PullTasksUseCase returns a new PullTasks use case.
+func (c *Container) PullTasksUseCase() *usecase.PullTasks {
+	return usecase.NewPullTasks(c.Tasks, c.Logger)
+}
+
+// ListNamespacesUseCase returns a new ListNamespaces use case.
+func (c *Container) ListNamespacesUseCase() *usecase.ListNamespaces {
+	return usecase.NewListNamespaces(c.Tasks)
+}
+
 // ExecCommandUseCase returns a new ExecCommand use case.
 func (c *Container) ExecCommandUseCase() *usecase.ExecCommand {
 	return usecase.NewExecCommand(c.Tasks, c.Worktrees)
@@ -313,11 +445,27 @@ func (c *Container) PollStatusUseCase(stdout io.Writer) *usecase.PollStatus {
 	return usecase.NewPollStatus(c.Tasks, stdout)
 }
 
+// RunSchedulerUseCase returns a new RunScheduler use case.
+// stdout is the writer for scheduler progress output.
+func (c *Container) RunSchedulerUseCase(stdout io.Writer) *usecase.RunScheduler {
+	return usecase.NewRunScheduler(c.Tasks, c.StartTaskUseCase(), c.ConfigLoader, c.Logger, stdout)
+}
+
+// WatchSessionsUseCase returns a new WatchSessions use case.
+func (c *Container) WatchSessionsUseCase(stdout io.Writer) *usecase.WatchSessions {
+	return usecase.NewWatchSessions(c.Tasks, c.Sessions, c.ConfigLoader, c.Clock, c.Logger, stdout)
+}
+
 // ShowLogsUseCase returns a new ShowLogs use case.
 func (c *Container) ShowLogsUseCase() *usecase.ShowLogs {
 	return usecase.NewShowLogs(c.Tasks, c.Config.CrewDir)
 }
 
+// ReplaySessionUseCase returns a new ReplaySession use case.
+func (c *Container) ReplaySessionUseCase(stdout io.Writer) *usecase.ReplaySession {
+	return usecase.NewReplaySession(c.Tasks, c.Config.CrewDir, stdout)
+}
+
 // MigrateStoreUseCase returns a new MigrateStore use case.
 func (c *Container) MigrateStoreUseCase(source domain.TaskRepository, dest domain.TaskRepository, destInit domain.StoreInitializer) *usecase.MigrateStore {
 	return usecase.NewMigrateStore(source, dest, destInit)
@@ -362,3 +510,82 @@ func resolveNamespace(cfg *domain.Config, gitClient domain.Git) string {
 	}
 	return domain.DefaultNamespace
 }
+
+// resolveEventActor determines the actor recorded in task events.
+// Commands run inside a task worktree are made by the worker; everything else
+// is attributed to the user namespace.
+func resolveEventActor(gitClient domain.Git, namespace string) string {
+	if gitClient != nil {
+		if branch, err := gitClient.CurrentBranch(); err == nil {
+			if _, ok := domain.ParseBranchTaskID(branch); ok {
+				return "worker"
+			}
+		}
+	}
+	return namespace
+}
+
+// AttachCommand returns the command that attaches a terminal to a session,
+// for callers that must keep running while attached (e.g., the TUI).
+func (c *Container) AttachCommand(sessionName string) *domain.ExecCommand {
+	if s, ok := c.Sessions.(interface {
+		AttachCommand(sessionName string) *domain.ExecCommand
+	}); ok {
+		return s.AttachCommand(sessionName)
+	}
+	return domain.NewCommand("tmux", []string{"-S", c.Config.SocketPath, "attach", "-t", sessionName}, "")
+}
+
+// PTYHostOptions configures a pty session host (see ServePTYSession).
+type PTYHostOptions = ptysession.HostOptions
+
+// ServePTYSession runs a pty session host until its command exits or ctx is cancelled.
+// It is the entry point of the hidden host process started by the pty session backend.
+func (c *Container) ServePTYSession(ctx context.Context, opts PTYHostOptions) error {
+	return ptysession.Serve(ctx, opts)
+}
+
+// RecordSession records terminal output read from src to an asciicast file at path.
+// It is the entry point of the hidden recorder process the tmux backend pipes sessions into.
+func (c *Container) RecordSession(src io.Reader, path string, cols, rows int, title string) error {
+	return recording.Record(src, path, cols, rows, title)
+}
+
+// ReplayCommand returns the command that replays a task's session recording,
+// for callers that must keep running while it plays (e.g., the TUI).
+func (c *Container) ReplayCommand(taskID int, review bool) *domain.ExecCommand {
+	args := []string{"replay", strconv.Itoa(taskID), "--wait"}
+	if review {
+		args = append(args, "--review")
+	}
+	return domain.NewCommand(crewExecutable(), args, "")
+}
+
+// newSessionManager creates the session manager selected by [session] backend.
+// Both backends record sessions through this binary.
+func newSessionManager(cfg Config, appConfig *domain.Config) domain.SessionManager {
+	if appConfig.Session.Backend == domain.SessionBackendPTY {
+		return ptysession.NewClient(cfg.CrewDir, crewExecutable())
+	}
+	return tmux.NewClient(cfg.SocketPath, cfg.CrewDir).WithRecorder(crewExecutable())
+}
+
+// crewExecutable returns the path of the running crew binary,
+// falling back to PATH lookup if it cannot be resolved.
+func crewExecutable() string {
+	exe, err := os.Executable()
+	if err != nil {
+		return "crew"
+	}
+	return exe
+}
+
+// hookRunner returns a HookRunner for hooks that are not triggered by saved task changes.
+func (c *Container) hookRunner() *shared.HookRunner {
+	return shared.NewHookRunner(c.ConfigLoader, c.Worktrees, c.Executor, c.Logger, c.Config.RepoRoot, c.Config.GitDir)
+}
+
+// conflictResolver returns the runner for the conflict_resolver agent, writing progress to output.
+func (c *Container) conflictResolver(output io.Writer) *shared.ConflictResolver {
+	return shared.NewConflictResolver(c.ConfigLoader, c.Sessions, c.Worktrees, c.Git, c.Logger, output, c.Config.CrewDir, c.Config.RepoRoot)
+}
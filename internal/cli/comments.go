@@ -34,7 +34,10 @@ Examples:
   crew comments --type suggestion --tag architecture
 
   # List comments with multiple tags (comma-separated)
-  crew comments --tags testing,refactoring`,
+  crew comments --tags testing,refactoring
+
+  # List completion gate results
+  crew comments --type gate`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			commentType := domain.CommentType(strings.TrimSpace(strings.ToLower(opts.Type)))
 			if !commentType.IsValid() {
@@ -56,7 +59,7 @@ Examples:
 		},
 	}
 
-	cmd.Flags().StringVar(&opts.Type, "type", "", "Comment type (report, message, suggestion, friction)")
+	cmd.Flags().StringVar(&opts.Type, "type", "", "Comment type (report, message, suggestion, friction, gate, usage, fallback)")
 	cmd.Flags().StringArrayVar(&opts.Tags, "tag", nil, "Filter by tag (can specify multiple)")
 	cmd.Flags().StringVar(&opts.TagsCSV, "tags", "", "Filter by tags (comma-separated)")
 
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+// newEventsCommand creates the events command for streaming task state changes.
+func newEventsCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		types  []string
+		task   int
+		tail   int
+		follow bool
+		json   bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Show task state changes from the event log",
+		Long: `Show task state changes recorded in .crew/events.jsonl.
+
+Every state change is appended to the event log as a JSON line with the
+task ID, actor, old/new values and timestamp. Unlike 'crew poll', which
+re-reads the task store, the event log keeps every intermediate transition.
+
+Event types:
+  status         Status transition (old is empty when the task is created)
+  substate       Execution substate change
+  session_start  Session started (new = session name)
+  session_end    Session ended (old = session name)
+  review         Review result recorded (new = lgtm or changes_requested)
+  merge          Task merged (new = base branch)
+  stall          Session stalled or resumed (new = idle or permission, empty when resumed)
+
+With --follow, new events are streamed as they are recorded until
+interrupted (Ctrl+C).
+
+Examples:
+  # Show all recorded events
+  crew events
+
+  # Stream events as they happen
+  crew events --follow
+
+  # Follow status and review events of task #3
+  crew events -f --task 3 --type status,review
+
+  # Show the last 20 events as JSON lines
+  crew events -n 20 --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// Setup signal handling for graceful shutdown
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			var types []domain.EventType
+			for _, value := range opts.types {
+				for _, part := range strings.Split(value, ",") {
+					if part = strings.TrimSpace(part); part != "" {
+						types = append(types, domain.EventType(part))
+					}
+				}
+			}
+
+			uc := c.StreamEventsUseCase(cmd.OutOrStdout())
+			_, err := uc.Execute(ctx, usecase.StreamEventsInput{
+				Types:  types,
+				TaskID: opts.task,
+				Tail:   opts.tail,
+				Follow: opts.follow,
+				JSON:   opts.json,
+			})
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.follow, "follow", "f", false, "Keep streaming new events")
+	cmd.Flags().IntVar(&opts.task, "task", 0, "Only show events of this task")
+	cmd.Flags().StringSliceVar(&opts.types, "type", nil, "Only show events of these types (comma-separated or repeated)")
+	cmd.Flags().IntVarP(&opts.tail, "tail", "n", 0, "Only show the last N recorded events (0 = all)")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output events as JSON lines")
+
+	return cmd
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/spf13/cobra"
+)
+
+// newHookCommand creates the _hook internal command.
+// This is started detached by the hook runner to run a rendered state change
+// hook, so that the command that changed the task does not wait for it.
+func newHookCommand(c *app.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "_hook <id> <hook> <dir> <command>",
+		Short:  "Run a lifecycle hook command (internal command)",
+		Hidden: true, // Internal command, not shown in help
+		Args:   cobra.ExactArgs(4),
+		RunE: func(_ *cobra.Command, args []string) error {
+			// Parse task ID
+			taskID, err := parseTaskID(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid task ID: %w", err)
+			}
+
+			// Failures are logged by the hook runner
+			c.ExecHook(taskID, domain.Hook(args[1]), args[3], args[2])
+			return nil
+		},
+	}
+
+	return cmd
+}
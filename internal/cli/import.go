@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+	"github.com/runoshun/git-crew/v2/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+// newImportCommand creates the import command for creating tasks from external sources.
+func newImportCommand(c *app.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Create tasks from external sources",
+		Long:  `Create tasks in bulk from external sources such as GitHub issues.`,
+		// No RunE: shows subcommand list when called without arguments
+	}
+
+	// Add subcommands
+	cmd.AddCommand(newImportIssuesCommand(c))
+
+	return cmd
+}
+
+// newImportIssuesCommand creates the import issues subcommand.
+func newImportIssuesCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		Base   string
+		State  string
+		Labels []string
+		Limit  int
+		DryRun bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "issues",
+		Short: "Create tasks from GitHub issues",
+		Long: `Create a todo task for each GitHub issue matching the filters.
+
+Each task takes its title, description and labels from the issue and is
+linked to it. Issues that are already linked to a task (in any status)
+are skipped, so the command can be run repeatedly.
+
+Requires the GitHub CLI (gh) to be installed and authenticated.
+
+Examples:
+  # Import open issues labeled "crew"
+  crew import issues --label crew
+
+  # Preview what would be imported
+  crew import issues --label crew --dry-run
+
+  # Import up to 10 issues with both labels
+  crew import issues --label crew --label ready --limit 10`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			uc := c.ImportIssuesUseCase()
+			out, err := uc.Execute(cmd.Context(), usecase.ImportIssuesInput{
+				BaseBranch: opts.Base,
+				State:      opts.State,
+				Labels:     opts.Labels,
+				Limit:      opts.Limit,
+				DryRun:     opts.DryRun,
+			})
+			if err != nil {
+				return err
+			}
+
+			w := cmd.OutOrStdout()
+			for _, s := range out.Skipped {
+				_, _ = fmt.Fprintf(w, "Skipped issue #%d (already linked to task #%d)\n", s.Issue, s.TaskID)
+			}
+			for _, created := range out.Created {
+				if opts.DryRun {
+					_, _ = fmt.Fprintf(w, "Would create task from issue #%d: %s\n", created.Issue, created.Title)
+					continue
+				}
+				_, _ = fmt.Fprintf(w, "Created task #%d from issue #%d: %s\n", created.TaskID, created.Issue, created.Title)
+			}
+			if len(out.Created) == 0 {
+				_, _ = fmt.Fprintln(w, "No issues to import")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&opts.Labels, "label", nil, "Only import issues with this label (can specify multiple)")
+	cmd.Flags().StringVar(&opts.State, "state", "", "Issue state: open, closed, or all (default: open)")
+	cmd.Flags().IntVar(&opts.Limit, "limit", 0, "Maximum number of issues to fetch")
+	cmd.Flags().StringVar(&opts.Base, "base", "", "Base branch for created tasks (default: current branch)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show what would be imported without creating tasks")
+
+	return cmd
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+	"github.com/runoshun/git-crew/v2/internal/mcp"
+	"github.com/spf13/cobra"
+)
+
+// newMCPCommand creates the mcp command that serves crew tools over MCP.
+func newMCPCommand(c *app.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mcp",
+		Short: "Serve crew tools over the Model Context Protocol",
+		Long: `Serve crew tools to AI agents over the Model Context Protocol (stdio).
+
+Agents call typed tools instead of running crew commands and parsing their
+output:
+  crew_list       List tasks
+  crew_show       Show a task with comments
+  crew_new        Create a task
+  crew_comment    Add a comment to a task
+  crew_substate   Report the execution substate of a task
+  crew_complete   Mark a task as done
+
+Tools that take a task_id default to the task of the current crew branch,
+so workers can omit it inside their worktree.
+
+The command is started by the agent, not run directly.
+
+Examples:
+  # Register with Claude Code
+  claude mcp add crew -- crew mcp
+
+  # Register with Codex (~/.codex/config.toml)
+  [mcp_servers.crew]
+  command = "crew"
+  args = ["mcp"]
+
+  # Register with OpenCode (opencode.json)
+  "mcp": { "crew": { "type": "local", "command": ["crew", "mcp"] } }`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// Setup signal handling for graceful shutdown
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			server := mcp.New(c, cmd.Root().Version)
+			return server.Serve(ctx, cmd.InOrStdin(), cmd.OutOrStdout())
+		},
+	}
+
+	return cmd
+}
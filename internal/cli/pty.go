@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+	"github.com/spf13/cobra"
+)
+
+// newPTYHostCommand creates the _pty-host internal command.
+// The pty session backend runs it in the background to own a session's pty.
+func newPTYHostCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		socket string
+		dir    string
+		record string
+	}
+
+	cmd := &cobra.Command{
+		Use:    "_pty-host <session> <command>",
+		Short:  "Run a pty session host (internal command)",
+		Hidden: true, // Internal command, not shown in help
+		Args:   cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Terminate the session when the host is asked to stop
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+			defer stop()
+
+			return c.ServePTYSession(ctx, app.PTYHostOptions{
+				Name:       args[0],
+				Command:    args[1],
+				Dir:        opts.dir,
+				SocketPath: opts.socket,
+				RecordPath: opts.record,
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.socket, "socket", "", "Control socket path")
+	cmd.Flags().StringVar(&opts.dir, "dir", "", "Working directory")
+	cmd.Flags().StringVar(&opts.record, "record", "", "asciicast recording path")
+	_ = cmd.MarkFlagRequired("socket")
+
+	return cmd
+}
+
+// newPTYAttachCommand creates the _pty-attach internal command.
+// The TUI runs it to attach to a pty session without replacing its own process.
+func newPTYAttachCommand(c *app.Container) *cobra.Command {
+	return &cobra.Command{
+		Use:    "_pty-attach <session>",
+		Short:  "Attach to a pty session (internal command)",
+		Hidden: true, // Internal command, not shown in help
+		Args:   cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return c.Sessions.Attach(args[0])
+		},
+	}
+}
+
+// newRecordCommand creates the _record internal command.
+// The tmux session backend pipes each session's output into it (tmux pipe-pane).
+func newRecordCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		cols int
+		rows int
+	}
+
+	cmd := &cobra.Command{
+		Use:    "_record <path>",
+		Short:  "Record session output from stdin (internal command)",
+		Hidden: true, // Internal command, not shown in help
+		Args:   cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title := strings.TrimSuffix(filepath.Base(args[0]), filepath.Ext(args[0]))
+			return c.RecordSession(cmd.InOrStdin(), args[0], opts.cols, opts.rows, title)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.cols, "cols", 80, "Terminal width")
+	cmd.Flags().IntVar(&opts.rows, "rows", 24, "Terminal height")
+
+	return cmd
+}
@@ -228,6 +228,18 @@ Use --help-manager-auto to see the auto mode guide.`,
 	closeCmd := newCloseCommand(c)
 	closeCmd.GroupID = groupTask
 
+	importCmd := newImportCommand(c)
+	importCmd.GroupID = groupTask
+
+	snapshotCmd := newSnapshotCommand(c)
+	snapshotCmd.GroupID = groupTask
+
+	statsCmd := newStatsCommand(c)
+	statsCmd.GroupID = groupTask
+
+	syncCmd := newSyncCommand(c)
+	syncCmd.GroupID = groupTask
+
 	// Session management commands
 	startCmd := newStartCommand(c)
 	startCmd.GroupID = groupSession
@@ -256,12 +268,30 @@ Use --help-manager-auto to see the auto mode guide.`,
 	mergeCmd := newMergeCommand(c)
 	mergeCmd.GroupID = groupSession
 
+	rebaseCmd := newRebaseCommand(c)
+	rebaseCmd.GroupID = groupSession
+
+	prCmd := newPRCommand(c)
+	prCmd.GroupID = groupSession
+
 	pollCmd := newPollCommand(c)
 	pollCmd.GroupID = groupSession
 
 	logsCmd := newLogsCommand(c)
 	logsCmd.GroupID = groupSession
 
+	eventsCmd := newEventsCommand(c)
+	eventsCmd.GroupID = groupSession
+
+	replayCmd := newReplayCommand(c)
+	replayCmd.GroupID = groupSession
+
+	runCmd := newRunCommand(c)
+	runCmd.GroupID = groupSession
+
+	watchCmd := newWatchCommand(c)
+	watchCmd.GroupID = groupSession
+
 	pruneCmd := newPruneCommand(c)
 	pruneCmd.GroupID = groupTask
 
@@ -277,8 +307,22 @@ Use --help-manager-auto to see the auto mode guide.`,
 	workspaceCmd := newWorkspaceCommand(c)
 	workspaceCmd.GroupID = groupUI
 
+	// API server command
+	serveCmd := newServeCommand(c)
+	serveCmd.GroupID = groupUI
+
+	// MCP server command
+	mcpCmd := newMCPCommand(c)
+	mcpCmd.GroupID = groupUI
+
 	// Internal commands (hidden)
 	sessionEndedCmd := newSessionEndedCommand(c)
+	restartCmd := newRestartCommand(c)
+	fallbackCmd := newFallbackCommand(c)
+	hookCmd := newHookCommand(c)
+	ptyHostCmd := newPTYHostCommand(c)
+	ptyAttachCmd := newPTYAttachCommand(c)
+	recordCmd := newRecordCommand(c)
 
 	// Add subcommands
 	root.AddCommand(
@@ -296,6 +340,10 @@ Use --help-manager-auto to see the auto mode guide.`,
 		commentCmd,
 		commentsCmd,
 		closeCmd,
+		importCmd,
+		snapshotCmd,
+		statsCmd,
+		syncCmd,
 		startCmd,
 		stopCmd,
 		attachCmd,
@@ -305,12 +353,26 @@ Use --help-manager-auto to see the auto mode guide.`,
 		diffCmd,
 		completeCmd,
 		mergeCmd,
+		rebaseCmd,
+		prCmd,
 		pollCmd,
 		logsCmd,
+		eventsCmd,
+		replayCmd,
+		runCmd,
+		watchCmd,
 		pruneCmd,
 		managerCmd,
 		workspaceCmd,
+		serveCmd,
+		mcpCmd,
 		sessionEndedCmd,
+		restartCmd,
+		fallbackCmd,
+		hookCmd,
+		ptyHostCmd,
+		ptyAttachCmd,
+		recordCmd,
 	)
 
 	return root
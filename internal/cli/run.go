@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+	"github.com/runoshun/git-crew/v2/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+// newRunCommand creates the run command for the task scheduler.
+func newRunCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		Agent       string
+		MaxParallel int
+		Interval    int
+		Once        bool
+	}
+
+	cmd := &cobra.Command{
+		Use:     "run",
+		Aliases: []string{"scheduler"},
+		Short:   "Start ready tasks automatically",
+		Long: `Run the task scheduler.
+
+The scheduler watches the task store and starts todo tasks that are ready:
+not blocked and with all dependencies merged. Tasks are started oldest first
+until the number of in_progress tasks reaches max_parallel.
+
+Settings are read from the [scheduler] config section and can be
+overridden with flags:
+  max_parallel   Maximum number of tasks running at the same time (default: 3)
+  interval       Polling interval in seconds (default: 10)
+  agent          Agent used to start every task (default: routed per task
+                 by [[agents.routing]], falling back to agents.worker_default)
+  agent_limits   Per-agent concurrency caps (e.g., { claude = 2 })
+
+The scheduler runs until interrupted (Ctrl+C). Use --once to run a single
+scheduling pass and exit.
+
+Examples:
+  # Run the scheduler with settings from config
+  crew run
+
+  # Run at most 5 tasks in parallel with a specific agent
+  crew run --max-parallel 5 --agent claude
+
+  # Start whatever is ready now and exit
+  crew run --once`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// Setup signal handling for graceful shutdown
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			uc := c.RunSchedulerUseCase(cmd.OutOrStdout())
+			out, err := uc.Execute(ctx, usecase.RunSchedulerInput{
+				Agent:       opts.Agent,
+				MaxParallel: opts.MaxParallel,
+				Interval:    opts.Interval,
+				Once:        opts.Once,
+			})
+			if err != nil {
+				return err
+			}
+
+			if opts.Once && len(out.Started) == 0 {
+				_, _ = fmt.Fprintln(cmd.OutOrStdout(), "No ready tasks to start.")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Agent, "agent", "", "Agent used to start tasks (overrides scheduler.agent)")
+	cmd.Flags().IntVarP(&opts.MaxParallel, "max-parallel", "p", 0, "Maximum number of running tasks (overrides scheduler.max_parallel)")
+	cmd.Flags().IntVarP(&opts.Interval, "interval", "i", 0, "Polling interval in seconds (overrides scheduler.interval)")
+	cmd.Flags().BoolVar(&opts.Once, "once", false, "Run a single scheduling pass and exit")
+
+	return cmd
+}
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+	"github.com/runoshun/git-crew/v2/internal/server"
+	"github.com/spf13/cobra"
+)
+
+// defaultServeAddr is the default listen address of crew serve.
+const defaultServeAddr = "127.0.0.1:7777"
+
+// newServeCommand creates the serve command for the local HTTP/JSON API.
+func newServeCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		Addr   string
+		Socket string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve a local HTTP/JSON API",
+		Long: `Serve the task operations over a local HTTP/JSON API.
+
+The API is intended for dashboards and editor integrations. It listens on a
+localhost port, or on a Unix socket with --socket. Only loopback addresses
+are accepted for --addr.
+
+Endpoints (see GET /openapi.yaml for the full description):
+  GET   /api/v1/tasks                 List tasks
+  POST  /api/v1/tasks                 Create a task
+  GET   /api/v1/tasks/{id}            Show a task with comments
+  PATCH /api/v1/tasks/{id}            Edit a task
+  POST  /api/v1/tasks/{id}/start      Start a session
+  POST  /api/v1/tasks/{id}/stop       Stop a session
+  POST  /api/v1/tasks/{id}/complete   Complete a task
+  POST  /api/v1/tasks/{id}/merge      Merge a task
+  POST  /api/v1/tasks/{id}/comments   Add a comment
+  GET   /api/v1/tasks/{id}/peek       Capture session output
+
+POST and PATCH requests must use Content-Type: application/json.
+
+The server runs until interrupted (Ctrl+C).
+
+Examples:
+  # Serve on the default port
+  crew serve
+
+  # Serve on a different port
+  crew serve --addr 127.0.0.1:9000
+
+  # Serve on a Unix socket
+  crew serve --socket .crew/crew.sock
+  curl --unix-socket .crew/crew.sock http://localhost/api/v1/tasks`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// Setup signal handling for graceful shutdown
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			listener, url, err := listenServe(opts.Addr, opts.Socket)
+			if err != nil {
+				return err
+			}
+			if opts.Socket != "" {
+				defer func() { _ = os.Remove(opts.Socket) }()
+			}
+
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Serving API on %s (OpenAPI: GET /openapi.yaml)\n", url)
+			return server.New(c).Serve(ctx, listener)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Addr, "addr", defaultServeAddr, "Listen address (loopback only)")
+	cmd.Flags().StringVar(&opts.Socket, "socket", "", "Listen on a Unix socket instead of a TCP port")
+	cmd.MarkFlagsMutuallyExclusive("addr", "socket")
+
+	return cmd
+}
+
+// listenServe opens the listener for crew serve and returns it with its base URL.
+func listenServe(addr, socket string) (net.Listener, string, error) {
+	if socket != "" {
+		// Remove a stale socket left by a previous server
+		if info, err := os.Lstat(socket); err == nil && info.Mode()&fs.ModeSocket != 0 {
+			_ = os.Remove(socket)
+		}
+		listener, err := net.Listen("unix", socket)
+		if err != nil {
+			return nil, "", fmt.Errorf("listen on socket: %w", err)
+		}
+		if err := os.Chmod(socket, 0o600); err != nil {
+			_ = listener.Close()
+			return nil, "", fmt.Errorf("restrict socket permissions: %w", err)
+		}
+		return listener, "unix:" + socket, nil
+	}
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid address %q: %w", addr, err)
+	}
+	if !server.IsLoopbackHost(host) {
+		return nil, "", errors.New("address must be a loopback address (e.g., 127.0.0.1:7777)")
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, "", fmt.Errorf("listen: %w", err)
+	}
+	return listener, "http://" + listener.Addr().String(), nil
+}
@@ -3,9 +3,13 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/runoshun/git-crew/v2/internal/app"
 	"github.com/runoshun/git-crew/v2/internal/domain"
@@ -15,6 +19,7 @@ import (
 
 // newDiffCommand creates the diff command for showing task changes.
 func newDiffCommand(c *app.Container) *cobra.Command {
+	var noFindings bool
 	cmd := &cobra.Command{
 		Use:   "diff <id> [args...]",
 		Short: "Display task change diff",
@@ -26,6 +31,10 @@ from the task's worktree directory.
 Any additional arguments after the task ID are passed to the diff command
 through the {{.Args}} template variable.
 
+Findings of the latest review are shown below the diff lines they refer to
+(findings outside the diff are listed at the end). The diff output is then
+printed without a pager; use --no-findings to run the diff command as is.
+
 Examples:
   # Show diff for task #1
   crew diff 1
@@ -34,7 +43,10 @@ Examples:
   crew diff 1 --stat
 
   # Show diff for specific file
-  crew diff 1 -- path/to/file.go`,
+  crew diff 1 -- path/to/file.go
+
+  # Show diff without review findings
+  crew diff 1 --no-findings`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Parse task ID
@@ -52,8 +64,9 @@ Examples:
 			// Execute use case
 			uc := c.ShowDiffUseCase(cmd.OutOrStdout(), cmd.ErrOrStderr())
 			_, err = uc.Execute(cmd.Context(), usecase.ShowDiffInput{
-				TaskID: taskID,
-				Args:   diffArgs,
+				TaskID:     taskID,
+				Args:       diffArgs,
+				NoFindings: noFindings,
 			})
 			if err != nil {
 				return err
@@ -63,6 +76,8 @@ Examples:
 		},
 	}
 
+	cmd.Flags().BoolVar(&noFindings, "no-findings", false, "Do not show review findings in the diff")
+
 	return cmd
 }
 
@@ -73,6 +88,7 @@ func newStartCommand(c *app.Container) *cobra.Command {
 		prompts      []string
 		continueFlag bool
 		skipReview   bool
+		explain      bool
 	}
 
 	cmd := &cobra.Command{
@@ -87,6 +103,12 @@ the task status to 'in_progress'.
 The agent argument specifies the command to run in the session.
 In the MVP version, this is the full command (e.g., "claude", "bash").
 
+Without an agent argument, the [[agents.routing]] rules pick the agent:
+the first rule whose conditions (labels, title/description regex, parent
+task, estimated size) all match the task wins, and agents.worker_default
+is used when none matches. Use --explain to see which rule fires without
+starting the task.
+
 Examples:
   # Start task #1 with claude
   crew start 1 claude
@@ -109,7 +131,10 @@ Examples:
   crew start 1 claude --prompt "Focus on performance optimization"
 
   # Start task with multiple additional prompts
-  crew start 1 claude -p "Use TDD approach" -p "Write comprehensive tests"`,
+  crew start 1 claude -p "Use TDD approach" -p "Write comprehensive tests"
+
+  # Show which agent the routing rules pick for task #1
+  crew start 1 --explain`,
 		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Parse task ID
@@ -124,6 +149,18 @@ Examples:
 				agent = args[1]
 			}
 
+			if opts.explain {
+				out, routeErr := c.RouteTaskUseCase().Execute(cmd.Context(), usecase.RouteTaskInput{
+					TaskID: taskID,
+					Agent:  agent,
+				})
+				if routeErr != nil {
+					return routeErr
+				}
+				printRouteExplanation(cmd.OutOrStdout(), out)
+				return nil
+			}
+
 			// Execute use case
 			uc := c.StartTaskUseCase()
 			input := usecase.StartTaskInput{
@@ -142,6 +179,9 @@ Examples:
 				return err
 			}
 
+			if out.RoutingRule != "" {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Routed to %s by rule %q\n", out.Agent, out.RoutingRule)
+			}
 			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Started task #%d (session: %s, worktree: %s)\n",
 				taskID, out.SessionName, out.WorktreePath)
 			return nil
@@ -152,10 +192,47 @@ Examples:
 	cmd.Flags().BoolVarP(&opts.continueFlag, "continue", "c", false, "Continue from previous session")
 	cmd.Flags().BoolVar(&opts.skipReview, "skip-review", false, "Set skip_review for this task (skip review on completion)")
 	cmd.Flags().StringArrayVarP(&opts.prompts, "prompt", "p", nil, "Additional prompt to append (can be specified multiple times)")
+	cmd.Flags().BoolVar(&opts.explain, "explain", false, "Show which routing rule picks the agent, without starting the task")
 
 	return cmd
 }
 
+// printRouteExplanation prints how the worker agent for a task was chosen.
+func printRouteExplanation(w io.Writer, out *usecase.RouteTaskOutput) {
+	_, _ = fmt.Fprintf(w, "Task #%d: %s\n", out.Task.ID, out.Task.Title)
+	_, _ = fmt.Fprintf(w, "Estimated size: %s (%s)\n", out.Size, out.SizeReason)
+
+	switch {
+	case out.Explicit:
+		_, _ = fmt.Fprintf(w, "Agent: %s (given explicitly; routing rules are not evaluated)\n", out.Decision.Agent)
+		return
+	case out.Rules == 0:
+		_, _ = fmt.Fprintln(w, "No routing rules configured ([[agents.routing]])")
+	default:
+		_, _ = fmt.Fprintln(w, "Routing rules:")
+		for i, eval := range out.Decision.Evaluations {
+			result := "no match"
+			if eval.Matched {
+				result = "MATCH"
+			}
+			_, _ = fmt.Fprintf(w, "  %d. %s -> %s: %s (%s)\n", i+1, eval.Name, eval.Agent, result, strings.Join(eval.Details, ", "))
+		}
+		if skipped := out.Rules - len(out.Decision.Evaluations); skipped > 0 {
+			_, _ = fmt.Fprintf(w, "  (%d later rules not evaluated)\n", skipped)
+		}
+	}
+
+	if out.Decision.Rule != "" {
+		_, _ = fmt.Fprintf(w, "Agent: %s (rule %q)\n", out.Decision.Agent, out.Decision.Rule)
+		return
+	}
+	agent := out.Decision.Agent
+	if agent == "" {
+		agent = "(none)"
+	}
+	_, _ = fmt.Fprintf(w, "Agent: %s (agents.worker_default)\n", agent)
+}
+
 // newAttachCommand creates the attach command for attaching to a session.
 func newAttachCommand(c *app.Container) *cobra.Command {
 	var opts struct {
@@ -165,10 +242,11 @@ func newAttachCommand(c *app.Container) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "attach [id]",
 		Short: "Attach to a running session",
-		Long: `Attach to a running tmux session for a task or manager.
+		Long: `Attach to a running session for a task or manager.
 
-This replaces the current process with the tmux session.
-Use Ctrl+G to detach from the session (configured in .crew/tmux.conf).
+With the tmux backend this replaces the current process with the tmux session;
+with [session] backend = "pty" the terminal is relayed to the session host.
+Use Ctrl+G to detach from the session (configured in .crew/tmux.conf for tmux).
 
 By default, attaches to the work session (crew-<id>).
 Use --manager to attach to the manager session (crew-manager).
@@ -312,10 +390,17 @@ Preconditions:
 If [complete].command is configured, it will be executed before transitioning
 the status. If the command fails, the completion is aborted.
 
+Each [[complete.gates]] entry is then run in the worktree (adjacent gates in the
+same parallel group run concurrently). Every result is recorded as a "gate"
+comment; if a required gate fails or times out, the completion is aborted and
+the failed gates are reported by name.
+
 		Review requirement:
 		  - skip_review enabled: bypasses review requirement
 		  - otherwise: runs review until the result matches [complete].review_success_regex (default: "✅ LGTM")
 		    or [complete].max_reviews attempts are reached (default: 1)
+		  - [complete].reviewers runs several reviewer agents in parallel; [complete].review_policy
+		    ("all", "majority", or "any") decides how many of them must match
 		  - --force-review runs review even if skip_review is enabled
 		  - review count increases when a review result is recorded
 
@@ -402,7 +487,7 @@ func newSessionEndedCommand(c *app.Container) *cobra.Command {
 
 			// Execute use case
 			uc := c.SessionEndedUseCase()
-			_, err = uc.Execute(cmd.Context(), usecase.SessionEndedInput{
+			out, err := uc.Execute(cmd.Context(), usecase.SessionEndedInput{
 				TaskID:   taskID,
 				ExitCode: exitCode,
 			})
@@ -410,6 +495,104 @@ func newSessionEndedCommand(c *app.Container) *cobra.Command {
 				return err
 			}
 
+			// Restart the same agent, or the fallback agent, once this session is gone
+			switch {
+			case out.Restart:
+				if err := c.StartRestart(taskID, out.Agent, exitCode); err != nil {
+					return fmt.Errorf("restart agent %s: %w", out.Agent, err)
+				}
+			case out.Fallback != "":
+				if err := c.StartFallback(taskID, out.Agent, exitCode); err != nil {
+					return fmt.Errorf("start fallback agent %s: %w", out.Fallback, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// newRestartCommand creates the _restart internal command.
+// This is started by _session-ended, detached from the session, to restart
+// a task whose worker session ended in error with the same agent after a backoff.
+func newRestartCommand(c *app.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "_restart <id> <agent> <exit-code>",
+		Short:  "Restart a failed task session (internal command)",
+		Hidden: true, // Internal command, not shown in help
+		Args:   cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse task ID
+			taskID, err := parseTaskID(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid task ID: %w", err)
+			}
+
+			// Parse exit code
+			exitCode, err := strconv.Atoi(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid exit code: %w", err)
+			}
+
+			// Execute use case
+			uc := c.RestartSessionUseCase()
+			out, err := uc.Execute(cmd.Context(), usecase.RestartSessionInput{
+				TaskID:   taskID,
+				Agent:    args[1],
+				ExitCode: exitCode,
+			})
+			if err != nil {
+				return err
+			}
+
+			if out.Restarted {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Restarted task #%d with %s (restart %d)\n", taskID, args[1], out.Restarts)
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// newFallbackCommand creates the _fallback internal command.
+// This is started by _session-ended, detached from the session, to restart
+// a task whose worker session ended in error with the next fallback agent.
+func newFallbackCommand(c *app.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "_fallback <id> <failed-agent> <exit-code>",
+		Short:  "Restart a task with a fallback agent (internal command)",
+		Hidden: true, // Internal command, not shown in help
+		Args:   cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse task ID
+			taskID, err := parseTaskID(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid task ID: %w", err)
+			}
+
+			// Parse exit code
+			exitCode, err := strconv.Atoi(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid exit code: %w", err)
+			}
+
+			// Execute use case
+			uc := c.FallbackTaskUseCase()
+			out, err := uc.Execute(cmd.Context(), usecase.FallbackTaskInput{
+				TaskID:   taskID,
+				Agent:    args[1],
+				ExitCode: exitCode,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, attempt := range out.Attempts {
+				_, _ = fmt.Fprintln(cmd.OutOrStdout(), attempt.Comment().Text)
+			}
 			return nil
 		},
 	}
@@ -668,11 +851,97 @@ Examples:
 	return cmd
 }
 
+// newReplayCommand creates the replay command for playing back a session recording.
+func newReplayCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		speed     float64
+		idleLimit time.Duration
+		run       int
+		review    bool
+		wait      bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "replay <id>",
+		Short: "Replay a recorded session",
+		Long: `Play back the terminal recording of a task's session.
+
+Every run of a session is recorded in asciicast v2 format to
+.crew/logs/<session>.<start time>.cast (e.g. .crew/logs/crew-1.20260118-100000.000.cast),
+so recordings can also be played with asciinema. Starting a session again keeps
+the recordings of its earlier runs. The latest run is replayed unless --run picks
+an earlier one (1 = the first run).
+
+Long pauses are shortened to --idle-limit. Press Ctrl+C to stop playback.
+
+Examples:
+  # Replay the work session of task #1
+  crew replay 1
+
+  # Replay at 4x speed
+  crew replay 1 --speed 4
+
+  # Replay the review session
+  crew replay 1 --review
+
+  # Replay the first run of the work session
+  crew replay 1 --run 1
+
+  # Keep the original pauses
+  crew replay 1 --idle-limit 0`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse task ID
+			taskID, err := parseTaskID(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid task ID: %w", err)
+			}
+			if opts.speed <= 0 {
+				return fmt.Errorf("invalid speed: %v (must be greater than 0)", opts.speed)
+			}
+			if opts.run < 0 {
+				return fmt.Errorf("invalid run: %d (must be 1 or greater)", opts.run)
+			}
+
+			// Ctrl+C stops playback
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			// Execute use case
+			uc := c.ReplaySessionUseCase(cmd.OutOrStdout())
+			if _, err := uc.Execute(ctx, usecase.ReplaySessionInput{
+				TaskID:    taskID,
+				Run:       opts.run,
+				Review:    opts.review,
+				Speed:     opts.speed,
+				IdleLimit: opts.idleLimit,
+			}); err != nil {
+				return err
+			}
+
+			if opts.wait && ctx.Err() == nil {
+				_, _ = fmt.Fprint(cmd.OutOrStdout(), "\r\n[replay finished, press Enter to return]")
+				_, _ = bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Float64Var(&opts.speed, "speed", 1, "Playback speed multiplier")
+	cmd.Flags().DurationVar(&opts.idleLimit, "idle-limit", 2*time.Second, "Maximum pause between outputs (0 = keep recorded pauses)")
+	cmd.Flags().IntVar(&opts.run, "run", 0, "Run to replay, counting from 1 for the first run (0 = latest)")
+	cmd.Flags().BoolVar(&opts.review, "review", false, "Replay the review session")
+	cmd.Flags().BoolVar(&opts.wait, "wait", false, "Wait for Enter after playback")
+
+	return cmd
+}
+
 // newMergeCommand creates the merge command for merging a task branch into a base branch.
 func newMergeCommand(c *app.Container) *cobra.Command {
 	var opts struct {
-		base string
-		yes  bool
+		base     string
+		strategy string
+		yes      bool
 	}
 
 	cmd := &cobra.Command{
@@ -689,11 +958,24 @@ Base branch selection:
   - If --base is not specified, uses task's base branch (or default branch if task has no base branch)
   - If --base is specified, uses the specified branch (allows merging to different branch)
 
+Strategies (--strategy, default from [merge] strategy in config, else merge):
+  merge    Create a merge commit (git merge --no-ff)
+  squash   Squash the branch into a single commit
+  rebase   Rebase the branch onto the base branch, then fast-forward
+  ff-only  Fast-forward only; fails if the base branch has moved
+
+Merge and squash commit messages come from the [merge] message template.
+
+If [agents] conflict_resolver_default is set, conflicts are first handed to that
+agent in the task worktree; the merge continues once the branch is mergeable.
+
 Processing:
-  1. If session is running, stop it
-  2. Execute git merge --no-ff
-  3. Delete the worktree
-  4. Update task status to 'closed' (with close_reason: merged)
+  1. Integrate the branch with the selected strategy
+  2. If session is running, stop it
+  3. Delete the worktree and branch
+  4. Update task status to 'merged'
+  5. Move tasks stacked on the branch onto the base branch
+     (git rebase --onto; conflicts are reported to their sessions)
 
 Examples:
   # Merge task #1 into its base branch (or default branch if not set)
@@ -702,6 +984,10 @@ Examples:
   # Merge task #1 into feature/workspace branch (override task's base branch)
   crew merge 1 --base feature/workspace
 
+  # Keep history linear
+  crew merge 1 --strategy squash
+  crew merge 1 --strategy rebase
+
   # Skip confirmation prompt
   crew merge 1 --yes`,
 		Args: cobra.ExactArgs(1),
@@ -711,6 +997,10 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("invalid task ID: %w", err)
 			}
+			strategy := domain.MergeStrategy(opts.strategy)
+			if strategy != "" && !strategy.IsValid() {
+				return fmt.Errorf("%w: %q (expected merge, squash, rebase, or ff-only)", domain.ErrInvalidMergeStrategy, opts.strategy)
+			}
 
 			// Get task info for confirmation
 			showUC := c.ShowTaskUseCase()
@@ -752,10 +1042,11 @@ Examples:
 			}
 
 			// Execute use case
-			uc := c.MergeTaskUseCase()
+			uc := c.MergeTaskUseCase(cmd.ErrOrStderr())
 			out, err := uc.Execute(cmd.Context(), usecase.MergeTaskInput{
 				TaskID:     taskID,
 				BaseBranch: opts.base,
+				Strategy:   strategy,
 			})
 			if err != nil {
 				// Print conflict message to stdout if present
@@ -765,12 +1056,194 @@ Examples:
 				return err
 			}
 
+			for _, id := range out.UnblockedTasks {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Unblocked task #%d (all dependencies merged)\n", id)
+			}
+			if out.SyncError != "" {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to update dependent tasks: %s\n", out.SyncError)
+			}
+			if len(out.Retargeted) > 0 {
+				_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Retargeted stacked tasks:")
+				for _, result := range out.Retargeted {
+					printRebasedTask(cmd.OutOrStdout(), result)
+				}
+			}
+
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&opts.base, "base", "", "Base branch to merge into (default: task's base branch or default branch)")
+	cmd.Flags().StringVar(&opts.strategy, "strategy", "", "Merge strategy: merge, squash, rebase, ff-only (default: [merge] strategy or merge)")
 	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip confirmation prompt")
 
 	return cmd
 }
+
+// newRebaseCommand creates the rebase command for updating task branches onto their base branch.
+func newRebaseCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		all   bool
+		merge bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rebase [<id>...]",
+		Short: "Update task branches onto their advanced base branch",
+		Long: `Rebase task branches onto their base branch so drift is resolved early.
+
+Each task worktree is rebased onto the task's base branch (or, with --merge,
+the base branch is merged into it) and the result is reported per task.
+
+Tasks are skipped when their worktree has uncommitted changes or a review
+is running. On conflict the rebase is aborted, the worktree is left
+unchanged, and the running session (if any) is told which files conflict.
+
+Examples:
+  # Rebase task #1 onto its base branch
+  crew rebase 1
+
+  # Rebase every active task that has a worktree
+  crew rebase --all
+
+  # Merge the base branch instead of rebasing
+  crew rebase --all --merge`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.all == (len(args) > 0) {
+				return fmt.Errorf("specify task IDs or --all")
+			}
+
+			taskIDs := make([]int, 0, len(args))
+			for _, arg := range args {
+				taskID, err := parseTaskID(arg)
+				if err != nil {
+					return fmt.Errorf("invalid task ID: %w", err)
+				}
+				taskIDs = append(taskIDs, taskID)
+			}
+
+			uc := c.RebaseTasksUseCase()
+			out, err := uc.Execute(cmd.Context(), usecase.RebaseTasksInput{
+				TaskIDs: taskIDs,
+				All:     opts.all,
+				Merge:   opts.merge,
+			})
+			if err != nil {
+				return err
+			}
+
+			w := cmd.OutOrStdout()
+			if len(out.Tasks) == 0 {
+				_, _ = fmt.Fprintln(w, "No tasks to rebase.")
+				return nil
+			}
+
+			conflicts := 0
+			failed := 0
+			for _, result := range out.Tasks {
+				printRebasedTask(w, result)
+				if result.Outcome == usecase.RebaseConflict {
+					conflicts++
+				}
+				if result.Outcome == usecase.RebaseFailed {
+					failed++
+				}
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d task(s) failed to rebase", failed)
+			}
+			if conflicts > 0 {
+				return fmt.Errorf("%d task(s) conflict with their base branch: %w", conflicts, domain.ErrMergeConflict)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.all, "all", false, "Rebase every active task that has a worktree")
+	cmd.Flags().BoolVar(&opts.merge, "merge", false, "Merge the base branch into the task branch instead of rebasing")
+
+	return cmd
+}
+
+// printRebasedTask prints one line describing how a task branch was updated.
+func printRebasedTask(w io.Writer, result usecase.RebasedTask) {
+	switch result.Outcome {
+	case usecase.RebaseUpdated:
+		_, _ = fmt.Fprintf(w, "#%d: updated onto %s\n", result.TaskID, result.BaseBranch)
+	case usecase.RebaseUpToDate:
+		_, _ = fmt.Fprintf(w, "#%d: up to date with %s\n", result.TaskID, result.BaseBranch)
+	case usecase.RebaseConflict:
+		notified := ""
+		if result.Notified {
+			notified = " (session notified)"
+		}
+		_, _ = fmt.Fprintf(w, "#%d: conflicts with %s%s\n", result.TaskID, result.BaseBranch, notified)
+		for _, file := range result.Conflicts {
+			_, _ = fmt.Fprintf(w, "    - %s\n", file)
+		}
+	case usecase.RebaseSkipped:
+		_, _ = fmt.Fprintf(w, "#%d: skipped (%s)\n", result.TaskID, result.Reason)
+	case usecase.RebaseFailed:
+		_, _ = fmt.Fprintf(w, "#%d: failed: %s\n", result.TaskID, result.Reason)
+	}
+}
+
+// newPRCommand creates the pr command for opening a pull request for a task.
+func newPRCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		base string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "pr <id>",
+		Short: "Push task branch and create or update its pull request",
+		Long: `Push the task branch and open a GitHub pull request for it.
+
+The pull request title and description are taken from the task. If the task
+is linked to an issue, the description references it so that merging the
+pull request closes the issue.
+
+If the task already has a pull request (or an open pull request exists for
+the branch), it is updated instead of creating a new one. The PR number is
+stored on the task.
+
+Requires the GitHub CLI (gh) to be installed and authenticated.
+
+Examples:
+  # Create a pull request for task #1
+  crew pr 1
+
+  # Target a different base branch
+  crew pr 1 --base develop`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse task ID
+			taskID, err := parseTaskID(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid task ID: %w", err)
+			}
+
+			// Execute use case
+			uc := c.CreatePRUseCase()
+			out, err := uc.Execute(cmd.Context(), usecase.CreatePRInput{
+				TaskID:     taskID,
+				BaseBranch: opts.base,
+			})
+			if err != nil {
+				return err
+			}
+
+			if out.Created {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Created PR #%d for task #%d\n", out.Task.PR, out.Task.ID)
+			} else {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Updated PR #%d for task #%d\n", out.Task.PR, out.Task.ID)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.base, "base", "", "Base branch for the pull request (default: task's base branch or default branch)")
+
+	return cmd
+}
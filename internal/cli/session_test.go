@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintRouteExplanation(t *testing.T) {
+	task := &domain.Task{ID: 3, Title: "Refactor loader"}
+
+	t.Run("rule fires", func(t *testing.T) {
+		var buf bytes.Buffer
+		printRouteExplanation(&buf, &usecase.RouteTaskOutput{
+			Task:       task,
+			Size:       domain.TaskSizeSmall,
+			SizeReason: "2 words in title and description",
+			Rules:      3,
+			Decision: domain.RoutingDecision{
+				Agent: "codex",
+				Rule:  "refactors",
+				Evaluations: []domain.RuleEvaluation{
+					{Name: "docs", Agent: "claude-fast", Details: []string{"no label in [docs]"}},
+					{Name: "refactors", Agent: "codex", Details: []string{"title matches /(?i)refactor/"}, Matched: true},
+				},
+			},
+		})
+
+		assert.Equal(t, `Task #3: Refactor loader
+Estimated size: small (2 words in title and description)
+Routing rules:
+  1. docs -> claude-fast: no match (no label in [docs])
+  2. refactors -> codex: MATCH (title matches /(?i)refactor/)
+  (1 later rules not evaluated)
+Agent: codex (rule "refactors")
+`, buf.String())
+	})
+
+	t.Run("no rules", func(t *testing.T) {
+		var buf bytes.Buffer
+		printRouteExplanation(&buf, &usecase.RouteTaskOutput{
+			Task:       task,
+			Size:       domain.TaskSizeSmall,
+			SizeReason: "2 words in title and description",
+			Decision:   domain.RoutingDecision{Agent: "claude"},
+		})
+
+		assert.Contains(t, buf.String(), "No routing rules configured ([[agents.routing]])\n")
+		assert.Contains(t, buf.String(), "Agent: claude (agents.worker_default)\n")
+	})
+
+	t.Run("explicit agent", func(t *testing.T) {
+		var buf bytes.Buffer
+		printRouteExplanation(&buf, &usecase.RouteTaskOutput{
+			Task:     task,
+			Size:     domain.TaskSizeSmall,
+			Rules:    2,
+			Explicit: true,
+			Decision: domain.RoutingDecision{Agent: "opencode"},
+		})
+
+		assert.Contains(t, buf.String(), "Agent: opencode (given explicitly; routing rules are not evaluated)\n")
+		assert.NotContains(t, buf.String(), "Routing rules:")
+	})
+}
@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+	"github.com/runoshun/git-crew/v2/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+// newSnapshotCommand creates the snapshot command for saving and restoring task state.
+func newSnapshotCommand(c *app.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Save and restore the task board",
+		Long: `Save and restore snapshots of the task board.
+
+A snapshot captures all tasks and comments of the current namespace and is
+associated with a commit SHA (the default branch by default). Use snapshots
+to roll the task board back after an unwanted bulk edit.`,
+		// No RunE: shows subcommand list when called without arguments
+	}
+
+	// Add subcommands
+	cmd.AddCommand(newSnapshotSaveCommand(c))
+	cmd.AddCommand(newSnapshotListCommand(c))
+	cmd.AddCommand(newSnapshotRestoreCommand(c))
+	cmd.AddCommand(newSnapshotPruneCommand(c))
+
+	return cmd
+}
+
+// newSnapshotSaveCommand creates the snapshot save subcommand.
+func newSnapshotSaveCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		Rev string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "save",
+		Short: "Save the current task board",
+		Long: `Save the current task board as a snapshot.
+
+The snapshot is associated with the commit SHA of --rev (default: the
+default branch). Multiple snapshots for the same SHA get increasing
+sequence numbers.
+
+Examples:
+  # Snapshot the task board before a bulk edit
+  crew snapshot save
+
+  # Associate the snapshot with a specific revision
+  crew snapshot save --rev v1.2.0`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			uc := c.SaveSnapshotUseCase()
+			out, err := uc.Execute(cmd.Context(), usecase.SaveSnapshotInput{Rev: opts.Rev})
+			if err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Saved snapshot %s\n", out.Snapshot.Ref)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Rev, "rev", "", "Revision to associate the snapshot with (default: default branch)")
+
+	return cmd
+}
+
+// newSnapshotListCommand creates the snapshot list subcommand.
+func newSnapshotListCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		SHA string
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List snapshots",
+		Long: `List snapshots of the task board, newest first.
+
+Examples:
+  # List all snapshots
+  crew snapshot list
+
+  # List snapshots for a commit
+  crew snapshot list --sha 1a2b3c4d...`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			uc := c.ListSnapshotsUseCase()
+			out, err := uc.Execute(cmd.Context(), usecase.ListSnapshotsInput{MainSHA: opts.SHA})
+			if err != nil {
+				return err
+			}
+
+			w := cmd.OutOrStdout()
+			if len(out.Snapshots) == 0 {
+				_, _ = fmt.Fprintln(w, "No snapshots")
+				return nil
+			}
+
+			tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+			defer func() { _ = tw.Flush() }()
+			_, _ = fmt.Fprintln(tw, "REF\tCREATED")
+			for _, snap := range out.Snapshots {
+				created := "-"
+				if !snap.CreatedAt.IsZero() {
+					created = snap.CreatedAt.Local().Format(time.DateTime)
+				}
+				_, _ = fmt.Fprintf(tw, "%s\t%s\n", snap.Ref, created)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.SHA, "sha", "", "Only list snapshots for this commit SHA")
+
+	return cmd
+}
+
+// newSnapshotRestoreCommand creates the snapshot restore subcommand.
+func newSnapshotRestoreCommand(c *app.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore <ref>",
+		Short: "Restore the task board from a snapshot",
+		Long: `Restore the task board from a snapshot.
+
+The current task board is saved as a new snapshot before restoring, so a
+restore can itself be undone. The SHA part of the ref may be abbreviated
+(e.g., 1a2b3c4_002). Task IDs are never reused after a restore.
+
+Examples:
+  # Roll back to a snapshot
+  crew snapshot restore 1a2b3c4_002`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			uc := c.RestoreSnapshotUseCase()
+			out, err := uc.Execute(cmd.Context(), usecase.RestoreSnapshotInput{Ref: args[0]})
+			if err != nil {
+				return err
+			}
+
+			w := cmd.OutOrStdout()
+			_, _ = fmt.Fprintf(w, "Saved current state as snapshot %s\n", out.Backup.Ref)
+			_, _ = fmt.Fprintf(w, "Restored snapshot %s\n", out.Restored.Ref)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// newSnapshotPruneCommand creates the snapshot prune subcommand.
+func newSnapshotPruneCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		Keep int
+	}
+
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Remove old snapshots",
+		Long: `Remove old snapshots, keeping the most recent ones for each commit SHA.
+
+Examples:
+  # Keep the 5 most recent snapshots per commit
+  crew snapshot prune
+
+  # Keep only the latest snapshot per commit
+  crew snapshot prune --keep 1`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			uc := c.PruneSnapshotsUseCase()
+			out, err := uc.Execute(cmd.Context(), usecase.PruneSnapshotsInput{Keep: opts.Keep})
+			if err != nil {
+				return err
+			}
+
+			w := cmd.OutOrStdout()
+			for _, snap := range out.Removed {
+				_, _ = fmt.Fprintf(w, "Removed snapshot %s\n", snap.Ref)
+			}
+			_, _ = fmt.Fprintf(w, "Pruned %d snapshot(s)\n", len(out.Removed))
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.Keep, "keep", usecase.DefaultSnapshotKeep, "Number of snapshots to keep per commit SHA")
+
+	return cmd
+}
@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+// newStatsCommand creates the stats command for reporting agent usage.
+func newStatsCommand(c *app.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show statistics about agent sessions",
+		Long: `Show statistics about agent sessions.
+
+When a session ends, crew parses the usage summary the agent printed
+(Claude Code -p --output-format json, Codex token summaries, opencode stats)
+and attaches it to the task as a "usage" comment.`,
+		// No RunE: shows subcommand list when called without arguments
+	}
+
+	// Add subcommands
+	cmd.AddCommand(newStatsCostCommand(c))
+
+	return cmd
+}
+
+// newStatsCostCommand creates the stats cost subcommand.
+func newStatsCostCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		Since string
+		By    string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "cost",
+		Short: "Show token usage and cost per agent, label, or task",
+		Long: `Show the token usage and cost recorded for agent sessions.
+
+Usage is recorded when a worker or reviewer session ends, from the summary
+the agent CLI printed. Agents that do not print a summary (for example,
+interactive sessions) are not counted. Costs are only known for agents that
+report them; the COST column shows "-" otherwise.
+
+With --by label, a session of a task with several labels is counted under
+each label; the TOTAL row counts it once.
+
+Examples:
+  # Cost per agent
+  crew stats cost
+
+  # Compare the cost of labels over the last week
+  crew stats cost --by label --since 7d
+
+  # Most expensive tasks since a date
+  crew stats cost --by task --since 2026-01-31`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			since, err := parseSince(opts.Since, c.Clock.Now())
+			if err != nil {
+				return err
+			}
+
+			uc := c.StatsCostUseCase()
+			out, err := uc.Execute(cmd.Context(), usecase.StatsCostInput{
+				Since: since,
+				By:    domain.UsageGroupBy(opts.By),
+			})
+			if err != nil {
+				return err
+			}
+
+			printCostStats(cmd.OutOrStdout(), domain.UsageGroupBy(opts.By), out)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Since, "since", "", "Only count usage recorded since a duration ago (7d, 12h) or a date (2026-01-31)")
+	cmd.Flags().StringVar(&opts.By, "by", string(domain.UsageByAgent), "Group by agent, label, or task")
+
+	return cmd
+}
+
+// printCostStats prints the aggregated usage as a table.
+func printCostStats(w io.Writer, by domain.UsageGroupBy, out *usecase.StatsCostOutput) {
+	if out.Total.Sessions == 0 {
+		_, _ = fmt.Fprintln(w, "No usage recorded")
+		return
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	defer func() { _ = tw.Flush() }()
+	_, _ = fmt.Fprintf(tw, "%s\tSESSIONS\tTASKS\tINPUT\tOUTPUT\tCACHE\tCOST\n", strings.ToUpper(string(by)))
+	for _, group := range out.Groups {
+		key := group.Key
+		if by == domain.UsageByTask {
+			key = "#" + group.Key + " " + group.Title
+		}
+		printCostRow(tw, key, group)
+	}
+	printCostRow(tw, "TOTAL", out.Total)
+}
+
+func printCostRow(w io.Writer, key string, group usecase.CostGroup) {
+	cost := "-"
+	if group.Usage.HasCost {
+		cost = domain.FormatCost(group.Usage.CostUSD)
+	}
+	_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s\t%s\n",
+		key,
+		group.Sessions,
+		group.Tasks,
+		domain.FormatTokens(group.Usage.InputTokens),
+		domain.FormatTokens(group.Usage.OutputTokens),
+		domain.FormatTokens(group.Usage.CacheReadTokens+group.Usage.CacheWriteTokens),
+		cost,
+	)
+}
+
+// parseSince parses a --since value: a duration before now (e.g., 7d, 2w, 12h)
+// or a date or timestamp (2026-01-31, RFC 3339). An empty value means no limit.
+func parseSince(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if n, err := strconv.Atoi(strings.TrimSuffix(value, suffix)); err == nil && strings.HasSuffix(value, suffix) && n >= 0 {
+			return now.Add(-time.Duration(n) * unit), nil
+		}
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --since value %q (expected a duration such as 7d or 12h, or a date such as 2026-01-31)", value)
+}
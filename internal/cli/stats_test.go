@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStatsCostCommand_ByTask(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Fix typo", Status: domain.StatusDone}
+	repo.Comments[1] = []domain.Comment{
+		domain.UsageRecord{
+			Time:  time.Now(),
+			Agent: "claude-fast",
+			Usage: domain.Usage{InputTokens: 1200, OutputTokens: 300, CostUSD: 0.5, HasCost: true},
+		}.Comment(),
+	}
+	container := newTestContainer(repo)
+
+	cmd := newStatsCostCommand(container)
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetArgs([]string{"--by", "task", "--since", "1d"})
+
+	err := cmd.Execute()
+
+	require.NoError(t, err)
+	output := buf.String()
+	assert.Contains(t, output, "TASK")
+	assert.Regexp(t, `#1 Fix typo\s+1\s+1\s+1,200\s+300\s+0\s+\$0\.50`, output)
+	assert.Regexp(t, `TOTAL\s+1\s+1`, output)
+}
+
+func TestNewStatsCostCommand_NoUsage(t *testing.T) {
+	container := newTestContainer(testutil.NewMockTaskRepository())
+
+	cmd := newStatsCostCommand(container)
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetArgs([]string{})
+
+	err := cmd.Execute()
+
+	require.NoError(t, err)
+	assert.Equal(t, "No usage recorded\n", buf.String())
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		want    time.Time
+		value   string
+		wantErr bool
+	}{
+		{value: "", want: time.Time{}},
+		{value: "7d", want: now.Add(-7 * 24 * time.Hour)},
+		{value: "2w", want: now.Add(-14 * 24 * time.Hour)},
+		{value: "90m", want: now.Add(-90 * time.Minute)},
+		{value: "2026-03-01T00:00:00Z", want: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{value: "2026-03-01", want: time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local)},
+		{value: "yesterday", wantErr: true},
+		{value: "-1d", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseSince(tt.value, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %v, want %v", got, tt.want)
+		})
+	}
+}
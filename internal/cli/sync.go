@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+	"github.com/runoshun/git-crew/v2/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+// newSyncCommand creates the sync command for sharing task boards through the git remote.
+func newSyncCommand(c *app.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Share task boards through the git remote",
+		Long: `Share task boards with teammates through the git remote (origin).
+
+Each namespace is published as the ref refs/crew-sync/<namespace>.
+Pulling merges remote changes task by task: a change made on only one side
+is kept, and when both sides changed the same task the most recently
+modified version wins.
+
+Pulled namespaces are shown by 'crew list' alongside your own tasks.`,
+		// No RunE: shows subcommand list when called without arguments
+	}
+
+	// Add subcommands
+	cmd.AddCommand(newSyncPushCommand(c))
+	cmd.AddCommand(newSyncPullCommand(c))
+	cmd.AddCommand(newSyncNamespacesCommand(c))
+
+	return cmd
+}
+
+// newSyncPushCommand creates the sync push subcommand.
+func newSyncPushCommand(c *app.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "push",
+		Short: "Publish your task board",
+		Long: `Publish your namespace to the remote.
+
+Remote changes are merged first. Namespaces pulled from teammates are
+pushed too, so your edits to their tasks are shared.
+
+Examples:
+  crew sync push`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			uc := c.PushTasksUseCase()
+			if _, err := uc.Execute(cmd.Context(), usecase.PushTasksInput{}); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Pushed tasks")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// newSyncPullCommand creates the sync pull subcommand.
+func newSyncPullCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		Namespaces []string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "pull",
+		Short: "Merge task boards from the remote",
+		Long: `Fetch namespaces from the remote and merge them into the local task board.
+
+By default all namespaces on the remote are pulled.
+
+Examples:
+  # Pull every teammate's task board
+  crew sync pull
+
+  # Pull a single namespace
+  crew sync pull --namespace alice`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			uc := c.PullTasksUseCase()
+			out, err := uc.Execute(cmd.Context(), usecase.PullTasksInput{Namespaces: opts.Namespaces})
+			if err != nil {
+				return err
+			}
+
+			w := cmd.OutOrStdout()
+			if len(out.Namespaces) == 0 {
+				_, _ = fmt.Fprintln(w, "No namespaces on remote")
+				return nil
+			}
+			for _, namespace := range out.Namespaces {
+				_, _ = fmt.Fprintf(w, "Pulled namespace %s\n", namespace)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&opts.Namespaces, "namespace", "n", nil, "Namespace to pull (can specify multiple)")
+
+	return cmd
+}
+
+// newSyncNamespacesCommand creates the sync namespaces subcommand.
+func newSyncNamespacesCommand(c *app.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "namespaces",
+		Short: "List namespaces on the remote",
+		Long: `List the task namespaces published on the remote.
+
+Examples:
+  crew sync namespaces`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			uc := c.ListNamespacesUseCase()
+			out, err := uc.Execute(cmd.Context(), usecase.ListNamespacesInput{})
+			if err != nil {
+				return err
+			}
+
+			w := cmd.OutOrStdout()
+			if len(out.Namespaces) == 0 {
+				_, _ = fmt.Fprintln(w, "No namespaces on remote")
+				return nil
+			}
+			for _, namespace := range out.Namespaces {
+				_, _ = fmt.Fprintln(w, namespace)
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -31,6 +31,8 @@ func newNewCommand(c *app.Container) *cobra.Command {
 		Issue       int
 		SkipReview  bool
 		DryRun      bool
+		Stack       bool
+		Force       bool
 	}
 
 	cmd := &cobra.Command{
@@ -48,9 +50,19 @@ Examples:
   # Create a sub-task under task #1
   crew new --parent 1 --title "OAuth2.0 implementation"
 
-  # Create a task linked to a GitHub issue
+  # Stack a sub-task on task #1's branch (its worktree starts from crew-1;
+  # merging task #1 later moves it onto task #1's base branch)
+  crew new --parent 1 --stack --title "Migrate callers"
+
+  # Create a task from a GitHub issue (title, body and labels are fetched)
+  crew new --issue 42
+
+  # Create a task linked to a GitHub issue with a custom title
   crew new --title "Fix login bug" --issue 42
 
+  # Link an issue that another active task is already linked to
+  crew new --title "Follow-up for login bug" --issue 42 --force
+
   # Create a task with labels
   crew new --title "Add feature" --label feature --label urgent
 
@@ -93,8 +105,8 @@ File format for --from:
 				return createTasksFromFile(cmd, c, opts.From, opts.Base, opts.DryRun)
 			}
 
-			// Require --title when not using --from
-			if opts.Title == "" {
+			// Require --title when not using --from or --issue
+			if opts.Title == "" && opts.Issue == 0 {
 				return fmt.Errorf("required flag(s) \"title\" not set")
 			}
 
@@ -106,6 +118,8 @@ File format for --from:
 				Issue:       opts.Issue,
 				Labels:      opts.Labels,
 				BaseBranch:  opts.Base,
+				Stack:       opts.Stack,
+				Force:       opts.Force,
 			}
 
 			// Set parent ID if specified
@@ -131,15 +145,18 @@ File format for --from:
 	}
 
 	// Flags (--title is conditionally required based on --from)
-	cmd.Flags().StringVar(&opts.Title, "title", "", "Task title (required unless --from is used)")
+	cmd.Flags().StringVar(&opts.Title, "title", "", "Task title (required unless --from or --issue is used)")
 	cmd.Flags().StringVar(&opts.Description, "body", "", "Task description")
 	cmd.Flags().IntVar(&opts.ParentID, "parent", 0, "Parent task ID (creates a sub-task)")
-	cmd.Flags().IntVar(&opts.Issue, "issue", 0, "Linked GitHub issue number")
+	cmd.Flags().IntVar(&opts.Issue, "issue", 0, "Linked GitHub issue number (fetches missing title/body and labels)")
 	cmd.Flags().StringArrayVar(&opts.Labels, "label", nil, "Labels (can specify multiple)")
 	cmd.Flags().StringVar(&opts.Base, "base", "", "Base branch for worktree (default: current branch)")
 	cmd.Flags().BoolVar(&opts.SkipReview, "skip-review", false, "Skip review on task completion (go directly to done)")
 	cmd.Flags().StringVar(&opts.From, "from", "", "Create tasks from a Markdown file")
 	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Preview tasks without creating (requires --from)")
+	cmd.Flags().BoolVar(&opts.Stack, "stack", false, "Base the task on the parent task's branch (requires --parent)")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Link --issue even if an active task is already linked to it")
+	cmd.MarkFlagsMutuallyExclusive("stack", "base")
 
 	return cmd
 }
@@ -310,7 +327,9 @@ Examples:
 	return cmd
 }
 
-// formatTaskStatus formats status with optional elapsed time for in_progress.
+// formatTaskStatus formats status with optional elapsed time for in_progress,
+// the stall reason of stalled sessions and the restart count of automatically
+// restarted tasks.
 func formatTaskStatus(task *domain.Task, clock domain.Clock) string {
 	statusStr := task.Status.Display()
 	parts := []string{}
@@ -324,6 +343,12 @@ func formatTaskStatus(task *domain.Task, clock domain.Clock) string {
 	if task.ExecutionSubstate != "" {
 		parts = append(parts, task.ExecutionSubstate.Display())
 	}
+	if task.Stalled != "" {
+		parts = append(parts, task.Stalled.Display())
+	}
+	if task.RestartCount > 0 {
+		parts = append(parts, fmt.Sprintf("restart %d", task.RestartCount))
+	}
 	if len(parts) > 0 {
 		statusStr = fmt.Sprintf("%s (%s)", statusStr, strings.Join(parts, ", "))
 	}
@@ -500,7 +525,8 @@ func newShowCommand(c *app.Container) *cobra.Command {
 If no ID is provided, the task ID is auto-detected from the current branch name.
 The branch must follow the naming convention: crew-<id> or crew-<id>-gh-<issue>
 
-By default, this command outputs the task Markdown file as-is.
+By default, this command outputs the task Markdown file as-is, followed by
+the latest result of each completion gate ([[complete.gates]]) if any ran.
 Use --json for structured output (supports --comments-by and --last-review).
 
 Examples:
@@ -543,6 +569,13 @@ Examples:
 				if _, copyErr := io.Copy(cmd.OutOrStdout(), file); copyErr != nil {
 					return fmt.Errorf("write task markdown: %w", copyErr)
 				}
+				comments, commentsErr := c.Tasks.GetComments(task.ID)
+				if commentsErr != nil {
+					return fmt.Errorf("get comments: %w", commentsErr)
+				}
+				printStackedOn(cmd.OutOrStdout(), task)
+				printGateResults(cmd.OutOrStdout(), domain.LatestGateResults(comments))
+				printUsageTotal(cmd.OutOrStdout(), domain.UsageRecords(comments))
 				return nil
 			}
 
@@ -559,12 +592,20 @@ Examples:
 
 			// Print output
 			type jsonComment struct {
-				Text     string             `json:"text"`
-				Author   string             `json:"author,omitempty"`
-				Type     domain.CommentType `json:"type,omitempty"`
-				Metadata map[string]string  `json:"metadata,omitempty"`
-				Time     time.Time          `json:"time"`
-				Tags     []string           `json:"tags,omitempty"`
+				Text     string                 `json:"text"`
+				Author   string                 `json:"author,omitempty"`
+				Type     domain.CommentType     `json:"type,omitempty"`
+				Metadata map[string]string      `json:"metadata,omitempty"`
+				Time     time.Time              `json:"time"`
+				Tags     []string               `json:"tags,omitempty"`
+				Findings []domain.ReviewFinding `json:"findings,omitempty"`
+			}
+			type jsonGate struct {
+				Time     time.Time         `json:"time"`
+				Name     string            `json:"name"`
+				Status   domain.GateStatus `json:"status"`
+				Duration string            `json:"duration"`
+				Required bool              `json:"required"`
 			}
 			type jsonTask struct {
 				Created           time.Time                `json:"created"`
@@ -577,13 +618,17 @@ Examples:
 				Status            domain.Status            `json:"status"`
 				StatusDisplay     string                   `json:"statusDisplay"`
 				ExecutionSubstate domain.ExecutionSubstate `json:"execution_substate,omitempty"`
+				Stalled           domain.StallReason       `json:"stalled,omitempty"`
 				Title             string                   `json:"title"`
 				Description       string                   `json:"description"`
 				Labels            []string                 `json:"labels"`
+				DependsOn         []int                    `json:"dependsOn,omitempty"`
 				Comments          []jsonComment            `json:"comments"`
+				Gates             []jsonGate               `json:"gates,omitempty"`
 				ID                int                      `json:"id"`
 				Issue             int                      `json:"issue"`
 				ReviewCount       int                      `json:"reviewCount"`
+				RestartCount      int                      `json:"restartCount,omitempty"`
 			}
 
 			jt := jsonTask{
@@ -595,11 +640,14 @@ Examples:
 				Status:            out.Task.Status,
 				StatusDisplay:     out.Task.Status.Display(),
 				ExecutionSubstate: out.Task.ExecutionSubstate,
+				Stalled:           out.Task.Stalled,
 				Title:             out.Task.Title,
 				Labels:            out.Task.Labels,
+				DependsOn:         out.Task.DependsOn,
 				ID:                out.Task.ID,
 				Issue:             out.Task.Issue,
 				ReviewCount:       out.Task.ReviewCount,
+				RestartCount:      out.Task.RestartCount,
 				LastReviewIsLGTM:  out.Task.LastReviewIsLGTM,
 				Comments:          make([]jsonComment, len(out.Comments)),
 			}
@@ -620,9 +668,20 @@ Examples:
 					Type:     c.Type,
 					Tags:     c.Tags,
 					Metadata: c.Metadata,
+					Findings: c.Findings,
 				}
 			}
 
+			for _, g := range out.Gates {
+				jt.Gates = append(jt.Gates, jsonGate{
+					Time:     g.Time,
+					Name:     g.Name,
+					Status:   g.Status,
+					Duration: g.Duration.String(),
+					Required: g.Required,
+				})
+			}
+
 			enc := json.NewEncoder(cmd.OutOrStdout())
 			enc.SetIndent("", "  ")
 			return enc.Encode(jt)
@@ -636,6 +695,48 @@ Examples:
 	return cmd
 }
 
+// printStackedOn prints the task a stacked task is based on after the task markdown.
+func printStackedOn(w io.Writer, task *domain.Task) {
+	stackedOn, stacked := task.StackedOn()
+	if !stacked {
+		return
+	}
+	_, _ = fmt.Fprintf(w, "\n\nStacked on: #%d (%s)\n", stackedOn, task.BaseBranch)
+}
+
+// printGateResults prints the latest completion gate results after the task markdown.
+func printGateResults(w io.Writer, results []domain.GateResult) {
+	if len(results) == 0 {
+		return
+	}
+	_, _ = fmt.Fprintln(w, "\n\nGates:")
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	defer func() { _ = tw.Flush() }()
+	for _, result := range results {
+		required := "required"
+		if !result.Required {
+			required = "optional"
+		}
+		_, _ = fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n", result.Name, result.Status.Display(), result.Duration, required)
+	}
+}
+
+// printUsageTotal prints the summed usage of the task's agent sessions.
+func printUsageTotal(w io.Writer, records []domain.UsageRecord) {
+	if len(records) == 0 {
+		return
+	}
+	var total domain.Usage
+	for _, record := range records {
+		total.Add(record.Usage)
+	}
+	sessions := "1 session"
+	if len(records) != 1 {
+		sessions = fmt.Sprintf("%d sessions", len(records))
+	}
+	_, _ = fmt.Fprintf(w, "\n\nUsage: %s (%s)\n", total.Summary(), sessions)
+}
+
 // formatTaskTitle adds [BLOCKED] prefix if the task is blocked.
 func formatTaskTitle(task *domain.Task) string {
 	if task.Status == domain.StatusError && strings.HasPrefix(task.BlockReason, "corrupted:") {
@@ -749,20 +850,22 @@ func parseTaskID(s string) (int, error) {
 // newEditCommand creates the edit command for editing task information.
 func newEditCommand(c *app.Container) *cobra.Command {
 	var opts struct {
-		Title        string
-		Description  string
-		Status       string
-		Labels       string
-		From         string
-		Block        string
-		AddLabels    []string
-		RemoveLabels []string
-		IfStatus     []string
-		ParentID     int
-		SkipReview   bool
-		NoSkipReview bool
-		NoParent     bool
-		Unblock      bool
+		Title           string
+		Description     string
+		Status          string
+		Labels          string
+		From            string
+		Block           string
+		AddLabels       []string
+		RemoveLabels    []string
+		IfStatus        []string
+		DependsOn       []int
+		RemoveDependsOn []int
+		ParentID        int
+		SkipReview      bool
+		NoSkipReview    bool
+		NoParent        bool
+		Unblock         bool
 	}
 
 	cmd := &cobra.Command{
@@ -830,6 +933,13 @@ Examples:
   # Unblock a task (allow starting)
   crew edit 1 --unblock
 
+  # Add dependencies (task stays blocked until #2 and #3 are merged)
+  crew edit 4 --depends-on 2 --depends-on 3
+  crew edit 4 --depends-on 2,3
+
+  # Remove a dependency
+  crew edit 4 --rm-depends-on 3
+
   # Edit task from a file (updates title, body, and labels)
   crew edit 1 --from task.md
 
@@ -865,7 +975,9 @@ File format for --from:
 				cmd.Flags().Changed("parent") ||
 				cmd.Flags().Changed("no-parent") ||
 				cmd.Flags().Changed("block") ||
-				cmd.Flags().Changed("unblock")
+				cmd.Flags().Changed("unblock") ||
+				len(opts.DependsOn) > 0 ||
+				len(opts.RemoveDependsOn) > 0
 
 			if !hasFlags {
 				// Editor mode: open task in editor
@@ -875,9 +987,11 @@ File format for --from:
 			// Flag mode: update task directly
 			// Build input
 			input := usecase.EditTaskInput{
-				TaskID:       taskID,
-				AddLabels:    opts.AddLabels,
-				RemoveLabels: opts.RemoveLabels,
+				TaskID:          taskID,
+				AddLabels:       opts.AddLabels,
+				RemoveLabels:    opts.RemoveLabels,
+				AddDependsOn:    opts.DependsOn,
+				RemoveDependsOn: opts.RemoveDependsOn,
 			}
 
 			// Set optional fields only if provided
@@ -955,6 +1069,8 @@ File format for --from:
 	cmd.Flags().StringVar(&opts.Block, "block", "", "Block task with reason (prevents starting)")
 	cmd.Flags().BoolVar(&opts.Unblock, "unblock", false, "Unblock task (allow starting)")
 	cmd.MarkFlagsMutuallyExclusive("block", "unblock")
+	cmd.Flags().IntSliceVar(&opts.DependsOn, "depends-on", nil, "Add dependency on another task (blocks until it is merged, can specify multiple)")
+	cmd.Flags().IntSliceVar(&opts.RemoveDependsOn, "rm-depends-on", nil, "Remove dependency on another task (can specify multiple)")
 	cmd.Flags().StringVar(&opts.From, "from", "", "Edit task from a Markdown file (updates title, body, and labels)")
 
 	return cmd
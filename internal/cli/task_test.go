@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 	"time"
 
@@ -299,6 +300,9 @@ func TestNewShowCommand_WithComments(t *testing.T) {
 		{
 			Text: "First comment",
 			Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			Findings: []domain.ReviewFinding{
+				{File: "api.go", Line: 10, EndLine: 12, Severity: domain.ReviewSeverityBlocking, Message: "Handle the error"},
+			},
 		},
 	}
 	container := newTestContainer(repo)
@@ -316,6 +320,8 @@ func TestNewShowCommand_WithComments(t *testing.T) {
 	assert.NoError(t, err)
 	output := buf.String()
 	assert.Contains(t, output, "\"text\": \"First comment\"")
+	assert.Contains(t, output, "\"end_line\": 12")
+	assert.Contains(t, output, "\"severity\": \"blocking\"")
 }
 
 func TestNewShowCommand_JSON(t *testing.T) {
@@ -484,6 +490,75 @@ func TestPrintTaskList_WithParent(t *testing.T) {
 	assert.Contains(t, output, "Child task")
 }
 
+func TestPrintTaskList_StackedKeepsParentNumeric(t *testing.T) {
+	var buf bytes.Buffer
+	clock := &testutil.MockClock{NowTime: time.Now()}
+
+	parentID := 1
+	tasks := []*domain.Task{
+		{ID: 2, Namespace: "test", ParentID: &parentID, BaseBranch: "crew-1", Title: "Stacked child", Status: domain.StatusTodo},
+		{ID: 3, Namespace: "test", BaseBranch: "crew-2-gh-7", Title: "Stacked elsewhere", Status: domain.StatusTodo},
+	}
+
+	printTaskList(&buf, tasks, clock)
+
+	// PARENT stays a plain ID so the output can be parsed
+	lines := strings.Split(buf.String(), "\n")
+	assert.Equal(t, "1", strings.Fields(lines[1])[2])
+	assert.Equal(t, "-", strings.Fields(lines[2])[2])
+	assert.NotContains(t, buf.String(), "(stacked")
+}
+
+func TestPrintStackedOn(t *testing.T) {
+	var buf bytes.Buffer
+	parentID := 1
+
+	printStackedOn(&buf, &domain.Task{ID: 2, ParentID: &parentID, BaseBranch: "crew-1-gh-7"})
+
+	assert.Equal(t, "\n\nStacked on: #1 (crew-1-gh-7)\n", buf.String())
+}
+
+func TestPrintStackedOn_NotStacked(t *testing.T) {
+	var buf bytes.Buffer
+
+	printStackedOn(&buf, &domain.Task{ID: 2, BaseBranch: "main"})
+
+	assert.Empty(t, buf.String())
+}
+
+func TestPrintGateResults(t *testing.T) {
+	var buf bytes.Buffer
+
+	printGateResults(&buf, []domain.GateResult{
+		{Name: "lint", Status: domain.GateStatusPassed, Duration: 1200 * time.Millisecond, Required: true},
+		{Name: "e2e", Status: domain.GateStatusTimedOut, Duration: time.Minute, Required: false},
+	})
+
+	output := buf.String()
+	assert.Contains(t, output, "Gates:")
+	assert.Regexp(t, `lint\s+passed\s+1.2s\s+required`, output)
+	assert.Regexp(t, `e2e\s+timed out\s+1m0s\s+optional`, output)
+}
+
+func TestPrintGateResults_None(t *testing.T) {
+	var buf bytes.Buffer
+
+	printGateResults(&buf, nil)
+
+	assert.Empty(t, buf.String())
+}
+
+func TestPrintUsageTotal(t *testing.T) {
+	var buf bytes.Buffer
+
+	printUsageTotal(&buf, []domain.UsageRecord{
+		{Agent: "claude-fast", Usage: domain.Usage{InputTokens: 1000, OutputTokens: 100, CostUSD: 0.25, HasCost: true}},
+		{Agent: "codex", Usage: domain.Usage{InputTokens: 500}},
+	})
+
+	assert.Equal(t, "\n\nUsage: 1,500 in / 100 out tokens, $0.25 (2 sessions)\n", buf.String())
+}
+
 func TestPrintTaskList_WithAgent(t *testing.T) {
 	var buf bytes.Buffer
 	clock := &testutil.MockClock{NowTime: time.Now()}
@@ -547,6 +622,48 @@ func TestPrintTaskList_InProgressWithElapsed(t *testing.T) {
 	assert.Contains(t, output, "In Progress (1h)")
 }
 
+func TestPrintTaskList_Stalled(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	var buf bytes.Buffer
+	clock := &testutil.MockClock{NowTime: now}
+
+	tasks := []*domain.Task{
+		{
+			ID:                1,
+			Title:             "Stalled task",
+			Status:            domain.StatusInProgress,
+			Started:           now.Add(-time.Hour),
+			Session:           "crew-1",
+			ExecutionSubstate: domain.SubstateAwaitingPermission,
+			Stalled:           domain.StallPermission,
+		},
+	}
+
+	printTaskList(&buf, tasks, clock)
+
+	output := buf.String()
+	assert.Contains(t, output, "In Progress (1h, awaiting_permission, stalled: permission)")
+}
+
+func TestPrintTaskList_Restarted(t *testing.T) {
+	var buf bytes.Buffer
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+
+	tasks := []*domain.Task{
+		{
+			ID:           1,
+			Title:        "Restarted task",
+			Status:       domain.StatusError,
+			RestartCount: 2,
+		},
+	}
+
+	printTaskList(&buf, tasks, clock)
+
+	output := buf.String()
+	assert.Contains(t, output, "Error (restart 2)")
+}
+
 func TestPrintTaskList_MultipleTasks(t *testing.T) {
 	var buf bytes.Buffer
 	clock := &testutil.MockClock{NowTime: time.Now()}
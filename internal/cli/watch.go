@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+	"github.com/runoshun/git-crew/v2/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+// newWatchCommand creates the watch command for stalled session detection.
+func newWatchCommand(c *app.Container) *cobra.Command {
+	var opts struct {
+		Nudge             string
+		Interval          int
+		IdleTimeout       int
+		PermissionTimeout int
+	}
+
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Detect stalled worker sessions",
+		Long: `Watch running sessions and flag the ones that stalled.
+
+A session stalls when its screen has not changed for idle_timeout seconds,
+or when it has been awaiting permission for permission_timeout seconds.
+Stalled tasks are marked in 'crew list' and the TUI, the on_stalled hook
+is run, and the session is optionally nudged with a message (typed into
+the session and submitted with Enter). The mark is cleared as soon as
+the session moves again.
+
+Settings are read from the [watch] config section and can be
+overridden with flags:
+  idle_timeout        Seconds without screen changes (0 = disabled)
+  permission_timeout  Seconds in awaiting_permission (0 = disabled)
+  interval            Polling interval in seconds (default: 30)
+  nudge               Message sent to sessions stalled on an unchanged screen
+  permission_nudge    Message sent to sessions stalled on a permission prompt
+
+At least one timeout must be set. The watcher runs until interrupted (Ctrl+C).
+
+Examples:
+  # Watch with settings from config
+  crew watch
+
+  # Flag workers stuck on a permission prompt for 5 minutes
+  crew watch --permission-timeout 300
+
+  # Nudge workers whose screen has not changed for 10 minutes
+  crew watch --idle-timeout 600 --nudge "Continue with the task, or report what blocks you."`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// Setup signal handling for graceful shutdown
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			uc := c.WatchSessionsUseCase(cmd.OutOrStdout())
+			_, err := uc.Execute(ctx, usecase.WatchSessionsInput{
+				Nudge:             opts.Nudge,
+				Interval:          opts.Interval,
+				IdleTimeout:       opts.IdleTimeout,
+				PermissionTimeout: opts.PermissionTimeout,
+			})
+			return err
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.IdleTimeout, "idle-timeout", 0, "Seconds without screen changes before a session stalls (overrides watch.idle_timeout)")
+	cmd.Flags().IntVar(&opts.PermissionTimeout, "permission-timeout", 0, "Seconds in awaiting_permission before a session stalls (overrides watch.permission_timeout)")
+	cmd.Flags().IntVarP(&opts.Interval, "interval", "i", 0, "Polling interval in seconds (overrides watch.interval)")
+	cmd.Flags().StringVar(&opts.Nudge, "nudge", "", "Message sent to sessions stalled on an unchanged screen (overrides watch.nudge)")
+
+	return cmd
+}
@@ -17,14 +17,19 @@ var configTemplateContent string
 // Fields are ordered to minimize memory padding.
 type Config struct {
 	Agents       map[string]Agent `toml:"agents"` // Agent definitions from [agents.<name>]
-	Warnings     []string         `toml:"-"`
-	AgentsConfig AgentsConfig     `toml:"agents"` // Common [agents] settings
 	TUI          TUIConfig        `toml:"tui"`
-	Worktree     WorktreeConfig   `toml:"worktree"`
-	Tasks        TasksConfig      `toml:"tasks"`
+	Help         HelpConfig       `toml:"help"`
+	Hooks        HooksConfig      `toml:"hooks"`
 	Diff         DiffConfig       `toml:"diff"`
 	Log          LogConfig        `toml:"log"`
-	Help         HelpConfig       `toml:"help"`
+	Session      SessionConfig    `toml:"session"`
+	Merge        MergeConfig      `toml:"merge"`
+	AgentsConfig AgentsConfig     `toml:"agents"` // Common [agents] settings
+	Tasks        TasksConfig      `toml:"tasks"`
+	Worktree     WorktreeConfig   `toml:"worktree"`
+	Warnings     []string         `toml:"-"`
+	Scheduler    SchedulerConfig  `toml:"scheduler"`
+	Watch        WatchConfig      `toml:"watch"`
 	Complete     CompleteConfig   `toml:"complete"`
 
 	OnboardingDone bool `toml:"onboarding_done,omitempty"` // Whether onboarding has been completed
@@ -40,13 +45,16 @@ type TasksConfig struct {
 
 // AgentsConfig holds common settings for all agents from [agents] section.
 type AgentsConfig struct {
-	DefaultWorker   string   `toml:"worker_default,omitempty"`   // Default worker agent name
-	DefaultManager  string   `toml:"manager_default,omitempty"`  // Default manager agent name
-	DefaultReviewer string   `toml:"reviewer_default,omitempty"` // Default reviewer agent name
-	WorkerPrompt    string   `toml:"worker_prompt,omitempty"`    // Default prompt for all worker agents
-	ManagerPrompt   string   `toml:"manager_prompt,omitempty"`   // Default prompt for all manager agents
-	ReviewerPrompt  string   `toml:"reviewer_prompt,omitempty"`  // Default prompt for all reviewer agents
-	DisabledAgents  []string `toml:"disabled_agents,omitempty"`  // List of agent names to disable
+	DefaultWorker           string        `toml:"worker_default,omitempty"`            // Default worker agent name
+	DefaultManager          string        `toml:"manager_default,omitempty"`           // Default manager agent name
+	DefaultReviewer         string        `toml:"reviewer_default,omitempty"`          // Default reviewer agent name
+	DefaultConflictResolver string        `toml:"conflict_resolver_default,omitempty"` // Conflict resolver agent name (empty = disabled)
+	WorkerPrompt            string        `toml:"worker_prompt,omitempty"`             // Default prompt for all worker agents
+	ManagerPrompt           string        `toml:"manager_prompt,omitempty"`            // Default prompt for all manager agents
+	ReviewerPrompt          string        `toml:"reviewer_prompt,omitempty"`           // Default prompt for all reviewer agents
+	ConflictResolverPrompt  string        `toml:"conflict_resolver_prompt,omitempty"`  // Default prompt for all conflict resolver agents
+	DisabledAgents          []string      `toml:"disabled_agents,omitempty"`           // List of agent names to disable
+	Routing                 []RoutingRule `toml:"routing,omitempty"`                   // Rules picking the worker for crew start (first match wins)
 }
 
 // Role represents the role of an agent.
@@ -54,12 +62,13 @@ type Role string
 
 // Valid roles for agents.
 const (
-	RoleWorker   Role = "worker"
-	RoleReviewer Role = "reviewer"
-	RoleManager  Role = "manager"
+	RoleWorker           Role = "worker"
+	RoleReviewer         Role = "reviewer"
+	RoleManager          Role = "manager"
+	RoleConflictResolver Role = "conflict_resolver"
 )
 
-// Agent defines a unified agent configuration that can serve as worker, reviewer, or manager.
+// Agent defines a unified agent configuration that can serve as worker, reviewer, manager, or conflict resolver.
 // This replaces the previous separate Worker and Manager types.
 type Agent struct {
 	// Environment variables (for agent process)
@@ -72,7 +81,7 @@ type Agent struct {
 	CommandTemplate string `toml:"command_template,omitempty"` // Full command template (e.g., "opencode -m {{.Model}} {{.Args}} --prompt {{.Prompt}}")
 
 	// Role configuration
-	Role         Role   `toml:"role,omitempty"`          // Role: worker, reviewer, manager
+	Role         Role   `toml:"role,omitempty"`          // Role: worker, reviewer, manager, conflict_resolver
 	SystemPrompt string `toml:"system_prompt,omitempty"` // System prompt template
 	Prompt       string `toml:"prompt,omitempty"`        // User prompt template
 	Args         string `toml:"args,omitempty"`          // Additional arguments
@@ -84,6 +93,12 @@ type Agent struct {
 	// Worktree setup (for workers/reviewers)
 	SetupScript string `toml:"setup_script,omitempty"` // Setup script (replaces worktree_setup_script and exclude_patterns)
 
+	// Error recovery
+	Fallback         []string `toml:"fallback,omitempty"`            // Agents to restart the task with, in order, when a worker session ends in error
+	NoRetryExitCodes []int    `toml:"no_retry_exit_codes,omitempty"` // Exit codes never retried, besides 130 (Ctrl+C) and 143 (SIGTERM)
+	MaxRestarts      int      `toml:"max_restarts,omitempty"`        // Times to restart the same agent with --continue before falling back (default: 0)
+	RestartBackoff   int      `toml:"restart_backoff,omitempty"`     // Seconds to wait before the first restart, doubled for each further one (default: 30)
+
 	// Visibility
 	Hidden bool `toml:"hidden,omitempty"` // Hide from TUI agent list
 }
@@ -101,8 +116,9 @@ type CommandData struct {
 	Description string
 	Branch      string // Branch name (e.g., "crew-1")
 
-	// Review context (for reviewer agents)
-	PreviousReview string // Previous review result (empty on first attempt)
+	// Review context (for reviewer agents, and for workers restarted after a review)
+	PreviousReview   *ReviewFeedback // Previous review text and findings (nil on first attempt)
+	ReviewResultFile string          // Path where the reviewer writes its JSON verdict
 
 	// Runtime options
 	Model string // Model name override (e.g., "sonnet", "gpt-4o")
@@ -280,18 +296,51 @@ func (m ReviewMode) NextMode() ReviewMode {
 // CompleteConfig holds completion gate settings from [complete] section.
 // Fields are ordered to minimize memory padding.
 type CompleteConfig struct {
-	Command            string     `toml:"command,omitempty"`              // Command to run as CI gate on complete
-	ReviewMode         ReviewMode `toml:"review_mode,omitempty"`          // Review mode: auto (default), manual, auto_fix
-	ReviewSuccessRegex string     `toml:"review_success_regex,omitempty"` // Regex that must match review result (from start)
-	MaxReviews         int        `toml:"max_reviews,omitempty"`          // Maximum review attempts before completion fails
-	AutoFixMaxRetries  int        `toml:"auto_fix_max_retries,omitempty"` // Maximum retry count for auto-fix mode (default: 3)
-	ReviewModeSet      bool       `toml:"-"`                              // True if ReviewMode was explicitly set in config (not exported to TOML)
+	Command            string         `toml:"command,omitempty"`              // Command to run as CI gate on complete
+	ReviewMode         ReviewMode     `toml:"review_mode,omitempty"`          // Review mode: auto (default), manual, auto_fix
+	ReviewSuccessRegex string         `toml:"review_success_regex,omitempty"` // Regex that must match review result (from start)
+	ReviewPolicy       ReviewPolicy   `toml:"review_policy,omitempty"`        // How reviewer verdicts combine: all (default), majority, any
+	Gates              []CompleteGate `toml:"gates,omitempty"`                // Named gates from [[complete.gates]], run after command
+	Reviewers          []string       `toml:"reviewers,omitempty"`            // Reviewer agents run in parallel (default: agents.reviewer_default only)
+	MaxReviews         int            `toml:"max_reviews,omitempty"`          // Maximum review attempts before completion fails
+	AutoFixMaxRetries  int            `toml:"auto_fix_max_retries,omitempty"` // Maximum retry count for auto-fix mode (default: 3)
+	ReviewModeSet      bool           `toml:"-"`                              // True if ReviewMode was explicitly set in config (not exported to TOML)
 
 	// Deprecated: Use ReviewMode instead. Kept for backward compatibility with existing configs.
 	AutoFix    bool `toml:"auto_fix,omitempty"` // Enable auto-fix mode (run review synchronously)
 	AutoFixSet bool `toml:"-"`                  // True if AutoFix was explicitly set in config (not exported to TOML)
 }
 
+// SchedulerConfig holds settings for the task scheduler from [scheduler] section.
+// Fields are ordered to minimize memory padding.
+type SchedulerConfig struct {
+	AgentLimits map[string]int `toml:"agent_limits,omitempty"` // Per-agent concurrency caps (agent name -> max running tasks)
+	Agent       string         `toml:"agent,omitempty"`        // Agent used to start every task (default: routed per task)
+	MaxParallel int            `toml:"max_parallel,omitempty"` // Maximum number of tasks running at the same time (default: 3)
+	Interval    int            `toml:"interval,omitempty"`     // Polling interval in seconds (default: 10)
+}
+
+// WatchConfig holds settings for stalled session detection (crew watch) from [watch] section.
+// Fields are ordered to minimize memory padding.
+type WatchConfig struct {
+	Nudge             string `toml:"nudge,omitempty"`              // Message sent to a session stalled on an unchanged screen (empty = no nudge)
+	PermissionNudge   string `toml:"permission_nudge,omitempty"`   // Message sent to a session stalled on a permission prompt (empty = no nudge)
+	Interval          int    `toml:"interval,omitempty"`           // Polling interval in seconds (default: 30)
+	IdleTimeout       int    `toml:"idle_timeout,omitempty"`       // Seconds without screen changes before a session stalls (0 = disabled)
+	PermissionTimeout int    `toml:"permission_timeout,omitempty"` // Seconds in awaiting_permission before a session stalls (0 = disabled)
+}
+
+// Session backends selectable in [session] backend.
+const (
+	SessionBackendTmux = "tmux" // Sessions run in tmux (default)
+	SessionBackendPTY  = "pty"  // Sessions run under a pty owned by a crew host process (no tmux required)
+)
+
+// SessionConfig holds session backend settings from [session] section.
+type SessionConfig struct {
+	Backend string `toml:"backend,omitempty"` // Session backend: "tmux" (default) or "pty"
+}
+
 // DiffConfig holds diff display settings from [diff] section.
 type DiffConfig struct {
 	Command string `toml:"command,omitempty"` // Command to display diff (with {{.Args}} template support)
@@ -349,7 +398,16 @@ const ReviewResultMarker = "---REVIEW_RESULT---"
 // It uses Go template syntax with CommandData fields.
 const DefaultSystemPrompt = `You are working on Task #{{.TaskID}}.
 
-IMPORTANT: First run 'crew --help-worker' and follow the workflow instructions exactly.`
+IMPORTANT: First run 'crew --help-worker' and follow the workflow instructions exactly.
+{{- if .PreviousReview}}
+
+## Review Feedback
+
+The last review did not pass. Address every item below before running 'crew complete' again:
+{{if .PreviousReview.Findings}}{{range .PreviousReview.Findings}}
+- [{{.Severity}}]{{with .Location}} {{.}}{{end}} {{.Message}}{{end}}{{else}}
+{{.PreviousReview}}{{end}}
+{{- end}}`
 
 // DefaultManagerSystemPrompt is the default system prompt template for managers.
 const DefaultManagerSystemPrompt = `You are a Manager agent for crew.
@@ -373,18 +431,44 @@ This is review attempt #{{.ReviewAttempt}}. Focus on:
 Previous review:
 {{.PreviousReview}}
 
-If all issues are addressed, respond with "✅ LGTM".
+If all issues are addressed, respond with the verdict "lgtm" (✅ LGTM).
 {{end}}
 
 ## Output Format
 
 IMPORTANT: Do NOT run 'crew comment'. The CLI will record your review.
 
-Output your final review after the marker line:
-` + "`" + `---REVIEW_RESULT---` + "`" + `
+{{if .ReviewResultFile}}Write your verdict as a JSON object to {{.ReviewResultFile}}.
+If you cannot write files, print the JSON object after the marker line instead:
+{{else}}Print your verdict as a JSON object after the marker line:
+{{end}}` + "`" + `---REVIEW_RESULT---` + "`" + `
+
+` + "```" + `json
+{"verdict": "lgtm", "summary": "<1-2 sentence overview>", "findings": [{"file": "path/to/file.go", "line": 42, "severity": "blocking", "message": "<issue and how to fix it>"}]}
+` + "```" + `
+
+- verdict: "lgtm", "minor_issues", or "needs_changes"
+- severity: "blocking", "suggestion", or "nit" (file, line, and end_line for a range are optional)
+`
+
+// DefaultConflictResolverSystemPrompt is the default system prompt template for conflict resolvers.
+// The conflicting files and both sides' changes are appended to the prompt.
+const DefaultConflictResolverSystemPrompt = `You are resolving merge conflicts for crew Task #{{.TaskID}}: {{.Title}}
+{{if .Description}}
+## Task Description
+
+{{.Description}}
+{{end}}
+## Instructions
+
+The task branch {{.Branch}} conflicts with its base branch. Bring the base branch
+into this worktree with the command given below, resolve every conflict so that
+both the task's intent and the base branch's changes are kept, and commit the result.
 
-Start with: ` + "`" + `✅ LGTM` + "`" + `, ` + "`" + `⚠️ Minor issues` + "`" + `, or ` + "`" + `❌ Needs changes` + "`" + `
-Then list specific issues with file:line references.
+- Regenerate generated files (e.g., go.sum, lock files) with their tools instead of editing them by hand.
+- Do not make changes unrelated to the conflicts.
+- Do NOT run 'crew complete' or 'crew merge'.
+- Exit when the branch is committed and the working tree is clean.
 `
 
 // Directory and file names for git-crew.
@@ -436,6 +520,15 @@ const (
 	DefaultReviewSuccessRegex = ReviewLGTMPrefix
 )
 
+// Default configuration values for SchedulerConfig.
+const (
+	DefaultSchedulerMaxParallel = 3
+	DefaultSchedulerInterval    = 10
+)
+
+// DefaultWatchInterval is the default polling interval of crew watch in seconds.
+const DefaultWatchInterval = 30
+
 // NewDefaultConfig returns a Config with default values.
 // This returns an empty Agents map.
 // Builtin agents should be registered by calling builtin.Register(cfg)
@@ -452,6 +545,13 @@ func NewDefaultConfig() *Config {
 		Log: LogConfig{
 			Level: DefaultLogLevel,
 		},
+		Scheduler: SchedulerConfig{
+			MaxParallel: DefaultSchedulerMaxParallel,
+			Interval:    DefaultSchedulerInterval,
+		},
+		Watch: WatchConfig{
+			Interval: DefaultWatchInterval,
+		},
 	}
 }
 
@@ -670,6 +770,18 @@ func (c *Config) resolveAgent(name string, visited, resolving map[string]bool) e
 	if agent.SetupScript != "" {
 		resolved.SetupScript = agent.SetupScript
 	}
+	if len(agent.Fallback) > 0 {
+		resolved.Fallback = agent.Fallback
+	}
+	if len(agent.NoRetryExitCodes) > 0 {
+		resolved.NoRetryExitCodes = agent.NoRetryExitCodes
+	}
+	if agent.MaxRestarts != 0 {
+		resolved.MaxRestarts = agent.MaxRestarts
+	}
+	if agent.RestartBackoff != 0 {
+		resolved.RestartBackoff = agent.RestartBackoff
+	}
 	resolved.Env = mergeEnv(parent.Env, agent.Env)
 	// Hidden is a boolean, only override if explicitly set to true
 	if agent.Hidden {
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// dependencyBlockPrefix marks block reasons that were set automatically from DependsOn.
+// Block reasons without this prefix are considered manual and are never overwritten.
+const dependencyBlockPrefix = "Depends on "
+
+// DependencyBlockReason returns the block reason for a set of unfinished dependencies.
+// Returns empty string if there are no unfinished dependencies.
+func DependencyBlockReason(unfinished []int) string {
+	if len(unfinished) == 0 {
+		return ""
+	}
+	ids := slices.Clone(unfinished)
+	slices.Sort(ids)
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = "#" + intToStr(id)
+	}
+	return dependencyBlockPrefix + strings.Join(parts, ", ")
+}
+
+// IsDependencyBlockReason returns true if the reason was generated by DependencyBlockReason.
+func IsDependencyBlockReason(reason string) bool {
+	return strings.HasPrefix(reason, dependencyBlockPrefix+"#")
+}
+
+// HasDependencies returns true if the task depends on other tasks.
+func (t *Task) HasDependencies() bool {
+	return len(t.DependsOn) > 0
+}
+
+// ApplyDependencyBlock updates BlockReason from the given unfinished dependencies.
+// Manual block reasons are preserved; only empty or dependency-generated reasons are replaced.
+// Returns true if BlockReason was changed.
+func (t *Task) ApplyDependencyBlock(unfinished []int) bool {
+	if t.BlockReason != "" && !IsDependencyBlockReason(t.BlockReason) {
+		return false
+	}
+	reason := DependencyBlockReason(unfinished)
+	if reason == t.BlockReason {
+		return false
+	}
+	t.BlockReason = reason
+	return true
+}
+
+// NormalizeDependencies returns the sorted, deduplicated list of positive dependency IDs.
+// Returns nil if no dependencies remain.
+func NormalizeDependencies(ids []int) []int {
+	result := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if id > 0 && !slices.Contains(result, id) {
+			result = append(result, id)
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	slices.Sort(result)
+	return result
+}
+
+// CheckDependencyCycle returns ErrDependencyCycle if giving taskID the dependencies
+// in dependsOn would create a cycle in the dependency graph.
+// graph maps task IDs to their current DependsOn lists; the entry for taskID is ignored.
+func CheckDependencyCycle(taskID int, dependsOn []int, graph map[int][]int) error {
+	visited := make(map[int]bool)
+	stack := slices.Clone(dependsOn)
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if current == taskID {
+			return ErrDependencyCycle
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		stack = append(stack, graph[current]...)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDependencyBlockReason(t *testing.T) {
+	assert.Equal(t, "", DependencyBlockReason(nil))
+	assert.Equal(t, "Depends on #42", DependencyBlockReason([]int{42}))
+	assert.Equal(t, "Depends on #3, #7", DependencyBlockReason([]int{7, 3}))
+}
+
+func TestIsDependencyBlockReason(t *testing.T) {
+	assert.True(t, IsDependencyBlockReason("Depends on #42"))
+	assert.False(t, IsDependencyBlockReason("Depends on design review"))
+	assert.False(t, IsDependencyBlockReason("Parent task"))
+	assert.False(t, IsDependencyBlockReason(""))
+}
+
+func TestTask_ApplyDependencyBlock(t *testing.T) {
+	tests := []struct {
+		name       string
+		reason     string
+		unfinished []int
+		want       string
+		changed    bool
+	}{
+		{"block unblocked task", "", []int{2}, "Depends on #2", true},
+		{"update dependency reason", "Depends on #2, #3", []int{3}, "Depends on #3", true},
+		{"unblock when all merged", "Depends on #3", nil, "", true},
+		{"no change", "Depends on #3", []int{3}, "Depends on #3", false},
+		{"keep manual reason when blocking", "Waiting for design", []int{2}, "Waiting for design", false},
+		{"keep manual reason when unblocking", "Waiting for design", nil, "Waiting for design", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &Task{BlockReason: tt.reason}
+			changed := task.ApplyDependencyBlock(tt.unfinished)
+			assert.Equal(t, tt.changed, changed)
+			assert.Equal(t, tt.want, task.BlockReason)
+		})
+	}
+}
+
+func TestNormalizeDependencies(t *testing.T) {
+	assert.Nil(t, NormalizeDependencies(nil))
+	assert.Nil(t, NormalizeDependencies([]int{0, -1}))
+	assert.Equal(t, []int{1, 3, 5}, NormalizeDependencies([]int{5, 1, 3, 1, 0}))
+}
+
+func TestCheckDependencyCycle(t *testing.T) {
+	// 3 -> 2 -> 1
+	graph := map[int][]int{
+		1: nil,
+		2: {1},
+		3: {2},
+	}
+
+	tests := []struct {
+		wantErr   error
+		name      string
+		dependsOn []int
+		taskID    int
+	}{
+		{nil, "no cycle", []int{3}, 4},
+		{nil, "no dependencies", nil, 1},
+		{ErrDependencyCycle, "self dependency", []int{1}, 1},
+		{ErrDependencyCycle, "direct cycle", []int{2}, 1},
+		{ErrDependencyCycle, "transitive cycle", []int{3}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckDependencyCycle(tt.taskID, tt.dependsOn, graph)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
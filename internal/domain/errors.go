@@ -12,6 +12,8 @@ var (
 	ErrSessionRunning           = errors.New("session already running")
 	ErrNoSession                = errors.New("no running session")
 	ErrWorktreeNotFound         = errors.New("worktree not found")
+	ErrBranchNotFound           = errors.New("branch not found")
+	ErrIssueAlreadyLinked       = errors.New("issue is already linked to a task")
 	ErrNoAgent                  = errors.New("no agent specified")
 	ErrUncommittedChanges       = errors.New("uncommitted changes exist")
 	ErrMergeConflict            = errors.New("merge conflict exists")
@@ -45,11 +47,23 @@ var (
 	ErrInvalidSkipReview        = errors.New("invalid skip_review value")
 	ErrInvalidEnvVarName        = errors.New("invalid environment variable name")
 	ErrTaskBlocked              = errors.New("task is blocked")
+	ErrDependencyCycle          = errors.New("dependency cycle detected")
+	ErrDependencyNotFound       = errors.New("dependency task not found")
+	ErrUnfinishedDependencies   = errors.New("task has unfinished dependencies")
 	ErrCopyAllRequiresManagers  = errors.New("copy --all requires git and worktree managers (container wiring missing)")
 	ErrInvalidNamespace         = errors.New("invalid namespace")
 	ErrMigrationConflict        = errors.New("migration conflict: destination task differs")
+	ErrSnapshotNotFound         = errors.New("snapshot not found")
+	ErrRemoteNamespaceNotFound  = errors.New("namespace not found on remote")
 	ErrNoReviewComment          = errors.New("reviewer did not output a review result")
 	ErrInvalidExecutionSubstate = errors.New("invalid execution substate")
+	ErrInvalidMergeStrategy     = errors.New("invalid merge strategy")
+	ErrNoTasksSpecified         = errors.New("no tasks specified")
+	ErrCannotStack              = errors.New("cannot stack task")
+	ErrGateFailed               = errors.New("completion gate failed")
+	ErrInvalidReview            = errors.New("invalid review verdict")
+	ErrInvalidUsageGroup        = errors.New("invalid usage grouping")
+	ErrNoStallTimeout           = errors.New("no stall timeout configured: set watch.idle_timeout or watch.permission_timeout")
 
 	// Workspace errors
 	ErrWorkspaceRepoNotFound  = errors.New("repository not found in workspace")
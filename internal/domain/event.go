@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"time"
+)
+
+// EventType identifies the kind of task state change recorded in the event log.
+type EventType string
+
+const (
+	EventStatus       EventType = "status"        // Status transition (old/new status; old is empty on creation)
+	EventSubstate     EventType = "substate"      // Execution substate change
+	EventSessionStart EventType = "session_start" // Session started (new = session name)
+	EventSessionEnd   EventType = "session_end"   // Session ended (old = session name)
+	EventReview       EventType = "review"        // Review result recorded (new = lgtm or changes_requested)
+	EventMerge        EventType = "merge"         // Task merged (new = base branch)
+	EventStall        EventType = "stall"         // Session stalled or resumed (new = stall reason, empty when resumed)
+)
+
+// Review event values.
+const (
+	ReviewEventLGTM           = "lgtm"
+	ReviewEventChangesRequest = "changes_requested"
+)
+
+// AllEventTypes returns all event types in display order.
+func AllEventTypes() []EventType {
+	return []EventType{EventStatus, EventSubstate, EventSessionStart, EventSessionEnd, EventReview, EventMerge, EventStall}
+}
+
+// IsValid returns true if the event type is recognized.
+func (t EventType) IsValid() bool {
+	switch t {
+	case EventStatus, EventSubstate, EventSessionStart, EventSessionEnd, EventReview, EventMerge, EventStall:
+		return true
+	default:
+		return false
+	}
+}
+
+// Event is a single task state change.
+// Fields are ordered to minimize memory padding.
+type Event struct {
+	Time      time.Time `json:"time"`                // When the change was recorded
+	Type      EventType `json:"type"`                // Kind of change
+	Namespace string    `json:"namespace,omitempty"` // Task namespace
+	Actor     string    `json:"actor,omitempty"`     // Who made the change (e.g., "worker" or the user namespace)
+	Agent     string    `json:"agent,omitempty"`     // Agent of the task at the time of the change
+	Old       string    `json:"old,omitempty"`       // Previous value
+	New       string    `json:"new,omitempty"`       // New value
+	TaskID    int       `json:"task_id"`             // Task ID
+}
+
+// TaskEvents returns the events describing the change from old to updated.
+// old is nil when the task is created.
+func TaskEvents(old, updated *Task, at time.Time, actor string) []Event {
+	if updated == nil {
+		return nil
+	}
+
+	if old == nil {
+		old = &Task{}
+	}
+	namespace := updated.Namespace
+	if namespace == "" {
+		namespace = old.Namespace
+	}
+
+	newEvent := func(eventType EventType, oldValue, newValue string) Event {
+		return Event{
+			Time:      at,
+			Type:      eventType,
+			Namespace: namespace,
+			Actor:     actor,
+			Agent:     updated.Agent,
+			Old:       oldValue,
+			New:       newValue,
+			TaskID:    updated.ID,
+		}
+	}
+
+	var events []Event
+	if old.Status != updated.Status {
+		events = append(events, newEvent(EventStatus, string(old.Status), string(updated.Status)))
+		if updated.Status == StatusMerged {
+			events = append(events, newEvent(EventMerge, "", updated.BaseBranch))
+		}
+	}
+	if old.Session != updated.Session {
+		if old.Session != "" {
+			ended := newEvent(EventSessionEnd, old.Session, "")
+			ended.Agent = old.Agent
+			events = append(events, ended)
+		}
+		if updated.Session != "" {
+			events = append(events, newEvent(EventSessionStart, "", updated.Session))
+		}
+	}
+	if old.ExecutionSubstate != updated.ExecutionSubstate {
+		events = append(events, newEvent(EventSubstate, string(old.ExecutionSubstate), string(updated.ExecutionSubstate)))
+	}
+	if old.Stalled != updated.Stalled {
+		events = append(events, newEvent(EventStall, string(old.Stalled), string(updated.Stalled)))
+	}
+	if updated.ReviewCount > old.ReviewCount {
+		result := ReviewEventChangesRequest
+		if updated.LastReviewIsLGTM != nil && *updated.LastReviewIsLGTM {
+			result = ReviewEventLGTM
+		}
+		events = append(events, newEvent(EventReview, "", result))
+	}
+	return events
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventType_IsValid(t *testing.T) {
+	for _, eventType := range AllEventTypes() {
+		assert.True(t, eventType.IsValid(), eventType)
+	}
+	assert.False(t, EventType("unknown").IsValid())
+	assert.False(t, EventType("").IsValid())
+}
+
+func TestTaskEvents_Created(t *testing.T) {
+	// Setup
+	at := time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC)
+	task := &Task{ID: 1, Status: StatusTodo, Namespace: "alice"}
+
+	// Execute
+	events := TaskEvents(nil, task, at, "alice")
+
+	// Assert
+	require.Len(t, events, 1)
+	assert.Equal(t, Event{Time: at, Type: EventStatus, Namespace: "alice", Actor: "alice", New: "todo", TaskID: 1}, events[0])
+}
+
+func TestTaskEvents_Changes(t *testing.T) {
+	lgtm := true
+	tests := []struct {
+		old     *Task
+		updated *Task
+		name    string
+		want    []Event
+	}{
+		{
+			name:    "no change",
+			old:     &Task{ID: 1, Status: StatusTodo},
+			updated: &Task{ID: 1, Status: StatusTodo, Title: "renamed"},
+			want:    nil,
+		},
+		{
+			name:    "session start",
+			old:     &Task{ID: 1, Status: StatusTodo},
+			updated: &Task{ID: 1, Status: StatusInProgress, Session: "crew-1", Agent: "claude"},
+			want: []Event{
+				{Type: EventStatus, Agent: "claude", Old: "todo", New: "in_progress", TaskID: 1},
+				{Type: EventSessionStart, Agent: "claude", New: "crew-1", TaskID: 1},
+			},
+		},
+		{
+			name:    "session end keeps previous agent",
+			old:     &Task{ID: 1, Status: StatusInProgress, Session: "crew-1", Agent: "claude"},
+			updated: &Task{ID: 1, Status: StatusDone},
+			want: []Event{
+				{Type: EventStatus, Old: "in_progress", New: "done", TaskID: 1},
+				{Type: EventSessionEnd, Agent: "claude", Old: "crew-1", TaskID: 1},
+			},
+		},
+		{
+			name:    "substate",
+			old:     &Task{ID: 1, Status: StatusInProgress, ExecutionSubstate: SubstateRunning},
+			updated: &Task{ID: 1, Status: StatusInProgress, ExecutionSubstate: SubstateAwaitingUser},
+			want: []Event{
+				{Type: EventSubstate, Old: "running", New: "awaiting_user", TaskID: 1},
+			},
+		},
+		{
+			name:    "stalled",
+			old:     &Task{ID: 1, Status: StatusInProgress, Session: "crew-1"},
+			updated: &Task{ID: 1, Status: StatusInProgress, Session: "crew-1", Stalled: StallPermission},
+			want: []Event{
+				{Type: EventStall, New: "permission", TaskID: 1},
+			},
+		},
+		{
+			name:    "review lgtm",
+			old:     &Task{ID: 1, Status: StatusDone},
+			updated: &Task{ID: 1, Status: StatusDone, ReviewCount: 1, LastReviewIsLGTM: &lgtm},
+			want: []Event{
+				{Type: EventReview, New: ReviewEventLGTM, TaskID: 1},
+			},
+		},
+		{
+			name:    "review changes requested",
+			old:     &Task{ID: 1, Status: StatusDone, ReviewCount: 1},
+			updated: &Task{ID: 1, Status: StatusDone, ReviewCount: 2},
+			want: []Event{
+				{Type: EventReview, New: ReviewEventChangesRequest, TaskID: 1},
+			},
+		},
+		{
+			name:    "merge",
+			old:     &Task{ID: 1, Status: StatusDone},
+			updated: &Task{ID: 1, Status: StatusMerged, BaseBranch: "main"},
+			want: []Event{
+				{Type: EventStatus, Old: "done", New: "merged", TaskID: 1},
+				{Type: EventMerge, New: "main", TaskID: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TaskEvents(tt.old, tt.updated, time.Time{}, ""))
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FallbackCommentAuthor is the author of the comments recording fallback restarts.
+const FallbackCommentAuthor = "fallback"
+
+// FallbackAttempt records an attempt to restart a task with a fallback agent
+// after a worker session ended in error.
+// Fields are ordered to minimize memory padding.
+type FallbackAttempt struct {
+	Time     time.Time
+	Origin   string // Agent whose fallback chain is followed
+	From     string // Agent whose session ended in error
+	Agent    string // Fallback agent (empty if the chain is exhausted)
+	Error    string // Why the restart failed (empty on success)
+	Attempt  int    // Position of Agent in the fallback chain (1 = first fallback)
+	ExitCode int    // Exit code of the failed session
+}
+
+// Succeeded returns true if the task was restarted with the fallback agent.
+func (a FallbackAttempt) Succeeded() bool {
+	return a.Agent != "" && a.Error == ""
+}
+
+// Comment converts the attempt to a task comment.
+func (a FallbackAttempt) Comment() Comment {
+	failure := fmt.Sprintf("%s exited with code %d", a.From, a.ExitCode)
+	var text string
+	switch {
+	case a.Agent == "":
+		text = fmt.Sprintf("%s; fallback chain of %s exhausted, leaving the task in error", failure, a.Origin)
+	case a.Error != "":
+		text = fmt.Sprintf("%s; restart with fallback %s (%d) failed: %s", failure, a.Agent, a.Attempt, a.Error)
+	default:
+		text = fmt.Sprintf("%s; restarted with fallback %s (%d)", failure, a.Agent, a.Attempt)
+	}
+
+	metadata := map[string]string{
+		"origin":    a.Origin,
+		"from":      a.From,
+		"attempt":   strconv.Itoa(a.Attempt),
+		"exit_code": strconv.Itoa(a.ExitCode),
+	}
+	if a.Agent != "" {
+		metadata["agent"] = a.Agent
+	}
+	if a.Error != "" {
+		// Metadata is stored on one line as comma-separated pairs
+		metadata["error"] = strings.NewReplacer(",", ";", "\n", " ", "\r", " ").Replace(a.Error)
+	}
+	return Comment{
+		Text:     text,
+		Author:   FallbackCommentAuthor,
+		Type:     CommentTypeFallback,
+		Metadata: metadata,
+		Time:     a.Time,
+	}
+}
+
+// FallbackAttemptFromComment parses a fallback comment.
+// It returns false if the comment is not a fallback comment.
+func FallbackAttemptFromComment(comment Comment) (FallbackAttempt, bool) {
+	if comment.Type != CommentTypeFallback {
+		return FallbackAttempt{}, false
+	}
+	attempt, _ := strconv.Atoi(comment.Metadata["attempt"])
+	exitCode, _ := strconv.Atoi(comment.Metadata["exit_code"])
+	return FallbackAttempt{
+		Time:     comment.Time,
+		Origin:   comment.Metadata["origin"],
+		From:     comment.Metadata["from"],
+		Agent:    comment.Metadata["agent"],
+		Error:    comment.Metadata["error"],
+		Attempt:  attempt,
+		ExitCode: exitCode,
+	}, true
+}
+
+// FallbackStep is the next agent of a fallback chain.
+type FallbackStep struct {
+	Origin  string // Agent whose fallback chain is followed
+	Agent   string // Agent to restart the task with
+	Attempt int    // Position of Agent in the chain (1 = first fallback)
+}
+
+// NextFallback returns the next agent to restart the task with after the
+// session of failedAgent ended in error.
+//
+// A chain starts with the fallback list of the failed agent. If the running
+// session was itself started as a fallback (the last fallback comment restarted
+// the task with failedAgent, and the task was not started again since), the
+// chain of the original agent is continued instead. skip is the number of
+// further agents to pass over, for agents that failed to start.
+// It returns false when the chain is exhausted.
+func NextFallback(cfg *Config, task *Task, comments []Comment, failedAgent string, skip int) (FallbackStep, bool) {
+	step := FallbackStep{Origin: failedAgent}
+	for i := len(comments) - 1; i >= 0; i-- {
+		last, ok := FallbackAttemptFromComment(comments[i])
+		if !ok {
+			continue
+		}
+		if last.Succeeded() && last.Agent == failedAgent && !last.Time.Before(task.Started) {
+			step.Origin = last.Origin
+			step.Attempt = last.Attempt
+		}
+		break
+	}
+
+	chain := cfg.Agents[step.Origin].Fallback
+	next := step.Attempt + skip
+	if next >= len(chain) {
+		step.Attempt = next
+		return step, false
+	}
+	step.Agent = chain[next]
+	step.Attempt = next + 1
+	return step, true
+}
+
+// Number and length of the task comments handed to a fallback agent.
+const (
+	fallbackPromptComments   = 5
+	fallbackPromptCommentLen = 500
+)
+
+// FallbackPrompt returns the prompt that hands a task over to a fallback agent:
+// what happened to the previous session, the state of the worktree, and the
+// latest task comments.
+func FallbackPrompt(from string, exitCode int, comments []Comment) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "This task was being worked on by the agent %q, whose session ended with exit code %d before the task was completed. ", from, exitCode)
+	b.WriteString("You are taking over as a fallback agent.\n\n")
+	b.WriteString("The worktree still contains the previous agent's commits and uncommitted changes. ")
+	b.WriteString("Check `git status`, `git log` and the diff against the base branch first, and continue from there instead of starting over.")
+
+	var recent []Comment
+	for _, comment := range comments {
+		switch comment.Type {
+		case CommentTypeGeneral, CommentTypeReport, CommentTypeMessage, CommentTypeSuggestion, CommentTypeFriction:
+			recent = append(recent, comment)
+		case CommentTypeGate, CommentTypeUsage, CommentTypeFallback:
+			// Recorded by crew; not useful to the agent
+		}
+	}
+	if len(recent) > fallbackPromptComments {
+		recent = recent[len(recent)-fallbackPromptComments:]
+	}
+	if len(recent) > 0 {
+		b.WriteString("\n\nLatest task comments:")
+		for _, comment := range recent {
+			author := comment.Author
+			if author == "" {
+				author = "comment"
+			}
+			text := strings.TrimSpace(comment.Text)
+			if runes := []rune(text); len(runes) > fallbackPromptCommentLen {
+				text = string(runes[:fallbackPromptCommentLen]) + "..."
+			}
+			fmt.Fprintf(&b, "\n- [%s] %s", author, text)
+		}
+	}
+	return b.String()
+}
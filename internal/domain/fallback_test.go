@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFallbackAttempt_CommentRoundTrip(t *testing.T) {
+	attempt := FallbackAttempt{
+		Time:     time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Origin:   "claude-dev",
+		From:     "codex-dev",
+		Agent:    "opencode-dev",
+		Error:    "agent not found",
+		Attempt:  2,
+		ExitCode: 1,
+	}
+
+	comment := attempt.Comment()
+
+	assert.Equal(t, CommentTypeFallback, comment.Type)
+	assert.Equal(t, FallbackCommentAuthor, comment.Author)
+	assert.Equal(t, "codex-dev exited with code 1; restart with fallback opencode-dev (2) failed: agent not found", comment.Text)
+	parsed, ok := FallbackAttemptFromComment(comment)
+	require.True(t, ok)
+	assert.Equal(t, attempt, parsed)
+
+	_, ok = FallbackAttemptFromComment(Comment{Text: "hello"})
+	assert.False(t, ok)
+}
+
+func TestFallbackAttempt_CommentErrorMetadata(t *testing.T) {
+	comment := FallbackAttempt{Origin: "a", From: "a", Agent: "b", Error: "depends on #1, #2\nretry", Attempt: 1, ExitCode: 1}.Comment()
+
+	assert.Equal(t, "depends on #1; #2 retry", comment.Metadata["error"])
+	assert.Contains(t, comment.Text, "failed: depends on #1, #2\nretry")
+}
+
+func TestNextFallback(t *testing.T) {
+	started := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	cfg := &Config{Agents: map[string]Agent{
+		"claude-dev": {Fallback: []string{"codex-dev", "opencode-dev"}},
+		"codex-dev":  {Fallback: []string{"claude-dev"}},
+	}}
+	restartedWithCodex := FallbackAttempt{Time: started, Origin: "claude-dev", From: "claude-dev", Agent: "codex-dev", Attempt: 1}.Comment()
+
+	tests := []struct {
+		name        string
+		failed      string
+		comments    []Comment
+		want        FallbackStep
+		skip        int
+		taskStarted time.Time
+		wantOK      bool
+	}{
+		{
+			name:   "starts the failed agent's chain",
+			failed: "claude-dev",
+			want:   FallbackStep{Origin: "claude-dev", Agent: "codex-dev", Attempt: 1},
+			wantOK: true,
+		},
+		{
+			name:   "skips agents that failed to start",
+			failed: "claude-dev",
+			skip:   1,
+			want:   FallbackStep{Origin: "claude-dev", Agent: "opencode-dev", Attempt: 2},
+			wantOK: true,
+		},
+		{
+			name:     "continues the chain of a fallback session",
+			failed:   "codex-dev",
+			comments: []Comment{restartedWithCodex, {Text: "progress", Author: "worker"}},
+			want:     FallbackStep{Origin: "claude-dev", Agent: "opencode-dev", Attempt: 2},
+			wantOK:   true,
+		},
+		{
+			name:        "a later manual start begins a new chain",
+			failed:      "codex-dev",
+			comments:    []Comment{restartedWithCodex},
+			taskStarted: started.Add(time.Hour),
+			want:        FallbackStep{Origin: "codex-dev", Agent: "claude-dev", Attempt: 1},
+			wantOK:      true,
+		},
+		{
+			name:   "agent without fallback",
+			failed: "opencode-dev",
+			want:   FallbackStep{Origin: "opencode-dev"},
+		},
+		{
+			name:   "chain exhausted",
+			failed: "claude-dev",
+			skip:   2,
+			want:   FallbackStep{Origin: "claude-dev", Attempt: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &Task{Started: started}
+			if !tt.taskStarted.IsZero() {
+				task.Started = tt.taskStarted
+			}
+
+			step, ok := NextFallback(cfg, task, tt.comments, tt.failed, tt.skip)
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, step)
+		})
+	}
+}
+
+func TestFallbackPrompt(t *testing.T) {
+	comments := []Comment{
+		{Text: "first", Author: "worker"},
+		{Text: "Usage of claude: 10 in / 2 out tokens", Type: CommentTypeUsage},
+		{Text: "second", Author: "manager", Type: CommentTypeMessage},
+		{Text: strings.Repeat("é", 600)},
+	}
+
+	prompt := FallbackPrompt("claude-dev", 1, comments)
+
+	assert.Contains(t, prompt, `the agent "claude-dev", whose session ended with exit code 1`)
+	assert.Contains(t, prompt, "git status")
+	assert.Contains(t, prompt, "\n- [worker] first\n- [manager] second\n- [comment] "+strings.Repeat("é", 500)+"...")
+	assert.NotContains(t, prompt, "Usage of claude")
+}
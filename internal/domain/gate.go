@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GateCommentAuthor is the author of the comments recording gate results.
+const GateCommentAuthor = "gate"
+
+// DefaultGateTimeout bounds a completion gate when no timeout is configured.
+const DefaultGateTimeout = 10 * time.Minute
+
+// gateOutputMaxBytes caps the failure output kept in a gate result comment.
+const gateOutputMaxBytes = 4 * 1024
+
+// gateNamePattern restricts gate names to characters that are safe in comment metadata.
+var gateNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// CompleteGate is a named completion check from a [[complete.gates]] entry.
+// Fields are ordered to minimize memory padding.
+type CompleteGate struct {
+	Name     string `toml:"name"`               // Gate name shown in results (letters, digits, '.', '_', '-')
+	Command  string `toml:"command"`            // Shell command run in the task worktree
+	Parallel string `toml:"parallel,omitempty"` // Parallel group: adjacent gates in the same group run concurrently
+	Timeout  int    `toml:"timeout,omitempty"`  // Timeout in seconds (default: 600)
+	Required bool   `toml:"required"`           // Failure blocks completion (default: true)
+}
+
+// IsValidGateName returns true if name can be used as a gate name.
+func IsValidGateName(name string) bool {
+	return gateNamePattern.MatchString(name)
+}
+
+// TimeoutDuration returns the gate timeout, falling back to DefaultGateTimeout.
+func (g CompleteGate) TimeoutDuration() time.Duration {
+	if g.Timeout <= 0 {
+		return DefaultGateTimeout
+	}
+	return time.Duration(g.Timeout) * time.Second
+}
+
+// GateStages splits gates into stages that run one after another.
+// Adjacent gates sharing a non-empty parallel group form a single stage
+// and run concurrently; every other gate is a stage of its own.
+func GateStages(gates []CompleteGate) [][]CompleteGate {
+	var stages [][]CompleteGate
+	for _, gate := range gates {
+		if n := len(stages); n > 0 && gate.Parallel != "" && stages[n-1][0].Parallel == gate.Parallel {
+			stages[n-1] = append(stages[n-1], gate)
+			continue
+		}
+		stages = append(stages, []CompleteGate{gate})
+	}
+	return stages
+}
+
+// GateStatus is the outcome of running a completion gate.
+type GateStatus string
+
+const (
+	GateStatusPassed   GateStatus = "passed"    // Command exited with status 0
+	GateStatusFailed   GateStatus = "failed"    // Command exited with a non-zero status
+	GateStatusTimedOut GateStatus = "timed_out" // Command was killed after the gate timeout
+)
+
+// IsValid returns true if the gate status is a known value.
+func (s GateStatus) IsValid() bool {
+	switch s {
+	case GateStatusPassed, GateStatusFailed, GateStatusTimedOut:
+		return true
+	}
+	return false
+}
+
+// Display returns a human-readable form of the status.
+func (s GateStatus) Display() string {
+	if s == GateStatusTimedOut {
+		return "timed out"
+	}
+	return string(s)
+}
+
+// GateResult is the recorded outcome of a completion gate.
+// Fields are ordered to minimize memory padding.
+type GateResult struct {
+	Time     time.Time     // When the gate finished
+	Name     string        // Gate name
+	Status   GateStatus    // Outcome
+	Output   string        // Combined command output (not persisted in the comment when the gate passed)
+	Duration time.Duration // How long the gate ran
+	Required bool          // Whether a failure blocks completion
+}
+
+// Passed returns true if the gate passed.
+func (r GateResult) Passed() bool {
+	return r.Status == GateStatusPassed
+}
+
+// Blocking returns true if the result prevents the task from completing.
+func (r GateResult) Blocking() bool {
+	return r.Required && !r.Passed()
+}
+
+// Summary returns a one-line description such as "lint passed (1.2s)".
+func (r GateResult) Summary() string {
+	summary := fmt.Sprintf("%s %s (%s)", r.Name, r.Status.Display(), r.Duration)
+	if !r.Required && !r.Passed() {
+		summary += " [optional]"
+	}
+	return summary
+}
+
+// OutputTail returns the trimmed output, keeping only its end if it is long.
+func (r GateResult) OutputTail() string {
+	output := strings.TrimSpace(r.Output)
+	if len(output) > gateOutputMaxBytes {
+		output = "...\n" + output[len(output)-gateOutputMaxBytes:]
+	}
+	return output
+}
+
+// Comment returns the structured task comment recording the result.
+func (r GateResult) Comment() Comment {
+	text := "Gate " + r.Summary()
+	if output := r.OutputTail(); output != "" && !r.Passed() {
+		text += "\n\n```\n" + output + "\n```"
+	}
+	return Comment{
+		Text:   text,
+		Author: GateCommentAuthor,
+		Type:   CommentTypeGate,
+		Metadata: map[string]string{
+			"gate":     r.Name,
+			"status":   string(r.Status),
+			"duration": r.Duration.String(),
+			"required": strconv.FormatBool(r.Required),
+		},
+		Time: r.Time,
+	}
+}
+
+// GateResultFromComment parses a comment written by GateResult.Comment.
+// Returns false if the comment is not a gate result.
+func GateResultFromComment(comment Comment) (GateResult, bool) {
+	if comment.Type != CommentTypeGate {
+		return GateResult{}, false
+	}
+	name := comment.Metadata["gate"]
+	status := GateStatus(comment.Metadata["status"])
+	if name == "" || !status.IsValid() {
+		return GateResult{}, false
+	}
+	duration, _ := time.ParseDuration(comment.Metadata["duration"])
+	required, err := strconv.ParseBool(comment.Metadata["required"])
+	if err != nil {
+		required = true
+	}
+	return GateResult{
+		Time:     comment.Time,
+		Name:     name,
+		Status:   status,
+		Duration: duration,
+		Required: required,
+	}, true
+}
+
+// LatestGateResults returns the most recent result of each gate found in comments,
+// in the order the gates first appear.
+func LatestGateResults(comments []Comment) []GateResult {
+	var results []GateResult
+	index := make(map[string]int)
+	for _, comment := range comments {
+		result, ok := GateResultFromComment(comment)
+		if !ok {
+			continue
+		}
+		if i, seen := index[result.Name]; seen {
+			results[i] = result
+			continue
+		}
+		index[result.Name] = len(results)
+		results = append(results, result)
+	}
+	return results
+}
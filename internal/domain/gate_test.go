@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGateStages(t *testing.T) {
+	gates := []CompleteGate{
+		{Name: "fmt"},
+		{Name: "lint", Parallel: "checks"},
+		{Name: "test", Parallel: "checks"},
+		{Name: "build"},
+		{Name: "e2e", Parallel: "slow"},
+		{Name: "bench", Parallel: "checks"},
+	}
+
+	stages := GateStages(gates)
+
+	names := make([][]string, len(stages))
+	for i, stage := range stages {
+		for _, gate := range stage {
+			names[i] = append(names[i], gate.Name)
+		}
+	}
+	assert.Equal(t, [][]string{{"fmt"}, {"lint", "test"}, {"build"}, {"e2e"}, {"bench"}}, names)
+}
+
+func TestCompleteGate_TimeoutDuration(t *testing.T) {
+	assert.Equal(t, DefaultGateTimeout, CompleteGate{}.TimeoutDuration())
+	assert.Equal(t, 90*time.Second, CompleteGate{Timeout: 90}.TimeoutDuration())
+}
+
+func TestIsValidGateName(t *testing.T) {
+	assert.True(t, IsValidGateName("unit-tests_v2.1"))
+	assert.False(t, IsValidGateName(""))
+	assert.False(t, IsValidGateName("lint,test"))
+	assert.False(t, IsValidGateName("a=b"))
+}
+
+func TestGateResult_CommentRoundTrip(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	result := GateResult{
+		Time:     now,
+		Name:     "test",
+		Status:   GateStatusFailed,
+		Output:   "FAIL: TestFoo\n",
+		Duration: 1500 * time.Millisecond,
+		Required: false,
+	}
+
+	comment := result.Comment()
+
+	assert.Equal(t, CommentTypeGate, comment.Type)
+	assert.Equal(t, GateCommentAuthor, comment.Author)
+	assert.Equal(t, now, comment.Time)
+	assert.True(t, strings.HasPrefix(comment.Text, "Gate test failed (1.5s) [optional]"))
+	assert.Contains(t, comment.Text, "FAIL: TestFoo")
+
+	parsed, ok := GateResultFromComment(comment)
+	require.True(t, ok)
+	assert.Equal(t, "test", parsed.Name)
+	assert.Equal(t, GateStatusFailed, parsed.Status)
+	assert.Equal(t, 1500*time.Millisecond, parsed.Duration)
+	assert.False(t, parsed.Required)
+	assert.False(t, parsed.Blocking())
+}
+
+func TestGateResult_CommentOmitsOutputWhenPassed(t *testing.T) {
+	comment := GateResult{Name: "lint", Status: GateStatusPassed, Output: "ok", Required: true}.Comment()
+
+	assert.Equal(t, "Gate lint passed (0s)", comment.Text)
+}
+
+func TestGateResult_OutputTail(t *testing.T) {
+	long := strings.Repeat("x", gateOutputMaxBytes) + "END"
+
+	tail := GateResult{Output: long}.OutputTail()
+
+	assert.True(t, strings.HasPrefix(tail, "...\n"))
+	assert.True(t, strings.HasSuffix(tail, "END"))
+	assert.Len(t, tail, gateOutputMaxBytes+len("...\n"))
+}
+
+func TestGateResultFromComment_IgnoresOtherComments(t *testing.T) {
+	_, ok := GateResultFromComment(Comment{Text: "hello"})
+	assert.False(t, ok)
+
+	_, ok = GateResultFromComment(Comment{Type: CommentTypeGate, Metadata: map[string]string{"gate": "lint", "status": "weird"}})
+	assert.False(t, ok)
+}
+
+func TestLatestGateResults(t *testing.T) {
+	comments := []Comment{
+		GateResult{Name: "lint", Status: GateStatusFailed, Required: true}.Comment(),
+		{Text: "worker note"},
+		GateResult{Name: "test", Status: GateStatusPassed, Required: true}.Comment(),
+		GateResult{Name: "lint", Status: GateStatusPassed, Required: true}.Comment(),
+	}
+
+	results := LatestGateResults(comments)
+
+	require.Len(t, results, 2)
+	assert.Equal(t, "lint", results[0].Name)
+	assert.Equal(t, GateStatusPassed, results[0].Status)
+	assert.Equal(t, "test", results[1].Name)
+}
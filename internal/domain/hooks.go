@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+)
+
+// Hook identifies a lifecycle hook from the [hooks] section.
+type Hook string
+
+const (
+	HookOnStart    Hook = "on_start"    // Task moved to in_progress
+	HookOnComplete Hook = "on_complete" // crew complete was invoked (runs before the completion checks)
+	HookOnDone     Hook = "on_done"     // Task moved to done
+	HookOnMerge    Hook = "on_merge"    // Task merged
+	HookOnError    Hook = "on_error"    // Task moved to error
+	HookOnClose    Hook = "on_close"    // Task closed
+	HookOnSubstate Hook = "on_substate" // Execution substate changed
+	HookOnStalled  Hook = "on_stalled"  // Running session detected as stalled
+)
+
+// HooksConfig holds lifecycle hook commands from [hooks] section.
+// Commands are shell commands expanded with HookData.
+type HooksConfig struct {
+	OnStart    string `toml:"on_start,omitempty"`
+	OnComplete string `toml:"on_complete,omitempty"`
+	OnDone     string `toml:"on_done,omitempty"`
+	OnMerge    string `toml:"on_merge,omitempty"`
+	OnError    string `toml:"on_error,omitempty"`
+	OnClose    string `toml:"on_close,omitempty"`
+	OnSubstate string `toml:"on_substate,omitempty"`
+	OnStalled  string `toml:"on_stalled,omitempty"`
+}
+
+// Command returns the command configured for the hook (empty if not set).
+func (c HooksConfig) Command(hook Hook) string {
+	switch hook {
+	case HookOnStart:
+		return c.OnStart
+	case HookOnComplete:
+		return c.OnComplete
+	case HookOnDone:
+		return c.OnDone
+	case HookOnMerge:
+		return c.OnMerge
+	case HookOnError:
+		return c.OnError
+	case HookOnClose:
+		return c.OnClose
+	case HookOnSubstate:
+		return c.OnSubstate
+	case HookOnStalled:
+		return c.OnStalled
+	default:
+		return ""
+	}
+}
+
+// HookForEvent returns the hook triggered by a recorded event.
+// Returns false if the event does not trigger a hook.
+func HookForEvent(event Event) (Hook, bool) {
+	switch event.Type {
+	case EventStatus:
+		switch Status(event.New) {
+		case StatusInProgress:
+			return HookOnStart, true
+		case StatusDone:
+			return HookOnDone, true
+		case StatusError:
+			return HookOnError, true
+		case StatusClosed:
+			return HookOnClose, true
+		}
+	case EventMerge:
+		return HookOnMerge, true
+	case EventSubstate:
+		return HookOnSubstate, true
+	case EventStall:
+		if event.New != "" {
+			return HookOnStalled, true
+		}
+	}
+	return "", false
+}
+
+// HookData holds data for rendering hook commands.
+// Field names follow CommandData where they overlap.
+// Fields are ordered to minimize memory padding.
+type HookData struct {
+	// Environment
+	GitDir   string // Path to .git directory
+	RepoRoot string // Repository root path
+	Worktree string // Worktree path (empty if the worktree does not exist)
+
+	// Task information
+	Title       string
+	Description string
+	Branch      string // Branch name (e.g., "crew-1")
+	BaseBranch  string
+	Status      Status
+	Substate    ExecutionSubstate
+	Agent       string
+
+	// Change that triggered the hook
+	Hook  Hook
+	Actor string // Who made the change
+	Old   string // Previous value (e.g., old status or substate)
+	New   string // New value (e.g., new status, substate or merge target)
+
+	// Integer fields grouped together for alignment
+	Issue  int // GitHub issue number (0 if not linked)
+	TaskID int
+}
+
+// RenderHookCommand expands a hook command template with data.
+// The quote function shell-quotes a value, e.g. {{quote .Title}}.
+func RenderHookCommand(command string, data HookData) (string, error) {
+	tmpl, err := template.New("hook").Funcs(template.FuncMap{"quote": shellQuote}).Parse(command)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// shellQuote quotes a value for safe use as a single shell word.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'"'"'`) + "'"
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHookForEvent(t *testing.T) {
+	tests := []struct {
+		name  string
+		want  Hook
+		event Event
+		ok    bool
+	}{
+		{"created", "", Event{Type: EventStatus, New: "todo"}, false},
+		{"started", HookOnStart, Event{Type: EventStatus, Old: "todo", New: "in_progress"}, true},
+		{"done", HookOnDone, Event{Type: EventStatus, Old: "in_progress", New: "done"}, true},
+		{"error", HookOnError, Event{Type: EventStatus, Old: "in_progress", New: "error"}, true},
+		{"closed", HookOnClose, Event{Type: EventStatus, Old: "todo", New: "closed"}, true},
+		{"merged status", "", Event{Type: EventStatus, Old: "done", New: "merged"}, false},
+		{"merge", HookOnMerge, Event{Type: EventMerge, New: "main"}, true},
+		{"substate", HookOnSubstate, Event{Type: EventSubstate, New: "awaiting_user"}, true},
+		{"session start", "", Event{Type: EventSessionStart, New: "crew-1"}, false},
+		{"stalled", HookOnStalled, Event{Type: EventStall, New: "idle"}, true},
+		{"resumed", "", Event{Type: EventStall, Old: "idle"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := HookForEvent(tt.event)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHooksConfig_Command(t *testing.T) {
+	cfg := HooksConfig{OnStart: "start", OnComplete: "complete", OnDone: "done", OnMerge: "merge", OnError: "error", OnClose: "close", OnSubstate: "substate", OnStalled: "stalled"}
+
+	assert.Equal(t, "start", cfg.Command(HookOnStart))
+	assert.Equal(t, "complete", cfg.Command(HookOnComplete))
+	assert.Equal(t, "done", cfg.Command(HookOnDone))
+	assert.Equal(t, "merge", cfg.Command(HookOnMerge))
+	assert.Equal(t, "error", cfg.Command(HookOnError))
+	assert.Equal(t, "close", cfg.Command(HookOnClose))
+	assert.Equal(t, "substate", cfg.Command(HookOnSubstate))
+	assert.Equal(t, "stalled", cfg.Command(HookOnStalled))
+	assert.Empty(t, cfg.Command("on_unknown"))
+}
+
+func TestRenderHookCommand(t *testing.T) {
+	data := HookData{Title: "Fix the user's bug", Branch: "crew-3", Hook: HookOnError, Old: "in_progress", New: "error", TaskID: 3}
+
+	got, err := RenderHookCommand(`notify {{.TaskID}} {{.Branch}} {{.Old}}->{{.New}} {{quote .Title}}`, data)
+
+	require.NoError(t, err)
+	assert.Equal(t, `notify 3 crew-3 in_progress->error 'Fix the user'"'"'s bug'`, got)
+}
+
+func TestRenderHookCommand_InvalidTemplate(t *testing.T) {
+	_, err := RenderHookCommand(`echo {{.Unknown}}`, HookData{})
+	assert.Error(t, err)
+
+	_, err = RenderHookCommand(`echo {{.TaskID`, HookData{})
+	assert.Error(t, err)
+}
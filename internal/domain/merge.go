@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+)
+
+// MergeStrategy determines how a task branch is integrated into its base branch.
+type MergeStrategy string
+
+const (
+	// MergeStrategyMerge creates a merge commit (git merge --no-ff). This is the default.
+	MergeStrategyMerge MergeStrategy = "merge"
+
+	// MergeStrategySquash squashes the branch into a single commit on the base branch.
+	MergeStrategySquash MergeStrategy = "squash"
+
+	// MergeStrategyRebase rebases the branch onto the base branch, then fast-forwards.
+	MergeStrategyRebase MergeStrategy = "rebase"
+
+	// MergeStrategyFFOnly fast-forwards the base branch and fails if that is not possible.
+	MergeStrategyFFOnly MergeStrategy = "ff-only"
+)
+
+// AllMergeStrategies returns all valid merge strategy values.
+func AllMergeStrategies() []MergeStrategy {
+	return []MergeStrategy{MergeStrategyMerge, MergeStrategySquash, MergeStrategyRebase, MergeStrategyFFOnly}
+}
+
+// IsValid returns true if the merge strategy is a known valid value.
+func (s MergeStrategy) IsValid() bool {
+	switch s {
+	case MergeStrategyMerge, MergeStrategySquash, MergeStrategyRebase, MergeStrategyFFOnly:
+		return true
+	}
+	return false
+}
+
+// CreatesCommit returns true if the strategy creates a new commit that uses the merge message.
+func (s MergeStrategy) CreatesCommit() bool {
+	return s == MergeStrategyMerge || s == MergeStrategySquash
+}
+
+// DefaultMergeMessage is the commit message template used for squash merges
+// when [merge] message is not set. Merge commits keep git's default message.
+const DefaultMergeMessage = `{{.Title}}{{if .Issue}} (#{{.Issue}}){{end}}
+
+Crew-Task: {{.TaskID}}`
+
+// MergeConfig holds merge settings from [merge] section.
+type MergeConfig struct {
+	Strategy MergeStrategy `toml:"strategy,omitempty"` // Default strategy: merge (default), squash, rebase, ff-only
+	Message  string        `toml:"message,omitempty"`  // Commit message template for merge and squash commits
+}
+
+// MergeOptions configures how Git.Merge integrates a branch.
+type MergeOptions struct {
+	Strategy    MergeStrategy // Merge strategy (default: merge)
+	Message     string        // Commit message (empty uses git's default for merge commits)
+	WorktreeDir string        // Worktree where the branch is checked out (used by rebase; empty if none)
+}
+
+// MergeMessageData contains the values available to the [merge] message template.
+// Fields are ordered to minimize memory padding.
+type MergeMessageData struct {
+	Title       string
+	Description string
+	Branch      string
+	BaseBranch  string
+	Labels      []string
+	TaskID      int
+	Issue       int
+}
+
+// RenderMergeMessage expands a [merge] message template.
+// The template can use {{join .Labels ", "}} to list labels.
+func RenderMergeMessage(message string, data MergeMessageData) (string, error) {
+	tmpl, err := template.New("merge").Funcs(template.FuncMap{"join": strings.Join}).Parse(message)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeStrategy_IsValid(t *testing.T) {
+	for _, s := range AllMergeStrategies() {
+		assert.True(t, s.IsValid(), s)
+	}
+	assert.False(t, MergeStrategy("").IsValid())
+	assert.False(t, MergeStrategy("octopus").IsValid())
+}
+
+func TestMergeStrategy_CreatesCommit(t *testing.T) {
+	assert.True(t, MergeStrategyMerge.CreatesCommit())
+	assert.True(t, MergeStrategySquash.CreatesCommit())
+	assert.False(t, MergeStrategyRebase.CreatesCommit())
+	assert.False(t, MergeStrategyFFOnly.CreatesCommit())
+}
+
+func TestRenderMergeMessage(t *testing.T) {
+	data := MergeMessageData{
+		Title:       "Add login",
+		Description: "Implements the login form",
+		Branch:      "crew-1",
+		BaseBranch:  "main",
+		Labels:      []string{"feature", "ui"},
+		TaskID:      1,
+	}
+
+	tests := []struct {
+		name    string
+		message string
+		issue   int
+		want    string
+	}{
+		{"default", DefaultMergeMessage, 0, "Add login\n\nCrew-Task: 1"},
+		{"default with issue", DefaultMergeMessage, 42, "Add login (#42)\n\nCrew-Task: 1"},
+		{"labels", `{{.Title}} [{{join .Labels ","}}]`, 0, "Add login [feature,ui]"},
+		{"trims whitespace", "\n{{.Branch}} -> {{.BaseBranch}}\n\n", 0, "crew-1 -> main"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := data
+			d.Issue = tt.issue
+			got, err := RenderMergeMessage(tt.message, d)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRenderMergeMessage_InvalidTemplate(t *testing.T) {
+	_, err := RenderMergeMessage("{{.Title", MergeMessageData{})
+	assert.Error(t, err)
+
+	_, err = RenderMergeMessage("{{.Unknown}}", MergeMessageData{})
+	assert.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // BranchName returns the branch name for a task.
@@ -29,6 +30,19 @@ func ReviewSessionName(taskID int) string {
 	return fmt.Sprintf("crew-%d-review", taskID)
 }
 
+// ReviewerSessionName returns the tmux session name for an additional reviewer
+// of a multi-reviewer review, which runs alongside ReviewSessionName.
+// Format: crew-<id>-review-<agent> ('.' and ':' in the agent name become '_')
+func ReviewerSessionName(taskID int, agent string) string {
+	return fmt.Sprintf("%s-%s", ReviewSessionName(taskID), strings.NewReplacer(".", "_", ":", "_").Replace(agent))
+}
+
+// ConflictResolverSessionName returns the tmux session name for a task's conflict resolver.
+// Format: crew-<id>-resolve
+func ConflictResolverSessionName(taskID int) string {
+	return fmt.Sprintf("crew-%d-resolve", taskID)
+}
+
 // ManagerSessionName returns the tmux session name for the manager.
 // Format: crew-manager (fixed, only one manager session)
 func ManagerSessionName() string {
@@ -61,6 +75,47 @@ func SessionLogPath(crewDir string, sessionName string) string {
 	return filepath.Join(crewDir, "logs", sessionName+".log")
 }
 
+// ReviewResultPath returns the path where a reviewer session writes its JSON verdict.
+func ReviewResultPath(crewDir string, sessionName string) string {
+	return filepath.Join(crewDir, "logs", sessionName+".review.json")
+}
+
+// RecordingPath returns the base path of the asciicast recordings of a session.
+// Each run of the session is recorded to its own file next to it (see RecordingRunPath);
+// the base path itself only holds a recording made before runs were kept apart.
+func RecordingPath(crewDir string, sessionName string) string {
+	return filepath.Join(crewDir, "logs", sessionName+".cast")
+}
+
+// recordingRunFormat is the start time format in per-run recording names.
+// It sorts in chronological order.
+const recordingRunFormat = "20060102-150405.000"
+
+// RecordingRunPath returns the path of the recording of a session run started at start,
+// given the base recording path of the session (e.g., crew-1.20260118-100000.000.cast).
+func RecordingRunPath(base string, start time.Time) string {
+	return strings.TrimSuffix(base, ".cast") + "." + start.UTC().Format(recordingRunFormat) + ".cast"
+}
+
+// ParseRecordingRunPath returns the start time of the run recorded at path,
+// or false if path is not a run recording of the session with the given base path.
+func ParseRecordingRunPath(base, path string) (time.Time, bool) {
+	prefix := strings.TrimSuffix(base, ".cast") + "."
+	if !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, ".cast") {
+		return time.Time{}, false
+	}
+	start, err := time.Parse(recordingRunFormat, strings.TrimSuffix(strings.TrimPrefix(path, prefix), ".cast"))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+// EventsPath returns the path to the task event log.
+func EventsPath(crewDir string) string {
+	return filepath.Join(crewDir, "events.jsonl")
+}
+
 // TasksStorePath returns the path to the tasks directory.
 func TasksStorePath(crewDir string) string {
 	return filepath.Join(crewDir, "tasks")
@@ -76,6 +131,11 @@ func TmuxConfigPath(crewDir string) string {
 	return filepath.Join(crewDir, "tmux.conf")
 }
 
+// SessionSocketPath returns the path to the control socket of a pty session.
+func SessionSocketPath(crewDir string, sessionName string) string {
+	return filepath.Join(crewDir, "sessions", sessionName+".sock")
+}
+
 // WorktreePath returns the path to a worktree for a task.
 // worktreeDir should be the base directory for worktrees (e.g., .crew/worktrees).
 func WorktreePath(worktreeDir string, taskID int) string {
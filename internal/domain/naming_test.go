@@ -1,6 +1,9 @@
 package domain
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestParseBranchTaskID(t *testing.T) {
 	tests := []struct {
@@ -152,6 +155,26 @@ func TestReviewSessionName(t *testing.T) {
 	}
 }
 
+func TestReviewerSessionName(t *testing.T) {
+	tests := []struct {
+		agent  string
+		want   string
+		taskID int
+	}{
+		{taskID: 1, agent: "codex-reviewer", want: "crew-1-review-codex-reviewer"},
+		{taskID: 7, agent: "gpt-4.1:fast", want: "crew-7-review-gpt-4_1_fast"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := ReviewerSessionName(tt.taskID, tt.agent)
+			if got != tt.want {
+				t.Errorf("ReviewerSessionName(%d, %q) = %q, want %q", tt.taskID, tt.agent, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestPathFunctions(t *testing.T) {
 	crewDir := "/repo/.crew"
 
@@ -228,3 +251,28 @@ func TestPathFunctions(t *testing.T) {
 		}
 	})
 }
+
+func TestRecordingRunPath(t *testing.T) {
+	base := RecordingPath("/repo/.crew", "crew-1")
+	start := time.Date(2026, 1, 18, 10, 0, 5, 123e6, time.UTC)
+
+	path := RecordingRunPath(base, start)
+	if want := "/repo/.crew/logs/crew-1.20260118-100005.123.cast"; path != want {
+		t.Errorf("RecordingRunPath() = %q, want %q", path, want)
+	}
+
+	got, ok := ParseRecordingRunPath(base, path)
+	if !ok || !got.Equal(start) {
+		t.Errorf("ParseRecordingRunPath(%q) = %v, %v, want %v, true", path, got, ok, start)
+	}
+	for _, other := range []string{
+		base,
+		"/repo/.crew/logs/crew-10.20260118-100005.123.cast",
+		"/repo/.crew/logs/crew-1-review.20260118-100005.123.cast",
+		"/repo/.crew/logs/crew-1.review.json",
+	} {
+		if _, ok := ParseRecordingRunPath(base, other); ok {
+			t.Errorf("ParseRecordingRunPath(%q) = true, want false", other)
+		}
+	}
+}
@@ -55,7 +55,7 @@ type TaskRepository interface {
 	SaveSnapshot(mainSHA string) error
 
 	// RestoreSnapshot restores tasks from a snapshot.
-	// Before restoring, the current state is saved as a new snapshot.
+	// Returns ErrSnapshotNotFound if the snapshot does not exist.
 	RestoreSnapshot(snapshotRef string) error
 
 	// ListSnapshots returns all snapshots for a given main SHA.
@@ -106,7 +106,7 @@ type ProcessInfo struct {
 	PPID    int    // Parent process ID
 }
 
-// SessionManager manages tmux sessions.
+// SessionManager manages agent sessions (tmux or pty, see [session] backend).
 type SessionManager interface {
 	// Start creates and starts a new session.
 	Start(ctx context.Context, opts StartSessionOptions) error
@@ -205,8 +205,22 @@ type Git interface {
 	// when merging branch into target. Returns empty slice if no conflicts.
 	GetMergeConflictFiles(branch, target string) ([]string, error)
 
-	// Merge merges a branch into the current branch.
-	Merge(branch string, noFF bool) error
+	// BranchDiff returns the changes made on branch since it diverged from base
+	// (git diff base...branch), limited to paths when given.
+	BranchDiff(base, branch string, paths []string) (string, error)
+
+	// Merge integrates a branch into the current branch using opts.Strategy.
+	Merge(branch string, opts MergeOptions) error
+
+	// UpdateBranch brings the branch checked out in dir up to date with base,
+	// by rebasing onto it (MergeStrategyRebase) or merging it in (MergeStrategyMerge).
+	// On conflict the worktree is restored and the conflicting files are returned
+	// with ErrMergeConflict.
+	UpdateBranch(dir, base string, strategy MergeStrategy) ([]string, error)
+
+	// RebaseOnto moves the commits of the branch checked out in dir that are not
+	// in upstream onto the onto branch. Conflicts are handled as in UpdateBranch.
+	RebaseOnto(dir, onto, upstream string) ([]string, error)
 
 	// DeleteBranch deletes a branch.
 	// If force is true, it uses -D (force delete), otherwise -d.
@@ -218,6 +232,9 @@ type Git interface {
 	// GetDefaultBranch returns the default branch name.
 	// Priority: git config crew.defaultBranch > refs/remotes/origin/HEAD > "main"
 	GetDefaultBranch() (string, error)
+
+	// RevParse resolves a revision (branch, tag, or SHA) to a full commit SHA.
+	RevParse(rev string) (string, error)
 }
 
 // GitHub provides GitHub integration via gh CLI.
@@ -225,6 +242,9 @@ type GitHub interface {
 	// GetIssue retrieves issue information.
 	GetIssue(number int) (*Issue, error)
 
+	// ListIssues lists issues matching the options.
+	ListIssues(opts ListIssuesOptions) ([]*Issue, error)
+
 	// CreatePR creates a pull request.
 	CreatePR(opts CreatePROptions) (int, error)
 
@@ -238,6 +258,14 @@ type GitHub interface {
 	Push(branch string) error
 }
 
+// ListIssuesOptions configures issue listing.
+// Fields are ordered to minimize memory padding.
+type ListIssuesOptions struct {
+	State  string   // Issue state: "open" (default), "closed", or "all"
+	Labels []string // Only issues with all of these labels
+	Limit  int      // Maximum number of issues (0 = adapter default)
+}
+
 // CreatePROptions configures PR creation.
 type CreatePROptions struct {
 	Title  string
@@ -350,6 +378,24 @@ type Logger interface {
 	Close() error
 }
 
+// EventLog is an append-only log of task events.
+type EventLog interface {
+	// Append appends events to the log.
+	Append(events ...Event) error
+
+	// ReadFrom returns the events stored after the given byte offset and
+	// the offset to continue reading from. Incomplete trailing lines are left
+	// for the next read. If the log is shorter than offset (e.g., it was
+	// truncated), reading restarts from the beginning.
+	ReadFrom(offset int64) ([]Event, int64, error)
+}
+
+// EventHandler reacts to task events after they are recorded.
+type EventHandler interface {
+	// HandleEvents is called with the saved task and the events describing its change.
+	HandleEvents(task *Task, events []Event)
+}
+
 // ScriptRunner executes shell scripts in a specified directory.
 type ScriptRunner interface {
 	// Run executes a script in the given directory.
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// RecordingVersion is the asciicast format version of session recordings.
+const RecordingVersion = 2
+
+// Recording event types (asciicast v2).
+const (
+	RecordingOutput = "o" // Data written to the terminal
+	RecordingInput  = "i" // Data typed by the user
+	RecordingResize = "r" // Terminal resized; data is "<cols>x<rows>"
+	RecordingMarker = "m" // Marker; data is the marker label
+)
+
+// RecordingHeader is the first line of an asciicast v2 recording.
+// Fields are ordered to minimize memory padding.
+type RecordingHeader struct {
+	Env       map[string]string `json:"env,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp,omitempty"` // Unix time the recording started
+}
+
+// RecordingEvent is a single asciicast v2 event.
+// It is encoded as a JSON array: [time, type, data].
+type RecordingEvent struct {
+	Type string  // Event type (RecordingOutput, RecordingResize, ...)
+	Data string  // Event data
+	Time float64 // Seconds since the recording started
+}
+
+// MarshalJSON encodes the event as an asciicast v2 array.
+func (e RecordingEvent) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	buf.WriteString(strconv.FormatFloat(e.Time, 'f', 6, 64))
+	buf.WriteString(`,"`)
+	buf.WriteString(e.Type)
+	buf.WriteString(`",`)
+	buf.Write(data)
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes an asciicast v2 event array.
+func (e *RecordingEvent) UnmarshalJSON(data []byte) error {
+	var fields []json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if len(fields) != 3 {
+		return fmt.Errorf("invalid recording event: expected 3 fields, got %d", len(fields))
+	}
+	if err := json.Unmarshal(fields[0], &e.Time); err != nil {
+		return fmt.Errorf("invalid recording event time: %w", err)
+	}
+	if err := json.Unmarshal(fields[1], &e.Type); err != nil {
+		return fmt.Errorf("invalid recording event type: %w", err)
+	}
+	if err := json.Unmarshal(fields[2], &e.Data); err != nil {
+		return fmt.Errorf("invalid recording event data: %w", err)
+	}
+	return nil
+}
+
+// RecordingReader reads an asciicast v2 recording event by event.
+type RecordingReader struct {
+	reader *bufio.Reader
+	Header RecordingHeader
+}
+
+// NewRecordingReader reads the recording header from r.
+func NewRecordingReader(r io.Reader) (*RecordingReader, error) {
+	reader := bufio.NewReader(r)
+	line, err := reader.ReadBytes('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || len(line) == 0) {
+		return nil, fmt.Errorf("read recording header: %w", err)
+	}
+	var header RecordingHeader
+	if err := json.Unmarshal(line, &header); err != nil {
+		return nil, fmt.Errorf("invalid recording header: %w", err)
+	}
+	if header.Version != RecordingVersion {
+		return nil, fmt.Errorf("unsupported recording version: %d", header.Version)
+	}
+	return &RecordingReader{reader: reader, Header: header}, nil
+}
+
+// Next returns the next event, or io.EOF at the end of the recording.
+// A truncated last line (the session was killed while writing) also ends the recording.
+func (r *RecordingReader) Next() (RecordingEvent, error) {
+	for {
+		line, err := r.reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			var event RecordingEvent
+			if jsonErr := json.Unmarshal(line, &event); jsonErr != nil {
+				if err != nil {
+					return RecordingEvent{}, io.EOF
+				}
+				return RecordingEvent{}, jsonErr
+			}
+			return event, nil
+		}
+		if err != nil {
+			return RecordingEvent{}, err
+		}
+	}
+}
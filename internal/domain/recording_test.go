@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingEvent_JSON(t *testing.T) {
+	event := RecordingEvent{Time: 1.5, Type: RecordingOutput, Data: "hello\r\n\x1b[0m"}
+
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.Equal(t, `[1.500000,"o","hello\r\n\u001b[0m"]`, string(data))
+
+	var decoded RecordingEvent
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, event, decoded)
+}
+
+func TestRecordingEvent_UnmarshalJSON_Invalid(t *testing.T) {
+	var event RecordingEvent
+	assert.Error(t, json.Unmarshal([]byte(`[1.0, "o"]`), &event))
+	assert.Error(t, json.Unmarshal([]byte(`["x", "o", "data"]`), &event))
+}
+
+func TestRecordingReader(t *testing.T) {
+	recording := `{"version": 2, "width": 80, "height": 24, "timestamp": 1700000000}
+[0.100000, "o", "first"]
+
+[0.200000, "r", "100x30"]
+[0.300000, "o", "trunc`
+
+	reader, err := NewRecordingReader(strings.NewReader(recording))
+	require.NoError(t, err)
+	assert.Equal(t, 80, reader.Header.Width)
+	assert.Equal(t, 24, reader.Header.Height)
+
+	event, err := reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, RecordingEvent{Time: 0.1, Type: RecordingOutput, Data: "first"}, event)
+
+	event, err = reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, RecordingEvent{Time: 0.2, Type: RecordingResize, Data: "100x30"}, event)
+
+	// Truncated last line ends the recording
+	_, err = reader.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestNewRecordingReader_Errors(t *testing.T) {
+	_, err := NewRecordingReader(strings.NewReader(""))
+	assert.Error(t, err)
+
+	_, err = NewRecordingReader(strings.NewReader(`{"version": 1, "width": 80, "height": 24}` + "\n"))
+	assert.ErrorContains(t, err, "unsupported recording version")
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// Exit codes of a session that was stopped on purpose rather than failing.
+// The task script maps SIGINT and SIGTERM to these codes.
+const (
+	ExitCodeInterrupted = 130 // Ctrl+C
+	ExitCodeTerminated  = 143 // SIGTERM (e.g., kill)
+)
+
+// ExitClass classifies how a worker session ended.
+type ExitClass int
+
+const (
+	ExitClean   ExitClass = iota // Exit code 0
+	ExitStopped                  // Stopped on purpose; never retried
+	ExitFailed                   // Failed; retried by restarts and fallback agents
+)
+
+// ClassifyExitCode classifies the exit code of a worker session.
+// Ctrl+C and SIGTERM, and the agent's no_retry_exit_codes, count as stopped.
+func ClassifyExitCode(code int, noRetry []int) ExitClass {
+	switch {
+	case code == 0:
+		return ExitClean
+	case code == ExitCodeInterrupted, code == ExitCodeTerminated, slices.Contains(noRetry, code):
+		return ExitStopped
+	default:
+		return ExitFailed
+	}
+}
+
+// Restart backoff defaults.
+const (
+	DefaultRestartBackoff = 30 * time.Second // Delay before the first restart
+	MaxRestartBackoff     = 30 * time.Minute // Upper bound of the doubled delay
+)
+
+// RestartDelay returns how long to wait before restarting a task whose session
+// ended in error, given the number of restarts made so far. The delay starts at
+// restart_backoff (default 30s) and doubles with each restart, up to 30 minutes.
+func (a Agent) RestartDelay(restarts int) time.Duration {
+	delay := DefaultRestartBackoff
+	if a.RestartBackoff > 0 {
+		delay = time.Duration(a.RestartBackoff) * time.Second
+	}
+	for i := 0; i < restarts && delay < MaxRestartBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, MaxRestartBackoff)
+}
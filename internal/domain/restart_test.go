@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyExitCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		noRetry []int
+		code    int
+		want    ExitClass
+	}{
+		{name: "clean exit", code: 0, want: ExitClean},
+		{name: "failure", code: 1, want: ExitFailed},
+		{name: "other signal", code: 137, want: ExitFailed},
+		{name: "ctrl+c", code: ExitCodeInterrupted, want: ExitStopped},
+		{name: "crew stop", code: ExitCodeTerminated, want: ExitStopped},
+		{name: "no_retry_exit_codes", code: 2, noRetry: []int{2, 3}, want: ExitStopped},
+		{name: "code not in no_retry_exit_codes", code: 1, noRetry: []int{2, 3}, want: ExitFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClassifyExitCode(tt.code, tt.noRetry))
+		})
+	}
+}
+
+func TestAgent_RestartDelay(t *testing.T) {
+	assert.Equal(t, DefaultRestartBackoff, Agent{}.RestartDelay(0))
+	assert.Equal(t, 2*DefaultRestartBackoff, Agent{}.RestartDelay(1))
+
+	agent := Agent{RestartBackoff: 10}
+	assert.Equal(t, 10*time.Second, agent.RestartDelay(0))
+	assert.Equal(t, 20*time.Second, agent.RestartDelay(1))
+	assert.Equal(t, 40*time.Second, agent.RestartDelay(2))
+	assert.Equal(t, MaxRestartBackoff, agent.RestartDelay(20))
+	assert.Equal(t, MaxRestartBackoff, Agent{RestartBackoff: 7200}.RestartDelay(0))
+}
@@ -0,0 +1,313 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ReviewVerdict is the overall outcome of a review.
+type ReviewVerdict string
+
+const (
+	ReviewVerdictLGTM         ReviewVerdict = "lgtm"          // Ready to merge
+	ReviewVerdictMinorIssues  ReviewVerdict = "minor_issues"  // Mergeable, with non-blocking remarks
+	ReviewVerdictNeedsChanges ReviewVerdict = "needs_changes" // Blocking issues must be fixed
+)
+
+// Review result headlines, matched by review_success_regex.
+const (
+	reviewMinorIssuesPrefix  = "⚠️ Minor issues"
+	reviewNeedsChangesPrefix = "❌ Needs changes"
+)
+
+// IsValid returns true if the verdict is a known value.
+func (v ReviewVerdict) IsValid() bool {
+	switch v {
+	case ReviewVerdictLGTM, ReviewVerdictMinorIssues, ReviewVerdictNeedsChanges:
+		return true
+	}
+	return false
+}
+
+// Headline returns the first line of a review with this verdict (e.g., "✅ LGTM").
+func (v ReviewVerdict) Headline() string {
+	switch v {
+	case ReviewVerdictLGTM:
+		return ReviewLGTMPrefix
+	case ReviewVerdictMinorIssues:
+		return reviewMinorIssuesPrefix
+	case ReviewVerdictNeedsChanges:
+		return reviewNeedsChangesPrefix
+	}
+	return ""
+}
+
+// ReviewSeverity classifies a review finding.
+type ReviewSeverity string
+
+const (
+	ReviewSeverityBlocking   ReviewSeverity = "blocking"   // Must be fixed before merging
+	ReviewSeveritySuggestion ReviewSeverity = "suggestion" // Improvement idea
+	ReviewSeverityNit        ReviewSeverity = "nit"        // Minor style issue
+)
+
+// IsValid returns true if the severity is a known value.
+func (s ReviewSeverity) IsValid() bool {
+	switch s {
+	case ReviewSeverityBlocking, ReviewSeveritySuggestion, ReviewSeverityNit:
+		return true
+	}
+	return false
+}
+
+// ReviewCommentAuthor is the author of comments recording review results.
+const ReviewCommentAuthor = "reviewer"
+
+// ReviewFinding is a single issue raised by a reviewer.
+// Fields are ordered to minimize memory padding.
+type ReviewFinding struct {
+	File     string         `json:"file,omitempty"`     // Path relative to the repository root (optional)
+	Severity ReviewSeverity `json:"severity"`           // blocking, suggestion, or nit
+	Message  string         `json:"message"`            // What is wrong and how to fix it
+	Line     int            `json:"line,omitempty"`     // 1-based first line (0 if not line specific)
+	EndLine  int            `json:"end_line,omitempty"` // 1-based last line of a range (0 for a single line)
+}
+
+// LastLine returns the last line the finding refers to (Line for a single line).
+func (f ReviewFinding) LastLine() int {
+	if f.EndLine > f.Line {
+		return f.EndLine
+	}
+	return f.Line
+}
+
+// Location returns "file:line", "file:start-end", "file", or "" depending on what is known.
+func (f ReviewFinding) Location() string {
+	if f.File == "" {
+		return ""
+	}
+	if f.Line <= 0 {
+		return f.File
+	}
+	if last := f.LastLine(); last > f.Line {
+		return f.File + ":" + strconv.Itoa(f.Line) + "-" + strconv.Itoa(last)
+	}
+	return f.File + ":" + strconv.Itoa(f.Line)
+}
+
+// Review is a typed review record.
+// Reviewers emit it as JSON (see ParseReviewJSON); it is stored as the
+// Markdown returned by Text, which ParseReviewText reads back.
+// Fields are ordered to minimize memory padding.
+type Review struct {
+	Verdict  ReviewVerdict   `json:"verdict"`
+	Summary  string          `json:"summary"`
+	Findings []ReviewFinding `json:"findings,omitempty"`
+}
+
+// ParseReviewJSON parses a JSON review verdict.
+// Text around the first JSON object, such as a Markdown code fence, is ignored.
+func ParseReviewJSON(text string) (*Review, error) {
+	start := strings.IndexByte(text, '{')
+	if start < 0 {
+		return nil, fmt.Errorf("%w: no JSON object found", ErrInvalidReview)
+	}
+
+	var review Review
+	if err := json.NewDecoder(strings.NewReader(text[start:])).Decode(&review); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidReview, err)
+	}
+	review.Verdict = ReviewVerdict(strings.ToLower(strings.TrimSpace(string(review.Verdict))))
+	if !review.Verdict.IsValid() {
+		return nil, fmt.Errorf("%w: unknown verdict %q (expected lgtm, minor_issues, or needs_changes)", ErrInvalidReview, review.Verdict)
+	}
+	for i := range review.Findings {
+		finding := &review.Findings[i]
+		finding.Severity = ReviewSeverity(strings.ToLower(strings.TrimSpace(string(finding.Severity))))
+		if !finding.Severity.IsValid() {
+			finding.Severity = ReviewSeveritySuggestion
+		}
+		if finding.EndLine <= finding.Line {
+			finding.EndLine = 0
+		}
+	}
+	return &review, nil
+}
+
+// Text renders the review as Markdown, starting with the verdict headline
+// so review_success_regex keeps matching it.
+func (r *Review) Text() string {
+	var b strings.Builder
+	b.WriteString(r.Verdict.Headline())
+	if summary := strings.TrimSpace(r.Summary); summary != "" {
+		b.WriteString("\n\n## Summary\n")
+		b.WriteString(summary)
+	}
+	if len(r.Findings) > 0 {
+		b.WriteString("\n\n## Findings")
+		for _, finding := range r.Findings {
+			b.WriteString("\n- [" + strings.ToUpper(string(finding.Severity)) + "] ")
+			if loc := finding.Location(); loc != "" {
+				b.WriteString("`" + loc + "` ")
+			}
+			b.WriteString(strings.TrimSpace(finding.Message))
+		}
+	}
+	return b.String()
+}
+
+// reviewFindingPattern matches finding lines such as
+// "- [BLOCKING] `main.go:12-14` message" (the location is optional).
+var reviewFindingPattern = regexp.MustCompile("^\\s*[-*]\\s*\\[(BLOCKING|SUGGESTION|NIT)\\]\\s*(?:`([^`:\\s]+)(?::(\\d+)(?:-(\\d+))?)?`\\s*)?(.*)$")
+
+// ParseReviewText reads a review from Markdown, either rendered by Review.Text
+// or written by the reviewer in the legacy free-form format.
+// The verdict is empty when the text does not start with a known headline.
+func ParseReviewText(text string) *Review {
+	text = strings.TrimSpace(text)
+	review := &Review{}
+	for _, verdict := range []ReviewVerdict{ReviewVerdictLGTM, ReviewVerdictMinorIssues, ReviewVerdictNeedsChanges} {
+		if strings.HasPrefix(text, verdict.Headline()) {
+			review.Verdict = verdict
+			break
+		}
+	}
+
+	var summary []string
+	inSummary := false
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(line, "## ") {
+			inSummary = strings.TrimSpace(line[3:]) == "Summary"
+			continue
+		}
+		if m := reviewFindingPattern.FindStringSubmatch(line); m != nil {
+			lineNo, _ := strconv.Atoi(m[3])
+			endLine, _ := strconv.Atoi(m[4])
+			review.Findings = append(review.Findings, ReviewFinding{
+				File:     m[2],
+				Line:     lineNo,
+				EndLine:  endLine,
+				Severity: ReviewSeverity(strings.ToLower(m[1])),
+				Message:  strings.TrimSpace(m[5]),
+			})
+			continue
+		}
+		if inSummary {
+			summary = append(summary, line)
+		}
+	}
+	review.Summary = strings.TrimSpace(strings.Join(summary, "\n"))
+	return review
+}
+
+// LatestReviewComments returns the review comments of the most recent review.
+// A multi-reviewer review records one comment per reviewer (tagged with the
+// "reviewer" metadata key) in a row; all of them are returned in that case.
+func LatestReviewComments(comments []Comment) []Comment {
+	last := -1
+	for i := len(comments) - 1; i >= 0; i-- {
+		if comments[i].Author == ReviewCommentAuthor {
+			last = i
+			break
+		}
+	}
+	if last < 0 {
+		return nil
+	}
+
+	first := last
+	seen := make(map[string]bool)
+	for i := last; i >= 0; i-- {
+		c := comments[i]
+		reviewer := c.Metadata["reviewer"]
+		if c.Author != ReviewCommentAuthor || reviewer == "" || seen[reviewer] {
+			break
+		}
+		seen[reviewer] = true
+		first = i
+	}
+	return comments[first : last+1]
+}
+
+// ReviewFindings returns the findings recorded on a review comment.
+// Comments recorded before findings were stored are parsed from their text.
+func (c Comment) ReviewFindings() []ReviewFinding {
+	if len(c.Findings) > 0 || c.Author != ReviewCommentAuthor {
+		return c.Findings
+	}
+	return ParseReviewText(c.Text).Findings
+}
+
+// ReviewFeedback is the previous review handed to agents on a follow-up.
+// In prompt templates {{.PreviousReview}} prints the review text, and
+// {{range .PreviousReview.Findings}} iterates over the individual findings.
+type ReviewFeedback struct {
+	Text     string          // Review text as recorded in the review comments
+	Findings []ReviewFinding // Findings with file, line range and severity
+}
+
+// NewReviewFeedback builds feedback from review comments.
+// Returns nil when there are no comments.
+func NewReviewFeedback(comments []Comment) *ReviewFeedback {
+	if len(comments) == 0 {
+		return nil
+	}
+	feedback := &ReviewFeedback{}
+	texts := make([]string, 0, len(comments))
+	for _, c := range comments {
+		text := c.Text
+		if reviewer := c.Metadata["reviewer"]; reviewer != "" && len(comments) > 1 {
+			text = "Review by " + reviewer + ":\n" + text
+		}
+		texts = append(texts, text)
+		feedback.Findings = append(feedback.Findings, c.ReviewFindings()...)
+	}
+	feedback.Text = strings.Join(texts, "\n\n")
+	return feedback
+}
+
+// String returns the review text, so templates can print the feedback directly.
+func (f *ReviewFeedback) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.Text
+}
+
+// ReviewPolicy decides whether a multi-reviewer review passes.
+type ReviewPolicy string
+
+const (
+	ReviewPolicyAll      ReviewPolicy = "all"      // Every reviewer must pass (default)
+	ReviewPolicyMajority ReviewPolicy = "majority" // More than half of the reviewers must pass
+	ReviewPolicyAny      ReviewPolicy = "any"      // At least one reviewer must pass
+)
+
+// IsValid returns true if the policy is a known value.
+func (p ReviewPolicy) IsValid() bool {
+	switch p {
+	case ReviewPolicyAll, ReviewPolicyMajority, ReviewPolicyAny:
+		return true
+	}
+	return false
+}
+
+// Satisfied reports whether passed out of total reviewers meets the policy.
+// An empty policy behaves like ReviewPolicyAll.
+func (p ReviewPolicy) Satisfied(passed, total int) bool {
+	if total <= 0 {
+		return false
+	}
+	switch p {
+	case ReviewPolicyMajority:
+		return passed*2 > total
+	case ReviewPolicyAny:
+		return passed > 0
+	case ReviewPolicyAll:
+		return passed == total
+	}
+	return passed == total
+}
@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ReviewAnnotationPrefix starts each review finding line inserted into a diff by AnnotateDiff.
+const ReviewAnnotationPrefix = ">> "
+
+var (
+	ansiEscapePattern = regexp.MustCompile("\x1b\\[[0-9;]*[A-Za-z]")
+	diffHunkPattern   = regexp.MustCompile(`^@@ -\d+(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+)
+
+// AnnotateDiff interleaves review findings with a unified diff.
+// A finding is inserted after the last line of its range that appears in the
+// diff (line numbers refer to the new version of the file). Findings that
+// cannot be placed, such as those without a file or on lines outside the
+// diff, are listed after the diff. Colored output is understood; diffs in
+// other formats only get the trailing list.
+func AnnotateDiff(diff string, findings []ReviewFinding) string {
+	if len(findings) == 0 {
+		return diff
+	}
+
+	var lines []string
+	if diff != "" {
+		lines = strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+	}
+
+	anchors := make([]int, len(findings))
+	for i := range anchors {
+		anchors[i] = -1
+	}
+	file := ""
+	newLine, oldLeft, newLeft := 0, 0, 0
+	for i, raw := range lines {
+		line := ansiEscapePattern.ReplaceAllString(raw, "")
+		if oldLeft > 0 || newLeft > 0 {
+			switch {
+			case strings.HasPrefix(line, "\\"):
+				// "\ No newline at end of file"
+			case strings.HasPrefix(line, "-"):
+				oldLeft--
+			case strings.HasPrefix(line, "+"):
+				newLeft--
+				anchorFindings(anchors, findings, file, newLine, i)
+				newLine++
+			default:
+				oldLeft--
+				newLeft--
+				anchorFindings(anchors, findings, file, newLine, i)
+				newLine++
+			}
+			continue
+		}
+		switch {
+		case strings.HasPrefix(line, "diff "):
+			file = ""
+		case strings.HasPrefix(line, "+++ "):
+			file = diffFilePath(line[4:])
+		case strings.HasPrefix(line, "@@ "):
+			if m := diffHunkPattern.FindStringSubmatch(line); m != nil {
+				oldLeft = hunkLength(m[1])
+				newLine, _ = strconv.Atoi(m[2])
+				newLeft = hunkLength(m[3])
+			}
+		}
+	}
+
+	placed := make(map[int][]ReviewFinding)
+	var unplaced []ReviewFinding
+	for i, finding := range findings {
+		if anchors[i] < 0 {
+			unplaced = append(unplaced, finding)
+			continue
+		}
+		placed[anchors[i]] = append(placed[anchors[i]], finding)
+	}
+
+	var b strings.Builder
+	for i, line := range lines {
+		b.WriteString(line)
+		b.WriteByte('\n')
+		for _, finding := range placed[i] {
+			writeDiffAnnotation(&b, finding, false)
+		}
+	}
+	if len(unplaced) > 0 {
+		if len(lines) > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString("Review findings outside the diff:\n")
+		for _, finding := range unplaced {
+			writeDiffAnnotation(&b, finding, true)
+		}
+	}
+	return b.String()
+}
+
+// anchorFindings attaches findings covering line of file to the diff line at index.
+func anchorFindings(anchors []int, findings []ReviewFinding, file string, line, index int) {
+	if file == "" {
+		return
+	}
+	for i, finding := range findings {
+		if finding.Line <= 0 || strings.TrimPrefix(finding.File, "./") != file {
+			continue
+		}
+		if line >= finding.Line && line <= finding.LastLine() {
+			anchors[i] = index
+		}
+	}
+}
+
+// diffFilePath returns the path from a "+++ " header, or "" for a deleted file.
+func diffFilePath(header string) string {
+	path, _, _ := strings.Cut(header, "\t")
+	path = strings.Trim(strings.TrimSpace(path), `"`)
+	if path == "/dev/null" {
+		return ""
+	}
+	return strings.TrimPrefix(path, "b/")
+}
+
+// hunkLength parses a hunk line count, which defaults to 1 when omitted.
+func hunkLength(s string) int {
+	if s == "" {
+		return 1
+	}
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+func writeDiffAnnotation(b *strings.Builder, finding ReviewFinding, withLocation bool) {
+	b.WriteString(ReviewAnnotationPrefix + "[" + strings.ToUpper(string(finding.Severity)) + "] ")
+	if loc := finding.Location(); withLocation && loc != "" {
+		b.WriteString(loc + " ")
+	} else if !withLocation && finding.LastLine() > finding.Line {
+		b.WriteString("(lines " + strconv.Itoa(finding.Line) + "-" + strconv.Itoa(finding.LastLine()) + ") ")
+	}
+	message := strings.TrimSpace(finding.Message)
+	b.WriteString(strings.ReplaceAll(message, "\n", "\n"+strings.Repeat(" ", len(ReviewAnnotationPrefix))))
+	b.WriteByte('\n')
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const annotateDiffInput = `diff --git a/main.go b/main.go
+index 1111111..2222222 100644
+--- a/main.go
++++ b/main.go
+@@ -10,4 +10,5 @@ func main() {
+ 	a := 1
+-	b := 2
++	b := 3
++	c := b
+ 	fmt.Println(a)
+diff --git a/util.go b/util.go
+--- a/util.go
++++ b/util.go
+@@ -1 +1 @@
+-package old
++package util
+`
+
+func TestAnnotateDiff(t *testing.T) {
+	findings := []ReviewFinding{
+		{File: "main.go", Line: 11, EndLine: 12, Severity: ReviewSeverityBlocking, Message: "c is unused\nRemove it"},
+		{File: "main.go", Line: 10, Severity: ReviewSeverityNit, Message: "Rename a"},
+		{File: "util.go", Line: 1, Severity: ReviewSeveritySuggestion, Message: "Better name"},
+		{File: "main.go", Line: 99, Severity: ReviewSeverityNit, Message: "Not in the diff"},
+		{Severity: ReviewSeveritySuggestion, Message: "Add tests"},
+	}
+
+	got := AnnotateDiff(annotateDiffInput, findings)
+
+	assert.Equal(t, `diff --git a/main.go b/main.go
+index 1111111..2222222 100644
+--- a/main.go
++++ b/main.go
+@@ -10,4 +10,5 @@ func main() {
+ 	a := 1
+>> [NIT] Rename a
+-	b := 2
++	b := 3
++	c := b
+>> [BLOCKING] (lines 11-12) c is unused
+   Remove it
+ 	fmt.Println(a)
+diff --git a/util.go b/util.go
+--- a/util.go
++++ b/util.go
+@@ -1 +1 @@
+-package old
++package util
+>> [SUGGESTION] Better name
+
+Review findings outside the diff:
+>> [NIT] main.go:99 Not in the diff
+>> [SUGGESTION] Add tests
+`, got)
+}
+
+func TestAnnotateDiff_ColoredOutput(t *testing.T) {
+	diff := "\x1b[1m+++ b/a.go\x1b[m\n\x1b[36m@@ -1,0 +1 @@\x1b[m\n\x1b[32m+x\x1b[m\n"
+
+	got := AnnotateDiff(diff, []ReviewFinding{{File: "a.go", Line: 1, Severity: ReviewSeverityNit, Message: "m"}})
+
+	assert.Equal(t, diff+">> [NIT] m\n", got)
+}
+
+func TestAnnotateDiff_NoFindings(t *testing.T) {
+	assert.Equal(t, annotateDiffInput, AnnotateDiff(annotateDiffInput, nil))
+}
+
+func TestAnnotateDiff_EmptyDiff(t *testing.T) {
+	got := AnnotateDiff("", []ReviewFinding{{File: "a.go", Line: 3, Severity: ReviewSeverityBlocking, Message: "m"}})
+
+	assert.Equal(t, "Review findings outside the diff:\n>> [BLOCKING] a.go:3 m\n", got)
+}
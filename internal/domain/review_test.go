@@ -0,0 +1,245 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReviewJSON(t *testing.T) {
+	text := "Here is my verdict:\n```json\n" + `{
+  "verdict": "Needs_Changes",
+  "summary": "Missing nil check.",
+  "findings": [
+    {"file": "internal/app.go", "line": 12, "severity": "BLOCKING", "message": "Check err"},
+    {"severity": "praise", "message": "Nice tests"}
+  ]
+}` + "\n```\n"
+
+	review, err := ParseReviewJSON(text)
+
+	require.NoError(t, err)
+	assert.Equal(t, ReviewVerdictNeedsChanges, review.Verdict)
+	assert.Equal(t, "Missing nil check.", review.Summary)
+	require.Len(t, review.Findings, 2)
+	assert.Equal(t, ReviewFinding{File: "internal/app.go", Line: 12, Severity: ReviewSeverityBlocking, Message: "Check err"}, review.Findings[0])
+	assert.Equal(t, ReviewSeveritySuggestion, review.Findings[1].Severity, "unknown severities become suggestions")
+}
+
+func TestParseReviewJSON_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+	}{
+		{"no json", "✅ LGTM"},
+		{"malformed", `{"verdict": "lgtm"`},
+		{"unknown verdict", `{"verdict": "ship it"}`},
+		{"missing verdict", `{"summary": "ok"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseReviewJSON(tt.text)
+			assert.ErrorIs(t, err, ErrInvalidReview)
+		})
+	}
+}
+
+func TestReview_Text(t *testing.T) {
+	review := &Review{
+		Verdict: ReviewVerdictMinorIssues,
+		Summary: "Works, a few nits.",
+		Findings: []ReviewFinding{
+			{File: "a.go", Line: 3, Severity: ReviewSeverityNit, Message: "Rename x"},
+			{File: "README.md", Severity: ReviewSeveritySuggestion, Message: "Document flag"},
+			{Severity: ReviewSeveritySuggestion, Message: "Consider a benchmark"},
+		},
+	}
+
+	assert.Equal(t, "⚠️ Minor issues\n\n## Summary\nWorks, a few nits.\n\n## Findings\n"+
+		"- [NIT] `a.go:3` Rename x\n"+
+		"- [SUGGESTION] `README.md` Document flag\n"+
+		"- [SUGGESTION] Consider a benchmark", review.Text())
+	assert.Equal(t, ReviewLGTMPrefix, (&Review{Verdict: ReviewVerdictLGTM}).Text())
+}
+
+func TestParseReviewText_RoundTrip(t *testing.T) {
+	review := &Review{
+		Verdict: ReviewVerdictNeedsChanges,
+		Summary: "Two problems.\nSee below.",
+		Findings: []ReviewFinding{
+			{File: "cmd/main.go", Line: 7, Severity: ReviewSeverityBlocking, Message: "Leaks a goroutine"},
+			{Severity: ReviewSeverityNit, Message: "Typo"},
+		},
+	}
+
+	assert.Equal(t, review, ParseReviewText(review.Text()))
+}
+
+func TestParseReviewText_Legacy(t *testing.T) {
+	text := `❌ Needs changes
+
+## Summary
+The handler ignores errors.
+
+## Blocking Issues
+- [BLOCKING] ` + "`server.go:88`" + ` error from Write is dropped
+  - Why: clients see truncated output
+  - Suggestion: return the error
+
+## Suggestions
+- [NIT] naming`
+
+	review := ParseReviewText(text)
+
+	assert.Equal(t, ReviewVerdictNeedsChanges, review.Verdict)
+	assert.Equal(t, "The handler ignores errors.", review.Summary)
+	assert.Equal(t, []ReviewFinding{
+		{File: "server.go", Line: 88, Severity: ReviewSeverityBlocking, Message: "error from Write is dropped"},
+		{Severity: ReviewSeverityNit, Message: "naming"},
+	}, review.Findings)
+}
+
+func TestReviewFinding_Location(t *testing.T) {
+	assert.Equal(t, "", ReviewFinding{Line: 3}.Location())
+	assert.Equal(t, "a.go", ReviewFinding{File: "a.go"}.Location())
+	assert.Equal(t, "a.go:3", ReviewFinding{File: "a.go", Line: 3}.Location())
+	assert.Equal(t, "a.go:3-7", ReviewFinding{File: "a.go", Line: 3, EndLine: 7}.Location())
+	assert.Equal(t, "a.go:3", ReviewFinding{File: "a.go", Line: 3, EndLine: 2}.Location())
+}
+
+func TestParseReviewText_LineRange(t *testing.T) {
+	review := &Review{
+		Verdict:  ReviewVerdictNeedsChanges,
+		Findings: []ReviewFinding{{File: "a.go", Line: 3, EndLine: 7, Severity: ReviewSeverityBlocking, Message: "Split this"}},
+	}
+
+	assert.Contains(t, review.Text(), "- [BLOCKING] `a.go:3-7` Split this")
+	assert.Equal(t, review, ParseReviewText(review.Text()))
+}
+
+func TestParseReviewJSON_EndLine(t *testing.T) {
+	review, err := ParseReviewJSON(`{"verdict": "minor_issues", "findings": [
+		{"file": "a.go", "line": 3, "end_line": 5, "severity": "nit", "message": "range"},
+		{"file": "a.go", "line": 9, "end_line": 9, "severity": "nit", "message": "single"}]}`)
+	require.NoError(t, err)
+	assert.Equal(t, 5, review.Findings[0].EndLine)
+	assert.Equal(t, 0, review.Findings[1].EndLine, "an end line that is not after line is dropped")
+}
+
+func TestLatestReviewComments(t *testing.T) {
+	reviewer := func(text, name string) Comment {
+		c := Comment{Author: ReviewCommentAuthor, Text: text}
+		if name != "" {
+			c.Metadata = map[string]string{"reviewer": name}
+		}
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		comments []Comment
+		want     []string
+	}{
+		{"no reviews", []Comment{{Author: "worker", Text: "hi"}}, nil},
+		{"single reviewer", []Comment{reviewer("old", ""), {Author: "worker", Text: "fixed"}, reviewer("new", "")}, []string{"new"}},
+		{"multi reviewer", []Comment{
+			reviewer("a1", "a"), reviewer("b1", "b"),
+			{Author: "worker", Text: "fixed"},
+			reviewer("a2", "a"), reviewer("b2", "b"),
+			{Author: "gate", Text: "lint passed"},
+		}, []string{"a2", "b2"}},
+		{"repeated reviewer starts a new review", []Comment{reviewer("a1", "a"), reviewer("a2", "a")}, []string{"a2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, c := range LatestReviewComments(tt.comments) {
+				got = append(got, c.Text)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewReviewFeedback(t *testing.T) {
+	// Setup
+	comments := []Comment{
+		{
+			Author:   ReviewCommentAuthor,
+			Text:     "❌ Needs changes",
+			Metadata: map[string]string{"reviewer": "a"},
+			Findings: []ReviewFinding{{File: "a.go", Line: 1, Severity: ReviewSeverityBlocking, Message: "stored"}},
+		},
+		{
+			// Recorded before findings were stored
+			Author:   ReviewCommentAuthor,
+			Text:     "⚠️ Minor issues\n\n## Findings\n- [NIT] `b.go:2` parsed",
+			Metadata: map[string]string{"reviewer": "b"},
+		},
+	}
+
+	// Execute
+	feedback := NewReviewFeedback(comments)
+
+	// Assert
+	require.NotNil(t, feedback)
+	assert.Equal(t, "Review by a:\n❌ Needs changes\n\nReview by b:\n⚠️ Minor issues\n\n## Findings\n- [NIT] `b.go:2` parsed", feedback.Text)
+	assert.Equal(t, []ReviewFinding{
+		{File: "a.go", Line: 1, Severity: ReviewSeverityBlocking, Message: "stored"},
+		{File: "b.go", Line: 2, Severity: ReviewSeverityNit, Message: "parsed"},
+	}, feedback.Findings)
+	assert.Nil(t, NewReviewFeedback(nil))
+}
+
+func TestReviewFeedback_Template(t *testing.T) {
+	render := func(text string, data CommandData) string {
+		var b strings.Builder
+		require.NoError(t, template.Must(template.New("t").Parse(text)).Execute(&b, data))
+		return b.String()
+	}
+	feedback := &ReviewFeedback{
+		Text:     "❌ Needs changes",
+		Findings: []ReviewFinding{{File: "a.go", Line: 3, EndLine: 4, Severity: ReviewSeverityBlocking, Message: "Fix"}},
+	}
+
+	assert.Equal(t, "[]", render("[{{.PreviousReview}}]", CommandData{}))
+	assert.Equal(t, "[❌ Needs changes]", render("[{{.PreviousReview}}]", CommandData{PreviousReview: feedback}))
+
+	worker := render(DefaultSystemPrompt, CommandData{TaskID: 1, PreviousReview: feedback})
+	assert.Contains(t, worker, "## Review Feedback")
+	assert.Contains(t, worker, "- [blocking] a.go:3-4 Fix")
+	assert.NotContains(t, render(DefaultSystemPrompt, CommandData{TaskID: 1}), "Review Feedback")
+}
+
+func TestParseReviewText_UnknownVerdict(t *testing.T) {
+	review := ParseReviewText("Looks fine to me")
+
+	assert.Empty(t, review.Verdict)
+	assert.Empty(t, review.Findings)
+}
+
+func TestReviewPolicy_Satisfied(t *testing.T) {
+	tests := []struct {
+		policy ReviewPolicy
+		passed int
+		total  int
+		want   bool
+	}{
+		{"", 2, 2, true},
+		{"", 1, 2, false},
+		{ReviewPolicyAll, 3, 3, true},
+		{ReviewPolicyAll, 2, 3, false},
+		{ReviewPolicyMajority, 2, 3, true},
+		{ReviewPolicyMajority, 1, 2, false},
+		{ReviewPolicyAny, 1, 3, true},
+		{ReviewPolicyAny, 0, 3, false},
+		{ReviewPolicyAny, 0, 0, false},
+	}
+	for _, tt := range tests {
+		got := tt.policy.Satisfied(tt.passed, tt.total)
+		assert.Equal(t, tt.want, got, "%q %d/%d", tt.policy, tt.passed, tt.total)
+	}
+}
@@ -0,0 +1,193 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// TaskSize is a coarse estimate of the amount of work in a task.
+type TaskSize string
+
+const (
+	TaskSizeSmall  TaskSize = "small"  // Typo fixes, one-line changes
+	TaskSizeMedium TaskSize = "medium" // Ordinary features and fixes
+	TaskSizeLarge  TaskSize = "large"  // Design work, migrations, wide refactors
+)
+
+// IsValid returns true if the size is a known value.
+func (s TaskSize) IsValid() bool {
+	switch s {
+	case TaskSizeSmall, TaskSizeMedium, TaskSizeLarge:
+		return true
+	}
+	return false
+}
+
+// TaskSizeLabelPrefix marks a label that sets a task's size explicitly (e.g., "size:small").
+const TaskSizeLabelPrefix = "size:"
+
+// Word counts of the title and description up to which a task without a
+// size label is estimated to be small or medium.
+const (
+	smallTaskMaxWords  = 60
+	mediumTaskMaxWords = 300
+)
+
+// EstimateTaskSize returns the estimated size of a task and how it was estimated.
+// A "size:<small|medium|large>" label wins; otherwise the size is estimated
+// from the number of words in the title and description.
+func EstimateTaskSize(task *Task) (TaskSize, string) {
+	for _, label := range task.Labels {
+		if value, ok := strings.CutPrefix(label, TaskSizeLabelPrefix); ok && TaskSize(value).IsValid() {
+			return TaskSize(value), "label " + label
+		}
+	}
+	words := len(strings.Fields(task.Title)) + len(strings.Fields(task.Description))
+	size := TaskSizeLarge
+	switch {
+	case words <= smallTaskMaxWords:
+		size = TaskSizeSmall
+	case words <= mediumTaskMaxWords:
+		size = TaskSizeMedium
+	}
+	return size, fmt.Sprintf("%d words in title and description", words)
+}
+
+// RoutingRule picks the worker agent for tasks it matches, from an [[agents.routing]] entry.
+// All conditions that are set must match; a rule without conditions matches every task.
+// Fields are ordered to minimize memory padding.
+type RoutingRule struct {
+	Name        string     `toml:"name,omitempty"`        // Name shown by crew start --explain (default: "rule N")
+	Agent       string     `toml:"agent"`                 // Worker agent to start
+	Title       string     `toml:"title,omitempty"`       // Regular expression matched against the title
+	Description string     `toml:"description,omitempty"` // Regular expression matched against the description
+	Labels      []string   `toml:"labels,omitempty"`      // The task has at least one of these labels
+	Sizes       []TaskSize `toml:"size,omitempty"`        // The estimated size is one of these
+	Parent      int        `toml:"parent,omitempty"`      // The task is a sub-task of this task
+}
+
+// DisplayName returns the rule name, or "rule N" for the rule at index i if it has none.
+func (r RoutingRule) DisplayName(i int) string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return "rule " + strconv.Itoa(i+1)
+}
+
+// Validate checks that the rule names an agent and that its patterns compile.
+func (r RoutingRule) Validate() error {
+	if r.Agent == "" {
+		return errors.New("missing agent")
+	}
+	for _, cond := range []struct{ key, pattern string }{{"title", r.Title}, {"description", r.Description}} {
+		if _, err := regexp.Compile(cond.pattern); err != nil {
+			return fmt.Errorf("invalid %s pattern %q: %w", cond.key, cond.pattern, err)
+		}
+	}
+	for _, size := range r.Sizes {
+		if !size.IsValid() {
+			return fmt.Errorf("invalid size %q (expected small, medium, or large)", size)
+		}
+	}
+	return nil
+}
+
+// Match reports whether the rule matches the task.
+// It returns the matched conditions, or the first condition that failed.
+func (r RoutingRule) Match(task *Task) (bool, []string) {
+	var matched []string
+	if len(r.Labels) > 0 {
+		i := slices.IndexFunc(r.Labels, func(label string) bool { return slices.Contains(task.Labels, label) })
+		if i < 0 {
+			return false, []string{"no label in [" + strings.Join(r.Labels, ", ") + "]"}
+		}
+		matched = append(matched, "label "+r.Labels[i])
+	}
+	for _, cond := range []struct{ key, pattern, value string }{
+		{"title", r.Title, task.Title},
+		{"description", r.Description, task.Description},
+	} {
+		if cond.pattern == "" {
+			continue
+		}
+		re, err := regexp.Compile(cond.pattern)
+		if err != nil || !re.MatchString(cond.value) {
+			return false, []string{cond.key + " does not match /" + cond.pattern + "/"}
+		}
+		matched = append(matched, cond.key+" matches /"+cond.pattern+"/")
+	}
+	if r.Parent > 0 {
+		if task.ParentID == nil || *task.ParentID != r.Parent {
+			return false, []string{"not a sub-task of #" + strconv.Itoa(r.Parent)}
+		}
+		matched = append(matched, "sub-task of #"+strconv.Itoa(r.Parent))
+	}
+	if len(r.Sizes) > 0 {
+		size, how := EstimateTaskSize(task)
+		if !slices.Contains(r.Sizes, size) {
+			return false, []string{"estimated size " + string(size) + " (" + how + ") not in [" + joinTaskSizes(r.Sizes) + "]"}
+		}
+		matched = append(matched, "estimated size "+string(size)+" ("+how+")")
+	}
+	if len(matched) == 0 {
+		matched = append(matched, "no conditions")
+	}
+	return true, matched
+}
+
+func joinTaskSizes(sizes []TaskSize) string {
+	parts := make([]string, len(sizes))
+	for i, size := range sizes {
+		parts[i] = string(size)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// RuleEvaluation is the outcome of evaluating one routing rule.
+// Fields are ordered to minimize memory padding.
+type RuleEvaluation struct {
+	Name    string   // Rule display name
+	Agent   string   // Agent the rule selects
+	Details []string // Matched conditions, or the condition that failed
+	Matched bool
+}
+
+// RoutingDecision is the worker agent chosen for a task and why.
+// Fields are ordered to minimize memory padding.
+type RoutingDecision struct {
+	Agent       string           // Chosen agent (empty if no rule matched and there is no default worker)
+	Rule        string           // Name of the rule that fired (empty when falling back to the default worker)
+	Evaluations []RuleEvaluation // Rules evaluated in order, up to and including the one that fired
+}
+
+// RouteTask picks the worker agent for a task with first-match semantics.
+// If no rule matches, the default worker is chosen.
+func RouteTask(rules []RoutingRule, defaultWorker string, task *Task) RoutingDecision {
+	var decision RoutingDecision
+	for i, rule := range rules {
+		matched, details := rule.Match(task)
+		decision.Evaluations = append(decision.Evaluations, RuleEvaluation{
+			Name:    rule.DisplayName(i),
+			Agent:   rule.Agent,
+			Details: details,
+			Matched: matched,
+		})
+		if matched {
+			decision.Agent = rule.Agent
+			decision.Rule = rule.DisplayName(i)
+			return decision
+		}
+	}
+	decision.Agent = defaultWorker
+	return decision
+}
+
+// RouteWorker picks the worker agent for a task from the [[agents.routing]] rules,
+// falling back to the default worker.
+func (c *Config) RouteWorker(task *Task) RoutingDecision {
+	return RouteTask(c.AgentsConfig.Routing, c.AgentsConfig.DefaultWorker, task)
+}
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateTaskSize(t *testing.T) {
+	tests := []struct {
+		name       string
+		task       *Task
+		wantSize   TaskSize
+		wantReason string
+	}{
+		{
+			name:       "short task is small",
+			task:       &Task{Title: "Fix typo in README"},
+			wantSize:   TaskSizeSmall,
+			wantReason: "4 words in title and description",
+		},
+		{
+			name:       "longer description is medium",
+			task:       &Task{Title: "Add export", Description: strings.Repeat("word ", 100)},
+			wantSize:   TaskSizeMedium,
+			wantReason: "102 words in title and description",
+		},
+		{
+			name:       "long description is large",
+			task:       &Task{Title: "Redesign storage", Description: strings.Repeat("word ", 400)},
+			wantSize:   TaskSizeLarge,
+			wantReason: "402 words in title and description",
+		},
+		{
+			name:       "size label wins",
+			task:       &Task{Title: "Fix typo", Labels: []string{"docs", "size:large"}},
+			wantSize:   TaskSizeLarge,
+			wantReason: "label size:large",
+		},
+		{
+			name:       "unknown size label is ignored",
+			task:       &Task{Title: "Fix typo", Labels: []string{"size:huge"}},
+			wantSize:   TaskSizeSmall,
+			wantReason: "2 words in title and description",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, reason := EstimateTaskSize(tt.task)
+			assert.Equal(t, tt.wantSize, size)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
+
+func TestRoutingRule_Match(t *testing.T) {
+	parent := 7
+	task := &Task{
+		Title:       "Fix flaky login test",
+		Description: "The OAuth callback test times out on CI.",
+		Labels:      []string{"bug", "ci"},
+		ParentID:    &parent,
+	}
+
+	tests := []struct {
+		name        string
+		rule        RoutingRule
+		wantDetails []string
+		wantMatch   bool
+	}{
+		{
+			name:        "no conditions",
+			rule:        RoutingRule{Agent: "a"},
+			wantMatch:   true,
+			wantDetails: []string{"no conditions"},
+		},
+		{
+			name:        "all conditions match",
+			rule:        RoutingRule{Agent: "a", Labels: []string{"docs", "ci"}, Title: "(?i)flaky", Description: "OAuth", Parent: 7, Sizes: []TaskSize{TaskSizeSmall}},
+			wantMatch:   true,
+			wantDetails: []string{"label ci", "title matches /(?i)flaky/", "description matches /OAuth/", "sub-task of #7", "estimated size small (12 words in title and description)"},
+		},
+		{
+			name:        "label does not match",
+			rule:        RoutingRule{Agent: "a", Labels: []string{"docs"}},
+			wantDetails: []string{"no label in [docs]"},
+		},
+		{
+			name:        "title does not match",
+			rule:        RoutingRule{Agent: "a", Labels: []string{"bug"}, Title: "^Add"},
+			wantDetails: []string{"title does not match /^Add/"},
+		},
+		{
+			name:        "parent does not match",
+			rule:        RoutingRule{Agent: "a", Parent: 3},
+			wantDetails: []string{"not a sub-task of #3"},
+		},
+		{
+			name:        "size does not match",
+			rule:        RoutingRule{Agent: "a", Sizes: []TaskSize{TaskSizeMedium, TaskSizeLarge}},
+			wantDetails: []string{"estimated size small (12 words in title and description) not in [medium, large]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, details := tt.rule.Match(task)
+			assert.Equal(t, tt.wantMatch, matched)
+			assert.Equal(t, tt.wantDetails, details)
+		})
+	}
+}
+
+func TestRoutingRule_Validate(t *testing.T) {
+	assert.NoError(t, RoutingRule{Agent: "a", Title: "^fix", Sizes: []TaskSize{TaskSizeSmall}}.Validate())
+	assert.ErrorContains(t, RoutingRule{Title: "^fix"}.Validate(), "missing agent")
+	assert.ErrorContains(t, RoutingRule{Agent: "a", Description: "("}.Validate(), `invalid description pattern "("`)
+	assert.ErrorContains(t, RoutingRule{Agent: "a", Sizes: []TaskSize{"huge"}}.Validate(), `invalid size "huge"`)
+}
+
+func TestRouteTask(t *testing.T) {
+	rules := []RoutingRule{
+		{Name: "docs", Agent: "claude-fast", Labels: []string{"docs"}},
+		{Agent: "codex", Title: "(?i)refactor"},
+		{Name: "catch-all-refactor", Agent: "claude", Title: "(?i)refactor"},
+	}
+
+	t.Run("first matching rule wins", func(t *testing.T) {
+		decision := RouteTask(rules, "default", &Task{Title: "Refactor parser"})
+
+		assert.Equal(t, "codex", decision.Agent)
+		assert.Equal(t, "rule 2", decision.Rule)
+		assert.Len(t, decision.Evaluations, 2)
+		assert.False(t, decision.Evaluations[0].Matched)
+		assert.True(t, decision.Evaluations[1].Matched)
+	})
+
+	t.Run("falls back to default worker", func(t *testing.T) {
+		decision := RouteTask(rules, "default", &Task{Title: "Add export"})
+
+		assert.Equal(t, "default", decision.Agent)
+		assert.Empty(t, decision.Rule)
+		assert.Len(t, decision.Evaluations, 3)
+	})
+
+	t.Run("no rules", func(t *testing.T) {
+		decision := RouteTask(nil, "default", &Task{Title: "Add export"})
+
+		assert.Equal(t, RoutingDecision{Agent: "default"}, decision)
+	})
+}
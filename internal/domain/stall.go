@@ -0,0 +1,80 @@
+package domain
+
+import "time"
+
+// StallReason tells why a running session counts as stalled.
+// The empty reason means the session is not stalled.
+type StallReason string
+
+const (
+	StallIdle       StallReason = "idle"       // Screen unchanged for watch.idle_timeout
+	StallPermission StallReason = "permission" // awaiting_permission for watch.permission_timeout
+)
+
+// IsValid returns true if the reason is recognized.
+func (r StallReason) IsValid() bool {
+	switch r {
+	case StallIdle, StallPermission:
+		return true
+	default:
+		return false
+	}
+}
+
+// Display returns a short label for lists (e.g., "stalled: permission").
+func (r StallReason) Display() string {
+	return "stalled: " + string(r)
+}
+
+// SessionActivity is what a watcher observed of a running session across polls.
+// Fields are ordered to minimize memory padding.
+type SessionActivity struct {
+	Started         time.Time         // Task start time the activity belongs to
+	ScreenChanged   time.Time         // When the screen last changed
+	SubstateChanged time.Time         // When the substate last changed
+	Screen          string            // Last screen snapshot
+	Substate        ExecutionSubstate // Last substate
+	Marked          StallReason       // Stall mark the task had when first observed, kept until the session moves
+}
+
+// Observe records a poll of task's session showing screen at now.
+// Activity of a previous run of the task (a different start time) is discarded.
+// A stall mark found on the first poll (e.g., left by a previous watcher) is
+// kept until the screen or substate changes.
+func (a *SessionActivity) Observe(task *Task, screen string, now time.Time) {
+	if a.ScreenChanged.IsZero() || !a.Started.Equal(task.Started) {
+		*a = SessionActivity{
+			Started:         task.Started,
+			ScreenChanged:   now,
+			SubstateChanged: now,
+			Screen:          screen,
+			Substate:        task.ExecutionSubstate,
+			Marked:          task.Stalled,
+		}
+		return
+	}
+	if screen != a.Screen {
+		a.Screen = screen
+		a.ScreenChanged = now
+		a.Marked = ""
+	}
+	if task.ExecutionSubstate != a.Substate {
+		a.Substate = task.ExecutionSubstate
+		a.SubstateChanged = now
+		a.Marked = ""
+	}
+}
+
+// Stalled returns why the session counts as stalled at now, or "" if it does not.
+// A zero timeout disables the corresponding check. Waiting on a permission
+// prompt takes precedence over an unchanged screen, which takes precedence
+// over a kept stall mark.
+func (a *SessionActivity) Stalled(now time.Time, idleTimeout, permissionTimeout time.Duration) StallReason {
+	if permissionTimeout > 0 && a.Substate == SubstateAwaitingPermission && now.Sub(a.SubstateChanged) >= permissionTimeout {
+		return StallPermission
+	}
+	if idleTimeout > 0 && now.Sub(a.ScreenChanged) >= idleTimeout {
+		return StallIdle
+	}
+	return a.Marked
+}
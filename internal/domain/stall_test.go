@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStallReason_IsValid(t *testing.T) {
+	assert.True(t, StallIdle.IsValid())
+	assert.True(t, StallPermission.IsValid())
+	assert.False(t, StallReason("").IsValid())
+	assert.False(t, StallReason("unknown").IsValid())
+}
+
+func TestSessionActivity_Stalled(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	idle := 10 * time.Minute
+	permission := 5 * time.Minute
+	task := &Task{ID: 1, Started: start, ExecutionSubstate: SubstateRunning}
+
+	var activity SessionActivity
+	activity.Observe(task, "working", start)
+	assert.Empty(t, activity.Stalled(start, idle, permission))
+
+	// The screen keeps changing
+	activity.Observe(task, "still working", start.Add(9*time.Minute))
+	assert.Empty(t, activity.Stalled(start.Add(15*time.Minute), idle, permission))
+
+	// Unchanged screen
+	activity.Observe(task, "still working", start.Add(19*time.Minute))
+	assert.Equal(t, StallIdle, activity.Stalled(start.Add(19*time.Minute), idle, permission))
+	assert.Empty(t, activity.Stalled(start.Add(19*time.Minute), 0, permission), "zero timeout disables the check")
+
+	// Waiting on a permission prompt takes precedence once it lasts long enough
+	task.ExecutionSubstate = SubstateAwaitingPermission
+	activity.Observe(task, "Allow?", start.Add(20*time.Minute))
+	assert.Empty(t, activity.Stalled(start.Add(24*time.Minute), idle, permission))
+	assert.Equal(t, StallPermission, activity.Stalled(start.Add(25*time.Minute), idle, permission))
+	assert.Equal(t, StallIdle, activity.Stalled(start.Add(31*time.Minute), idle, 0))
+}
+
+func TestSessionActivity_ObserveNewRun(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	task := &Task{ID: 1, Started: start}
+
+	var activity SessionActivity
+	activity.Observe(task, "screen", start)
+
+	// A task started again starts over even if the screen looks the same
+	task.Started = start.Add(time.Hour)
+	activity.Observe(task, "screen", start.Add(time.Hour))
+
+	assert.Equal(t, start.Add(time.Hour), activity.ScreenChanged)
+	assert.Empty(t, activity.Stalled(start.Add(time.Hour), time.Minute, 0))
+}
+
+func TestSessionActivity_KeepsMarkUntilChange(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	task := &Task{ID: 1, Started: start, Stalled: StallPermission, ExecutionSubstate: SubstateAwaitingPermission}
+
+	var activity SessionActivity
+	activity.Observe(task, "screen", start.Add(time.Hour))
+	assert.Equal(t, StallPermission, activity.Stalled(start.Add(time.Hour), time.Hour, time.Hour))
+
+	// The permission prompt is answered
+	task.ExecutionSubstate = SubstateRunning
+	activity.Observe(task, "screen", start.Add(2*time.Hour))
+	assert.Empty(t, activity.Stalled(start.Add(2*time.Hour), 0, time.Hour))
+}
@@ -2,6 +2,7 @@
 package domain
 
 import (
+	"encoding/json"
 	"errors"
 	"slices"
 	"strings"
@@ -38,15 +39,18 @@ type Task struct {
 	Namespace         string            `json:"-" yaml:"-"`                 // Task namespace (derived from storage path)
 	Status            Status            `json:"status"`                     // Current status
 	ExecutionSubstate ExecutionSubstate `json:"execution_substate,omitempty"`
+	Stalled           StallReason       `json:"stalled,omitempty"`           // Why the running session counts as stalled (empty if not stalled)
 	CloseReason       CloseReason       `json:"closeReason,omitempty"`       // Why the task was closed
 	Title             string            `json:"title"`                       // Title (required)
 	BlockReason       string            `json:"blockReason,omitempty"`       // Non-empty if task cannot be started (e.g., "Parent task", "Depends on #42")
 	Labels            []string          `json:"labels,omitempty"`            // Labels
+	DependsOn         []int             `json:"dependsOn,omitempty"`         // IDs of tasks that must be merged before this task can start
 	ID                int               `json:"-"`                           // Task ID (stored as map key, not in value)
 	Issue             int               `json:"issue,omitempty"`             // GitHub issue number (0 = not linked)
 	PR                int               `json:"pr,omitempty"`                // GitHub PR number (0 = not created)
 	ReviewCount       int               `json:"reviewCount,omitempty"`       // Number of recorded reviews
 	AutoFixRetryCount int               `json:"autoFixRetryCount,omitempty"` // Current retry count for auto_fix mode
+	RestartCount      int               `json:"restartCount,omitempty"`      // Automatic restarts after errors since the task was last started
 	StatusVersion     int               `json:"statusVersion,omitempty"`     // Status model version (0=legacy, 2=current)
 }
 
@@ -55,6 +59,12 @@ func (t *Task) IsRoot() bool {
 	return t.ParentID == nil
 }
 
+// StackedOn returns the ID of the task whose branch this task is based on.
+// A task is stacked when its base branch is another task's crew branch.
+func (t *Task) StackedOn() (int, bool) {
+	return ParseBranchTaskID(t.BaseBranch)
+}
+
 // IsRunning returns true if the task has an active session.
 func (t *Task) IsRunning() bool {
 	return t.Session != ""
@@ -130,6 +140,9 @@ func (t *Task) ToMarkdownWithComments(comments []Comment) string {
 				result += "# Metadata: " + metadata + "\n"
 			}
 		}
+		if len(comment.Findings) > 0 {
+			result += "# Findings: " + formatCommentFindings(comment.Findings) + "\n"
+		}
 		result += "# Time: " + comment.Time.Format(time.RFC3339) + "\n"
 		result += "\n" + comment.Text
 	}
@@ -385,12 +398,15 @@ const (
 	CommentTypeMessage    CommentType = "message"    // Message/notification
 	CommentTypeSuggestion CommentType = "suggestion" // Improvement suggestion
 	CommentTypeFriction   CommentType = "friction"   // Friction/blocker
+	CommentTypeGate       CommentType = "gate"       // Completion gate result
+	CommentTypeUsage      CommentType = "usage"      // Agent token and cost usage
+	CommentTypeFallback   CommentType = "fallback"   // Restart with a fallback agent
 )
 
 // IsValid returns true if the CommentType is recognized.
 func (t CommentType) IsValid() bool {
 	switch t {
-	case CommentTypeGeneral, CommentTypeReport, CommentTypeMessage, CommentTypeSuggestion, CommentTypeFriction:
+	case CommentTypeGeneral, CommentTypeReport, CommentTypeMessage, CommentTypeSuggestion, CommentTypeFriction, CommentTypeGate, CommentTypeUsage, CommentTypeFallback:
 		return true
 	default:
 		return false
@@ -406,6 +422,7 @@ type Comment struct {
 	Metadata map[string]string `json:"metadata,omitempty"` // Additional metadata
 	Time     time.Time         `json:"time"`               // Creation time
 	Tags     []string          `json:"tags,omitempty"`     // Tags for filtering
+	Findings []ReviewFinding   `json:"findings,omitempty"` // Review findings (file, line range, severity)
 }
 
 func normalizeCommentTags(tags []string) []string {
@@ -431,6 +448,30 @@ func normalizeCommentTags(tags []string) []string {
 	return normalized
 }
 
+// formatCommentFindings encodes findings as single-line JSON for the "# Findings:" header.
+func formatCommentFindings(findings []ReviewFinding) string {
+	data, err := json.Marshal(findings)
+	if err != nil {
+		return "[]"
+	}
+	return string(data)
+}
+
+func parseCommentFindingsValue(value string) ([]ReviewFinding, error) {
+	trimmed := trimSpace(value)
+	if trimmed == "" {
+		return nil, nil
+	}
+	var findings []ReviewFinding
+	if err := json.Unmarshal([]byte(trimmed), &findings); err != nil {
+		return nil, ErrInvalidCommentMeta
+	}
+	if len(findings) == 0 {
+		return nil, nil
+	}
+	return findings, nil
+}
+
 func formatCommentMetadata(metadata map[string]string) string {
 	normalized := normalizeCommentMetadata(metadata)
 	if len(normalized) == 0 {
@@ -768,6 +809,10 @@ func parseCommentBlock(block string) (ParsedComment, error) {
 			if _, err := parseCommentMetadataValue(line[11:]); err != nil {
 				return ParsedComment{}, ErrInvalidCommentMeta
 			}
+		case strings.HasPrefix(line, "# Findings:"):
+			if _, err := parseCommentFindingsValue(line[11:]); err != nil {
+				return ParsedComment{}, ErrInvalidCommentMeta
+			}
 		case line == "":
 			// Empty line before time is invalid
 			return ParsedComment{}, ErrInvalidCommentMeta
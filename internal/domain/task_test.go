@@ -398,6 +398,22 @@ func TestTask_ToMarkdownWithComments(t *testing.T) {
 			},
 			want: "---\ntitle: Test Task\nparent:\nlabels:\nskip_review:\n---\n\nDescription\n\n---\n# Comment: 0\n# Author: worker\n# Type: friction\n# Tags: docs, testing\n# Metadata: priority=high, source=cli\n# Time: 2026-01-18T10:00:00Z\n\nComment text",
 		},
+		{
+			name: "review comment with findings",
+			task: &Task{
+				Title:       "Test Task",
+				Description: "Description",
+			},
+			comments: []Comment{
+				{
+					Text:     "❌ Needs changes",
+					Author:   ReviewCommentAuthor,
+					Time:     now,
+					Findings: []ReviewFinding{{File: "a.go", Line: 3, EndLine: 5, Severity: ReviewSeverityBlocking, Message: "Fix, then test"}},
+				},
+			},
+			want: "---\ntitle: Test Task\nparent:\nlabels:\nskip_review:\n---\n\nDescription\n\n---\n# Comment: 0\n# Author: reviewer\n# Findings: [{\"file\":\"a.go\",\"severity\":\"blocking\",\"message\":\"Fix, then test\",\"line\":3,\"end_line\":5}]\n# Time: 2026-01-18T10:00:00Z\n\n❌ Needs changes",
+		},
 		{
 			name: "multiple comments",
 			task: &Task{
@@ -0,0 +1,331 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UsageCommentAuthor is the author of the comments recording agent usage.
+const UsageCommentAuthor = "usage"
+
+// Usage is the token and cost usage an agent reported for a session.
+// Fields are ordered to minimize memory padding.
+type Usage struct {
+	Model            string  // Model name, if reported
+	CostUSD          float64 // Cost in US dollars (only meaningful if HasCost)
+	InputTokens      int64
+	OutputTokens     int64
+	CacheReadTokens  int64
+	CacheWriteTokens int64
+	HasCost          bool // Whether the agent reported a cost
+}
+
+// TotalTokens returns the number of input, output and cache tokens.
+func (u Usage) TotalTokens() int64 {
+	return u.InputTokens + u.OutputTokens + u.CacheReadTokens + u.CacheWriteTokens
+}
+
+// IsZero returns true if no tokens or cost were reported.
+func (u Usage) IsZero() bool {
+	return u.TotalTokens() == 0 && !u.HasCost
+}
+
+// Add adds other to u. The model is kept only if both agree.
+func (u *Usage) Add(other Usage) {
+	if u.IsZero() {
+		u.Model = other.Model
+	} else if u.Model != other.Model {
+		u.Model = ""
+	}
+	u.InputTokens += other.InputTokens
+	u.OutputTokens += other.OutputTokens
+	u.CacheReadTokens += other.CacheReadTokens
+	u.CacheWriteTokens += other.CacheWriteTokens
+	u.CostUSD += other.CostUSD
+	u.HasCost = u.HasCost || other.HasCost
+}
+
+// Summary returns a one-line description such as "1,200 in / 300 out tokens, $0.0123".
+func (u Usage) Summary() string {
+	summary := FormatTokens(u.InputTokens) + " in / " + FormatTokens(u.OutputTokens) + " out tokens"
+	if cached := u.CacheReadTokens + u.CacheWriteTokens; cached > 0 {
+		summary += " (+ " + FormatTokens(cached) + " cache)"
+	}
+	if u.HasCost {
+		summary += ", " + FormatCost(u.CostUSD)
+	}
+	return summary
+}
+
+// FormatTokens formats a token count with thousands separators.
+func FormatTokens(n int64) string {
+	s := strconv.FormatInt(n, 10)
+	if n < 0 {
+		return s
+	}
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
+
+// FormatCost formats a cost in US dollars.
+func FormatCost(usd float64) string {
+	if usd != 0 && usd < 0.01 {
+		return fmt.Sprintf("$%.4f", usd)
+	}
+	return fmt.Sprintf("$%.2f", usd)
+}
+
+// UsageRecord is the usage of one agent session, attached to a task as a comment.
+// Fields are ordered to minimize memory padding.
+type UsageRecord struct {
+	Time    time.Time
+	Agent   string // Agent name
+	Session string // Session name
+	Usage   Usage
+}
+
+// Comment returns the structured task comment recording the usage.
+func (r UsageRecord) Comment() Comment {
+	text := "Usage"
+	if r.Agent != "" {
+		text += " of " + r.Agent
+	}
+	text += ": " + r.Usage.Summary()
+
+	metadata := make(map[string]string)
+	for key, value := range map[string]string{"agent": r.Agent, "session": r.Session, "model": r.Usage.Model} {
+		if value != "" {
+			metadata[key] = value
+		}
+	}
+	setTokens := func(key string, n int64) {
+		if n != 0 {
+			metadata[key] = strconv.FormatInt(n, 10)
+		}
+	}
+	setTokens("input_tokens", r.Usage.InputTokens)
+	setTokens("output_tokens", r.Usage.OutputTokens)
+	setTokens("cache_read_tokens", r.Usage.CacheReadTokens)
+	setTokens("cache_write_tokens", r.Usage.CacheWriteTokens)
+	if r.Usage.HasCost {
+		metadata["cost_usd"] = strconv.FormatFloat(r.Usage.CostUSD, 'f', -1, 64)
+	}
+	return Comment{
+		Text:     text,
+		Author:   UsageCommentAuthor,
+		Type:     CommentTypeUsage,
+		Metadata: metadata,
+		Time:     r.Time,
+	}
+}
+
+// UsageRecordFromComment parses a comment written by UsageRecord.Comment.
+// Returns false if the comment is not a usage record.
+func UsageRecordFromComment(comment Comment) (UsageRecord, bool) {
+	if comment.Type != CommentTypeUsage {
+		return UsageRecord{}, false
+	}
+	tokens := func(key string) int64 {
+		n, _ := strconv.ParseInt(comment.Metadata[key], 10, 64)
+		return n
+	}
+	usage := Usage{
+		Model:            comment.Metadata["model"],
+		InputTokens:      tokens("input_tokens"),
+		OutputTokens:     tokens("output_tokens"),
+		CacheReadTokens:  tokens("cache_read_tokens"),
+		CacheWriteTokens: tokens("cache_write_tokens"),
+	}
+	if cost, err := strconv.ParseFloat(comment.Metadata["cost_usd"], 64); err == nil {
+		usage.CostUSD = cost
+		usage.HasCost = true
+	}
+	return UsageRecord{
+		Time:    comment.Time,
+		Agent:   comment.Metadata["agent"],
+		Session: comment.Metadata["session"],
+		Usage:   usage,
+	}, true
+}
+
+// UsageRecords returns the usage records found in comments.
+func UsageRecords(comments []Comment) []UsageRecord {
+	var records []UsageRecord
+	for _, comment := range comments {
+		if record, ok := UsageRecordFromComment(comment); ok {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// UsageGroupBy selects how usage records are aggregated.
+type UsageGroupBy string
+
+const (
+	UsageByAgent UsageGroupBy = "agent" // Group by the agent that ran the session
+	UsageByLabel UsageGroupBy = "label" // Group by task label
+	UsageByTask  UsageGroupBy = "task"  // Group by task
+)
+
+// IsValid returns true if the grouping is a known value.
+func (g UsageGroupBy) IsValid() bool {
+	switch g {
+	case UsageByAgent, UsageByLabel, UsageByTask:
+		return true
+	}
+	return false
+}
+
+var (
+	// Codex interactive sessions print "Token usage: total=1,234 input=1,000 (+ 200 cached) output=234" on exit.
+	codexTokenUsagePattern = regexp.MustCompile(`Token usage: total=([\d,]+) input=([\d,]+)(?: \(\+ ([\d,]+) cached\))? output=([\d,]+)`)
+	// codex exec prints "tokens used: 1234" (older) or "tokens used\n1,234" at the end of a run.
+	codexTokensUsedPattern = regexp.MustCompile(`(?i)tokens used:?\s*([\d,]+)`)
+	codexModelPattern      = regexp.MustCompile(`(?m)^model:\s*(\S+)`)
+	// opencode stats prints a table with rows such as "│Total Cost    $1.23 │".
+	opencodeStatPattern = regexp.MustCompile(`^(Total Cost|Input|Output|Cache Read|Cache Write)\s{2,}(\$?[\d.,]+[KMB]?)$`)
+)
+
+// ParseUsage extracts the usage summary an agent CLI printed in its output.
+// It understands the result object of Claude Code (-p --output-format json
+// or stream-json), the token summary of Codex and the table printed by
+// opencode stats. When the output contains several summaries, the last one
+// wins. Returns false if no summary was found.
+func ParseUsage(output string) (Usage, bool) {
+	output = strings.ReplaceAll(ansiEscapePattern.ReplaceAllString(output, ""), "\r", "")
+	if usage, ok := parseClaudeUsage(output); ok {
+		return usage, true
+	}
+	if usage, ok := parseCodexUsage(output); ok {
+		return usage, true
+	}
+	return parseOpenCodeUsage(output)
+}
+
+// claudeResult is the final result object printed by Claude Code.
+type claudeResult struct {
+	TotalCostUSD *float64 `json:"total_cost_usd"`
+	CostUSD      *float64 `json:"cost_usd"` // Older CLI versions
+	Usage        *struct {
+		InputTokens              int64 `json:"input_tokens"`
+		OutputTokens             int64 `json:"output_tokens"`
+		CacheReadInputTokens     int64 `json:"cache_read_input_tokens"`
+		CacheCreationInputTokens int64 `json:"cache_creation_input_tokens"`
+	} `json:"usage"`
+	ModelUsage map[string]json.RawMessage `json:"modelUsage"`
+	Type       string                     `json:"type"`
+}
+
+func parseClaudeUsage(output string) (Usage, bool) {
+	lines := strings.Split(output, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(line, "{") || !strings.Contains(line, `"result"`) {
+			continue
+		}
+		var result claudeResult
+		if err := json.Unmarshal([]byte(line), &result); err != nil || result.Type != "result" || result.Usage == nil {
+			continue
+		}
+		usage := Usage{
+			InputTokens:      result.Usage.InputTokens,
+			OutputTokens:     result.Usage.OutputTokens,
+			CacheReadTokens:  result.Usage.CacheReadInputTokens,
+			CacheWriteTokens: result.Usage.CacheCreationInputTokens,
+		}
+		switch {
+		case result.TotalCostUSD != nil:
+			usage.CostUSD, usage.HasCost = *result.TotalCostUSD, true
+		case result.CostUSD != nil:
+			usage.CostUSD, usage.HasCost = *result.CostUSD, true
+		}
+		models := make([]string, 0, len(result.ModelUsage))
+		for model := range result.ModelUsage {
+			models = append(models, model)
+		}
+		slices.Sort(models)
+		usage.Model = strings.Join(models, "+")
+		return usage, true
+	}
+	return Usage{}, false
+}
+
+func parseCodexUsage(output string) (Usage, bool) {
+	var usage Usage
+	if matches := codexTokenUsagePattern.FindAllStringSubmatch(output, -1); len(matches) > 0 {
+		m := matches[len(matches)-1]
+		cached := parseTokenCount(m[3])
+		// Codex counts cached tokens as part of the input
+		usage.InputTokens = parseTokenCount(m[2]) - cached
+		usage.CacheReadTokens = cached
+		usage.OutputTokens = parseTokenCount(m[4])
+	} else if matches := codexTokensUsedPattern.FindAllStringSubmatch(output, -1); len(matches) > 0 {
+		// Only the total is reported; count it as input
+		usage.InputTokens = parseTokenCount(matches[len(matches)-1][1])
+	} else {
+		return Usage{}, false
+	}
+	if matches := codexModelPattern.FindAllStringSubmatch(output, -1); len(matches) > 0 {
+		usage.Model = matches[len(matches)-1][1]
+	}
+	return usage, !usage.IsZero()
+}
+
+func parseOpenCodeUsage(output string) (Usage, bool) {
+	var usage Usage
+	found := false
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(strings.Trim(strings.TrimSpace(line), "│|"))
+		m := opencodeStatPattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		found = true
+		switch m[1] {
+		case "Total Cost":
+			cost, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimPrefix(m[2], "$"), ",", ""), 64)
+			if err == nil {
+				usage.CostUSD, usage.HasCost = cost, true
+			}
+		case "Input":
+			usage.InputTokens = parseTokenCount(m[2])
+		case "Output":
+			usage.OutputTokens = parseTokenCount(m[2])
+		case "Cache Read":
+			usage.CacheReadTokens = parseTokenCount(m[2])
+		case "Cache Write":
+			usage.CacheWriteTokens = parseTokenCount(m[2])
+		}
+	}
+	return usage, found && !usage.IsZero()
+}
+
+// parseTokenCount parses counts such as "1,234", "12.3K" or "1.2M".
+func parseTokenCount(s string) int64 {
+	s = strings.ReplaceAll(strings.TrimSpace(s), ",", "")
+	multiplier := 1.0
+	switch {
+	case strings.HasSuffix(s, "K"):
+		multiplier = 1e3
+	case strings.HasSuffix(s, "M"):
+		multiplier = 1e6
+	case strings.HasSuffix(s, "B"):
+		multiplier = 1e9
+	}
+	if multiplier != 1 {
+		s = s[:len(s)-1]
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return int64(n*multiplier + 0.5)
+}
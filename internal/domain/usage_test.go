@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUsage(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   Usage
+		wantOK bool
+	}{
+		{
+			name:   "claude json result",
+			output: `{"type":"result","subtype":"success","total_cost_usd":0.0421,"usage":{"input_tokens":12,"cache_creation_input_tokens":3000,"cache_read_input_tokens":15000,"output_tokens":450},"modelUsage":{"claude-sonnet-4-5":{"costUSD":0.0421}}}`,
+			want:   Usage{Model: "claude-sonnet-4-5", InputTokens: 12, OutputTokens: 450, CacheReadTokens: 15000, CacheWriteTokens: 3000, CostUSD: 0.0421, HasCost: true},
+			wantOK: true,
+		},
+		{
+			name: "claude stream json keeps the last result",
+			output: "{\"type\":\"assistant\",\"message\":{}}\r\n" +
+				"{\"type\":\"result\",\"cost_usd\":0.5,\"usage\":{\"input_tokens\":1,\"output_tokens\":2}}\r\n" +
+				"\x1b[0m{\"type\":\"result\",\"cost_usd\":0.75,\"usage\":{\"input_tokens\":3,\"output_tokens\":4}}\r\n",
+			want:   Usage{InputTokens: 3, OutputTokens: 4, CostUSD: 0.75, HasCost: true},
+			wantOK: true,
+		},
+		{
+			name:   "codex token usage",
+			output: "model: gpt-5-codex\nToken usage: total=1,500 input=1,200 (+ 800 cached) output=300 (reasoning 100)\n",
+			want:   Usage{Model: "gpt-5-codex", InputTokens: 400, CacheReadTokens: 800, OutputTokens: 300},
+			wantOK: true,
+		},
+		{
+			name:   "codex exec tokens used",
+			output: "[2026-01-01T00:00:00] tokens used: 1234\n...\ntokens used\n5,678\n",
+			want:   Usage{InputTokens: 5678},
+			wantOK: true,
+		},
+		{
+			name: "opencode stats",
+			output: "│Total Cost                                        $1.23 │\n" +
+				"│Avg Cost/Day                                      $0.61 │\n" +
+				"│Input                                             12.3K │\n" +
+				"│Output                                             4.5K │\n" +
+				"│Cache Read                                         1.2M │\n" +
+				"│Cache Write                                           0 │\n",
+			want:   Usage{InputTokens: 12300, OutputTokens: 4500, CacheReadTokens: 1200000, CostUSD: 1.23, HasCost: true},
+			wantOK: true,
+		},
+		{
+			name:   "no summary",
+			output: "Error: rate limited\n{\"type\":\"result\"}\n",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseUsage(tt.output)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUsageRecord_CommentRoundTrip(t *testing.T) {
+	record := UsageRecord{
+		Time:    time.Date(2026, 2, 3, 4, 5, 6, 0, time.UTC),
+		Agent:   "claude-fast",
+		Session: "crew-3",
+		Usage:   Usage{Model: "claude-haiku-4-5", InputTokens: 1200, OutputTokens: 300, CacheReadTokens: 5000, CostUSD: 0.0042, HasCost: true},
+	}
+
+	comment := record.Comment()
+
+	assert.Equal(t, CommentTypeUsage, comment.Type)
+	assert.Equal(t, UsageCommentAuthor, comment.Author)
+	assert.Equal(t, "Usage of claude-fast: 1,200 in / 300 out tokens (+ 5,000 cache), $0.0042", comment.Text)
+	got, ok := UsageRecordFromComment(comment)
+	require.True(t, ok)
+	assert.Equal(t, record, got)
+
+	_, ok = UsageRecordFromComment(Comment{Type: CommentTypeGate})
+	assert.False(t, ok)
+}
+
+func TestUsageRecord_CommentWithoutCost(t *testing.T) {
+	comment := UsageRecord{Usage: Usage{InputTokens: 5}}.Comment()
+
+	assert.Equal(t, "Usage: 5 in / 0 out tokens", comment.Text)
+	assert.Equal(t, map[string]string{"input_tokens": "5"}, comment.Metadata)
+	got, ok := UsageRecordFromComment(comment)
+	require.True(t, ok)
+	assert.False(t, got.Usage.HasCost)
+}
+
+func TestUsage_Add(t *testing.T) {
+	total := Usage{}
+	total.Add(Usage{Model: "a", InputTokens: 1, CostUSD: 0.5, HasCost: true})
+	assert.Equal(t, "a", total.Model)
+	total.Add(Usage{Model: "b", OutputTokens: 2})
+
+	assert.Equal(t, Usage{InputTokens: 1, OutputTokens: 2, CostUSD: 0.5, HasCost: true}, total)
+}
+
+func TestFormatTokens(t *testing.T) {
+	assert.Equal(t, "0", FormatTokens(0))
+	assert.Equal(t, "999", FormatTokens(999))
+	assert.Equal(t, "1,000", FormatTokens(1000))
+	assert.Equal(t, "12,345,678", FormatTokens(12345678))
+}
@@ -234,22 +234,34 @@ func convertRawToDomainConfig(raw map[string]any, sourcePath string) *domain.Con
 				if ac.ReviewerPrompt != "" {
 					res.AgentsConfig.ReviewerPrompt = ac.ReviewerPrompt
 				}
+				if ac.DefaultConflictResolver != "" {
+					res.AgentsConfig.DefaultConflictResolver = ac.DefaultConflictResolver
+				}
+				if ac.ConflictResolverPrompt != "" {
+					res.AgentsConfig.ConflictResolverPrompt = ac.ConflictResolverPrompt
+				}
 				if len(ac.DisabledAgents) > 0 {
 					res.AgentsConfig.DisabledAgents = ac.DisabledAgents
 				}
+				res.AgentsConfig.Routing = ac.Routing
+				warnings = append(warnings, ac.Warnings...)
 				for name, def := range ac.Defs {
 					res.Agents[name] = domain.Agent{
-						Inherit:         def.Inherit,
-						CommandTemplate: def.CommandTemplate,
-						Role:            domain.Role(def.Role),
-						SystemPrompt:    def.SystemPrompt,
-						Prompt:          def.Prompt,
-						Args:            def.Args,
-						DefaultModel:    def.DefaultModel,
-						Description:     def.Description,
-						SetupScript:     def.SetupScript,
-						Hidden:          def.Hidden,
-						Env:             def.Env,
+						Inherit:          def.Inherit,
+						CommandTemplate:  def.CommandTemplate,
+						Role:             domain.Role(def.Role),
+						SystemPrompt:     def.SystemPrompt,
+						Prompt:           def.Prompt,
+						Args:             def.Args,
+						DefaultModel:     def.DefaultModel,
+						Description:      def.Description,
+						SetupScript:      def.SetupScript,
+						Hidden:           def.Hidden,
+						Env:              def.Env,
+						Fallback:         def.Fallback,
+						NoRetryExitCodes: def.NoRetryExitCodes,
+						MaxRestarts:      def.MaxRestarts,
+						RestartBackoff:   def.RestartBackoff,
 					}
 					for k := range def.Extra {
 						warnings = append(warnings, fmt.Sprintf("unknown key in [agents.%s]: %s", name, k))
@@ -318,6 +330,35 @@ func convertRawToDomainConfig(raw map[string]any, sourcePath string) *domain.Con
 						if i, ok := v.(int64); ok {
 							res.Complete.AutoFixMaxRetries = int(i)
 						}
+					case "reviewers":
+						if arr, ok := v.([]any); ok {
+							seen := make(map[string]bool)
+							for _, item := range arr {
+								s, ok := item.(string)
+								if !ok || s == "" {
+									continue
+								}
+								if seen[s] {
+									warnings = append(warnings, fmt.Sprintf("duplicate reviewer in complete.reviewers: %s", s))
+									continue
+								}
+								seen[s] = true
+								res.Complete.Reviewers = append(res.Complete.Reviewers, s)
+							}
+						}
+					case "review_policy":
+						if s, ok := v.(string); ok {
+							policy := domain.ReviewPolicy(s)
+							if policy.IsValid() {
+								res.Complete.ReviewPolicy = policy
+							} else {
+								warnings = append(warnings, fmt.Sprintf("invalid value for complete.review_policy: %q (expected \"all\", \"majority\", or \"any\")", s))
+							}
+						}
+					case "gates":
+						gates, gateWarnings := parseCompleteGates(v)
+						res.Complete.Gates = gates
+						warnings = append(warnings, gateWarnings...)
 					default:
 						warnings = append(warnings, fmt.Sprintf("unknown key in [complete]: %s", k))
 					}
@@ -332,6 +373,155 @@ func convertRawToDomainConfig(raw map[string]any, sourcePath string) *domain.Con
 					}
 				}
 			}
+		case "scheduler":
+			if m, ok := value.(map[string]any); ok {
+				for k, v := range m {
+					switch k {
+					case "max_parallel":
+						if i, ok := v.(int64); ok {
+							if i <= 0 {
+								warnings = append(warnings, fmt.Sprintf("invalid value for scheduler.max_parallel: %d (expected >= 1)", i))
+							} else {
+								res.Scheduler.MaxParallel = int(i)
+							}
+						}
+					case "interval":
+						if i, ok := v.(int64); ok {
+							if i <= 0 {
+								warnings = append(warnings, fmt.Sprintf("invalid value for scheduler.interval: %d (expected >= 1)", i))
+							} else {
+								res.Scheduler.Interval = int(i)
+							}
+						}
+					case "agent":
+						if s, ok := v.(string); ok {
+							res.Scheduler.Agent = s
+						}
+					case "agent_limits":
+						if limits, ok := v.(map[string]any); ok {
+							for name, lv := range limits {
+								i, ok := lv.(int64)
+								if !ok || i <= 0 {
+									warnings = append(warnings, fmt.Sprintf("invalid value for scheduler.agent_limits.%s: %v (expected >= 1)", name, lv))
+									continue
+								}
+								if res.Scheduler.AgentLimits == nil {
+									res.Scheduler.AgentLimits = make(map[string]int)
+								}
+								res.Scheduler.AgentLimits[name] = int(i)
+							}
+						}
+					default:
+						warnings = append(warnings, fmt.Sprintf("unknown key in [scheduler]: %s", k))
+					}
+				}
+			}
+		case "watch":
+			if m, ok := value.(map[string]any); ok {
+				for k, v := range m {
+					switch k {
+					case "interval":
+						if i, ok := v.(int64); ok {
+							if i <= 0 {
+								warnings = append(warnings, fmt.Sprintf("invalid value for watch.interval: %d (expected >= 1)", i))
+							} else {
+								res.Watch.Interval = int(i)
+							}
+						}
+					case "idle_timeout", "permission_timeout":
+						if i, ok := v.(int64); ok {
+							switch {
+							case i < 0:
+								warnings = append(warnings, fmt.Sprintf("invalid value for watch.%s: %d (expected >= 0)", k, i))
+							case k == "idle_timeout":
+								res.Watch.IdleTimeout = int(i)
+							default:
+								res.Watch.PermissionTimeout = int(i)
+							}
+						}
+					case "nudge":
+						if s, ok := v.(string); ok {
+							res.Watch.Nudge = s
+						}
+					case "permission_nudge":
+						if s, ok := v.(string); ok {
+							res.Watch.PermissionNudge = s
+						}
+					default:
+						warnings = append(warnings, fmt.Sprintf("unknown key in [watch]: %s", k))
+					}
+				}
+			}
+		case "hooks":
+			if m, ok := value.(map[string]any); ok {
+				for k, v := range m {
+					s, ok := v.(string)
+					if !ok {
+						warnings = append(warnings, fmt.Sprintf("invalid value for hooks.%s: %v (expected a command string)", k, v))
+						continue
+					}
+					switch domain.Hook(k) {
+					case domain.HookOnStart:
+						res.Hooks.OnStart = s
+					case domain.HookOnComplete:
+						res.Hooks.OnComplete = s
+					case domain.HookOnDone:
+						res.Hooks.OnDone = s
+					case domain.HookOnMerge:
+						res.Hooks.OnMerge = s
+					case domain.HookOnError:
+						res.Hooks.OnError = s
+					case domain.HookOnClose:
+						res.Hooks.OnClose = s
+					case domain.HookOnSubstate:
+						res.Hooks.OnSubstate = s
+					case domain.HookOnStalled:
+						res.Hooks.OnStalled = s
+					default:
+						warnings = append(warnings, fmt.Sprintf("unknown key in [hooks]: %s", k))
+					}
+				}
+			}
+		case "merge":
+			if m, ok := value.(map[string]any); ok {
+				for k, v := range m {
+					switch k {
+					case "strategy":
+						if s, ok := v.(string); ok {
+							strategy := domain.MergeStrategy(s)
+							if strategy.IsValid() {
+								res.Merge.Strategy = strategy
+							} else {
+								warnings = append(warnings, fmt.Sprintf("invalid value for merge.strategy: %q (expected \"merge\", \"squash\", \"rebase\", or \"ff-only\")", s))
+							}
+						}
+					case "message":
+						if s, ok := v.(string); ok {
+							res.Merge.Message = s
+						}
+					default:
+						warnings = append(warnings, fmt.Sprintf("unknown key in [merge]: %s", k))
+					}
+				}
+			}
+		case "session":
+			if m, ok := value.(map[string]any); ok {
+				for k, v := range m {
+					switch k {
+					case "backend":
+						if s, ok := v.(string); ok {
+							switch s {
+							case domain.SessionBackendTmux, domain.SessionBackendPTY:
+								res.Session.Backend = s
+							default:
+								warnings = append(warnings, fmt.Sprintf("invalid value for session.backend: %q (expected \"tmux\" or \"pty\")", s))
+							}
+						}
+					default:
+						warnings = append(warnings, fmt.Sprintf("unknown key in [session]: %s", k))
+					}
+				}
+			}
 		case "diff":
 			if m, ok := value.(map[string]any); ok {
 				for k, v := range m {
@@ -504,30 +694,178 @@ func convertRawToDomainConfig(raw map[string]any, sourcePath string) *domain.Con
 
 // agentsConfig holds the parsed [agents] section.
 type agentsConfig struct {
-	Defs            map[string]agentDef // Per-agent definitions from [agents.<name>]
-	DefaultWorker   string              // Default worker agent name
-	DefaultManager  string              // Default manager agent name
-	DefaultReviewer string              // Default reviewer agent name
-	WorkerPrompt    string              // Default prompt for all worker agents
-	ManagerPrompt   string              // Default prompt for all manager agents
-	ReviewerPrompt  string              // Default prompt for all reviewer agents
-	DisabledAgents  []string            // List of agent names to disable
-	Unknowns        []string            // Unknown keys in [agents]
+	Defs                    map[string]agentDef // Per-agent definitions from [agents.<name>]
+	DefaultWorker           string              // Default worker agent name
+	DefaultManager          string              // Default manager agent name
+	DefaultReviewer         string              // Default reviewer agent name
+	DefaultConflictResolver string              // Conflict resolver agent name (empty disables it)
+	WorkerPrompt            string              // Default prompt for all worker agents
+	ManagerPrompt           string              // Default prompt for all manager agents
+	ReviewerPrompt          string              // Default prompt for all reviewer agents
+	ConflictResolverPrompt  string              // Default prompt for the conflict resolver
+	DisabledAgents          []string            // List of agent names to disable
+	Routing                 []domain.RoutingRule
+	Unknowns                []string // Unknown keys in [agents]
+	Warnings                []string // Invalid values found in [agents]
 }
 
 type agentDef struct {
-	Extra           map[string]any
-	Env             map[string]string
-	Inherit         string
-	CommandTemplate string
-	Role            string
-	SystemPrompt    string
-	Prompt          string
-	Args            string
-	DefaultModel    string
-	Description     string
-	SetupScript     string
-	Hidden          bool
+	Extra            map[string]any
+	Env              map[string]string
+	Inherit          string
+	CommandTemplate  string
+	Role             string
+	SystemPrompt     string
+	Prompt           string
+	Args             string
+	DefaultModel     string
+	Description      string
+	SetupScript      string
+	Fallback         []string
+	NoRetryExitCodes []int
+	MaxRestarts      int
+	RestartBackoff   int
+	Hidden           bool
+}
+
+// parseCompleteGates parses the [[complete.gates]] entries.
+// Invalid entries are skipped with a warning.
+func parseCompleteGates(value any) ([]domain.CompleteGate, []string) {
+	arr, ok := value.([]any)
+	if !ok {
+		return nil, []string{"invalid value for complete.gates: expected an array of tables ([[complete.gates]])"}
+	}
+	var gates []domain.CompleteGate
+	var warnings []string
+	seen := make(map[string]bool)
+	for i, item := range arr {
+		m, ok := item.(map[string]any)
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("invalid value for complete.gates[%d]: expected a table", i))
+			continue
+		}
+		gate := domain.CompleteGate{Required: true}
+		for k, v := range m {
+			switch k {
+			case "name":
+				if s, ok := v.(string); ok {
+					gate.Name = s
+				}
+			case "command":
+				if s, ok := v.(string); ok {
+					gate.Command = s
+				}
+			case "parallel":
+				if s, ok := v.(string); ok {
+					gate.Parallel = s
+				}
+			case "timeout":
+				if t, ok := v.(int64); ok {
+					if t <= 0 {
+						warnings = append(warnings, fmt.Sprintf("invalid value for complete.gates[%d].timeout: %d (expected >= 1)", i, t))
+					} else {
+						gate.Timeout = int(t)
+					}
+				}
+			case "required":
+				if b, ok := v.(bool); ok {
+					gate.Required = b
+				}
+			default:
+				warnings = append(warnings, fmt.Sprintf("unknown key in [[complete.gates]]: %s", k))
+			}
+		}
+		switch {
+		case !domain.IsValidGateName(gate.Name):
+			warnings = append(warnings, fmt.Sprintf("invalid value for complete.gates[%d].name: %q (expected letters, digits, '.', '_' or '-')", i, gate.Name))
+		case gate.Command == "":
+			warnings = append(warnings, fmt.Sprintf("missing command for complete gate %q", gate.Name))
+		case seen[gate.Name]:
+			warnings = append(warnings, fmt.Sprintf("duplicate complete gate %q", gate.Name))
+		default:
+			seen[gate.Name] = true
+			gates = append(gates, gate)
+		}
+	}
+	return gates, warnings
+}
+
+// parseAgentRouting parses the [[agents.routing]] entries.
+// Invalid entries are skipped with a warning.
+func parseAgentRouting(value any) ([]domain.RoutingRule, []string) {
+	arr, ok := value.([]any)
+	if !ok {
+		return nil, []string{"invalid value for agents.routing: expected an array of tables ([[agents.routing]])"}
+	}
+	var rules []domain.RoutingRule
+	var warnings []string
+	for i, item := range arr {
+		m, ok := item.(map[string]any)
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("invalid value for agents.routing[%d]: expected a table", i))
+			continue
+		}
+		var rule domain.RoutingRule
+		for k, v := range m {
+			switch k {
+			case "name":
+				if s, ok := v.(string); ok {
+					rule.Name = s
+				}
+			case "agent":
+				if s, ok := v.(string); ok {
+					rule.Agent = s
+				}
+			case "title":
+				if s, ok := v.(string); ok {
+					rule.Title = s
+				}
+			case "description":
+				if s, ok := v.(string); ok {
+					rule.Description = s
+				}
+			case "labels":
+				rule.Labels = stringList(v)
+			case "size":
+				for _, s := range stringList(v) {
+					rule.Sizes = append(rule.Sizes, domain.TaskSize(s))
+				}
+			case "parent":
+				if n, ok := v.(int64); ok {
+					if n <= 0 {
+						warnings = append(warnings, fmt.Sprintf("invalid value for agents.routing[%d].parent: %d (expected a task ID)", i, n))
+					} else {
+						rule.Parent = int(n)
+					}
+				}
+			default:
+				warnings = append(warnings, fmt.Sprintf("unknown key in [[agents.routing]]: %s", k))
+			}
+		}
+		if err := rule.Validate(); err != nil {
+			warnings = append(warnings, fmt.Sprintf("invalid routing rule %q: %v", rule.DisplayName(i), err))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules, warnings
+}
+
+// stringList accepts a single string or an array of strings.
+func stringList(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var result []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
 }
 
 // parseAgentsSection parses the raw agents map into structured agentsConfig.
@@ -562,6 +900,14 @@ func parseAgentsSection(raw map[string]any) agentsConfig {
 			if s, ok := value.(string); ok {
 				result.ReviewerPrompt = s
 			}
+		case "conflict_resolver_default":
+			if s, ok := value.(string); ok {
+				result.DefaultConflictResolver = s
+			}
+		case "conflict_resolver_prompt":
+			if s, ok := value.(string); ok {
+				result.ConflictResolverPrompt = s
+			}
 		case "disabled_agents":
 			if arr, ok := value.([]any); ok {
 				for _, item := range arr {
@@ -570,6 +916,10 @@ func parseAgentsSection(raw map[string]any) agentsConfig {
 					}
 				}
 			}
+		case "routing":
+			rules, warnings := parseAgentRouting(value)
+			result.Routing = rules
+			result.Warnings = append(result.Warnings, warnings...)
 		default:
 			if subMap, ok := value.(map[string]any); ok {
 				def := agentDef{
@@ -617,6 +967,27 @@ func parseAgentsSection(raw map[string]any) agentsConfig {
 						if b, ok := v.(bool); ok {
 							def.Hidden = b
 						}
+					case "fallback":
+						def.Fallback = stringList(v)
+					case "no_retry_exit_codes":
+						if arr, ok := v.([]any); ok {
+							for _, item := range arr {
+								if code, ok := item.(int64); ok {
+									def.NoRetryExitCodes = append(def.NoRetryExitCodes, int(code))
+								}
+							}
+						}
+					case "max_restarts", "restart_backoff":
+						if i, ok := v.(int64); ok {
+							switch {
+							case i < 0:
+								result.Warnings = append(result.Warnings, fmt.Sprintf("invalid value for agents.%s.%s: %d (expected >= 0)", key, k, i))
+							case k == "max_restarts":
+								def.MaxRestarts = int(i)
+							default:
+								def.RestartBackoff = int(i)
+							}
+						}
 					case "env":
 						if envMap, ok := v.(map[string]any); ok {
 							def.Env = make(map[string]string)
@@ -681,6 +1052,11 @@ func mergeConfigs(base, override *domain.Config) *domain.Config {
 		Diff:         base.Diff,
 		Log:          base.Log,
 		Help:         base.Help,
+		Hooks:        base.Hooks,
+		Session:      base.Session,
+		Merge:        base.Merge,
+		Scheduler:    base.Scheduler,
+		Watch:        base.Watch,
 		Tasks:        base.Tasks,
 		TUI:          base.TUI,
 		Worktree:     base.Worktree,
@@ -714,14 +1090,32 @@ func mergeConfigs(base, override *domain.Config) *domain.Config {
 	if override.AgentsConfig.ReviewerPrompt != "" {
 		result.AgentsConfig.ReviewerPrompt = override.AgentsConfig.ReviewerPrompt
 	}
+	if override.AgentsConfig.DefaultConflictResolver != "" {
+		result.AgentsConfig.DefaultConflictResolver = override.AgentsConfig.DefaultConflictResolver
+	}
+	if override.AgentsConfig.ConflictResolverPrompt != "" {
+		result.AgentsConfig.ConflictResolverPrompt = override.AgentsConfig.ConflictResolverPrompt
+	}
 	if len(override.AgentsConfig.DisabledAgents) > 0 {
 		result.AgentsConfig.DisabledAgents = override.AgentsConfig.DisabledAgents
 	}
+	if len(override.AgentsConfig.Routing) > 0 {
+		result.AgentsConfig.Routing = override.AgentsConfig.Routing
+	}
 
 	// Override other sections
 	if override.Complete.Command != "" {
 		result.Complete.Command = override.Complete.Command
 	}
+	if len(override.Complete.Reviewers) > 0 {
+		result.Complete.Reviewers = override.Complete.Reviewers
+	}
+	if override.Complete.ReviewPolicy != "" {
+		result.Complete.ReviewPolicy = override.Complete.ReviewPolicy
+	}
+	if len(override.Complete.Gates) > 0 {
+		result.Complete.Gates = override.Complete.Gates
+	}
 	if override.Complete.ReviewModeSet {
 		result.Complete.ReviewMode = override.Complete.ReviewMode
 		result.Complete.ReviewModeSet = true
@@ -739,6 +1133,73 @@ func mergeConfigs(base, override *domain.Config) *domain.Config {
 	if override.Complete.AutoFixMaxRetries > 0 {
 		result.Complete.AutoFixMaxRetries = override.Complete.AutoFixMaxRetries
 	}
+	if override.Scheduler.MaxParallel > 0 {
+		result.Scheduler.MaxParallel = override.Scheduler.MaxParallel
+	}
+	if override.Scheduler.Interval > 0 {
+		result.Scheduler.Interval = override.Scheduler.Interval
+	}
+	if override.Scheduler.Agent != "" {
+		result.Scheduler.Agent = override.Scheduler.Agent
+	}
+	if len(override.Scheduler.AgentLimits) > 0 {
+		limits := make(map[string]int, len(result.Scheduler.AgentLimits)+len(override.Scheduler.AgentLimits))
+		for name, limit := range result.Scheduler.AgentLimits {
+			limits[name] = limit
+		}
+		for name, limit := range override.Scheduler.AgentLimits {
+			limits[name] = limit
+		}
+		result.Scheduler.AgentLimits = limits
+	}
+	if override.Watch.Interval > 0 {
+		result.Watch.Interval = override.Watch.Interval
+	}
+	if override.Watch.IdleTimeout > 0 {
+		result.Watch.IdleTimeout = override.Watch.IdleTimeout
+	}
+	if override.Watch.PermissionTimeout > 0 {
+		result.Watch.PermissionTimeout = override.Watch.PermissionTimeout
+	}
+	if override.Watch.Nudge != "" {
+		result.Watch.Nudge = override.Watch.Nudge
+	}
+	if override.Watch.PermissionNudge != "" {
+		result.Watch.PermissionNudge = override.Watch.PermissionNudge
+	}
+	if override.Hooks.OnStart != "" {
+		result.Hooks.OnStart = override.Hooks.OnStart
+	}
+	if override.Hooks.OnComplete != "" {
+		result.Hooks.OnComplete = override.Hooks.OnComplete
+	}
+	if override.Hooks.OnDone != "" {
+		result.Hooks.OnDone = override.Hooks.OnDone
+	}
+	if override.Hooks.OnMerge != "" {
+		result.Hooks.OnMerge = override.Hooks.OnMerge
+	}
+	if override.Hooks.OnError != "" {
+		result.Hooks.OnError = override.Hooks.OnError
+	}
+	if override.Hooks.OnClose != "" {
+		result.Hooks.OnClose = override.Hooks.OnClose
+	}
+	if override.Hooks.OnSubstate != "" {
+		result.Hooks.OnSubstate = override.Hooks.OnSubstate
+	}
+	if override.Hooks.OnStalled != "" {
+		result.Hooks.OnStalled = override.Hooks.OnStalled
+	}
+	if override.Session.Backend != "" {
+		result.Session.Backend = override.Session.Backend
+	}
+	if override.Merge.Strategy != "" {
+		result.Merge.Strategy = override.Merge.Strategy
+	}
+	if override.Merge.Message != "" {
+		result.Merge.Message = override.Merge.Message
+	}
 	if override.Diff.Command != "" {
 		result.Diff.Command = override.Diff.Command
 	}
@@ -832,6 +1293,18 @@ func mergeConfigs(base, override *domain.Config) *domain.Config {
 		if len(overrideAgent.Env) > 0 {
 			baseAgent.Env = mergeEnv(baseAgent.Env, overrideAgent.Env)
 		}
+		if len(overrideAgent.Fallback) > 0 {
+			baseAgent.Fallback = overrideAgent.Fallback
+		}
+		if len(overrideAgent.NoRetryExitCodes) > 0 {
+			baseAgent.NoRetryExitCodes = overrideAgent.NoRetryExitCodes
+		}
+		if overrideAgent.MaxRestarts != 0 {
+			baseAgent.MaxRestarts = overrideAgent.MaxRestarts
+		}
+		if overrideAgent.RestartBackoff != 0 {
+			baseAgent.RestartBackoff = overrideAgent.RestartBackoff
+		}
 		result.Agents[name] = baseAgent
 	}
 
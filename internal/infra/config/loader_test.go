@@ -218,6 +218,129 @@ command_template = 'my-custom-agent --task "{{.Title}}"'
 	assert.Equal(t, `my-custom-agent --task "{{.Title}}"`, cfg.Agents["my-worker"].CommandTemplate)
 }
 
+func TestLoader_Load_AgentFallback(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	globalConfig := `
+[agents.claude-dev]
+inherit = "claude"
+fallback = ["codex"]
+
+[agents.claude-review]
+inherit = "claude-dev"
+`
+	err := os.WriteFile(filepath.Join(globalDir, domain.ConfigFileName), []byte(globalConfig), 0o644)
+	require.NoError(t, err)
+	repoConfig := `
+[agents.claude-dev]
+fallback = ["codex", "opencode"]
+`
+	err = os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(repoConfig), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	// The repo list replaces the global one and is inherited
+	assert.Equal(t, []string{"codex", "opencode"}, cfg.Agents["claude-dev"].Fallback)
+	assert.Equal(t, []string{"codex", "opencode"}, cfg.Agents["claude-review"].Fallback)
+	assert.Empty(t, cfg.Agents["claude"].Fallback)
+	assert.NotContains(t, cfg.Warnings, "unknown key in [agents.claude-dev]: fallback")
+}
+
+func TestLoader_Load_AgentRestarts(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	globalConfig := `
+[agents.claude-dev]
+inherit = "claude"
+max_restarts = 2
+restart_backoff = 10
+no_retry_exit_codes = [2, 3]
+
+[agents.codex-dev]
+inherit = "codex"
+max_restarts = -1
+`
+	err := os.WriteFile(filepath.Join(globalDir, domain.ConfigFileName), []byte(globalConfig), 0o644)
+	require.NoError(t, err)
+	repoConfig := `
+[agents.claude-review]
+inherit = "claude-dev"
+max_restarts = 1
+`
+	err = os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(repoConfig), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	dev := cfg.Agents["claude-dev"]
+	assert.Equal(t, 2, dev.MaxRestarts)
+	assert.Equal(t, 10, dev.RestartBackoff)
+	assert.Equal(t, []int{2, 3}, dev.NoRetryExitCodes)
+
+	// Inherited settings can be overridden one by one
+	review := cfg.Agents["claude-review"]
+	assert.Equal(t, 1, review.MaxRestarts)
+	assert.Equal(t, 10, review.RestartBackoff)
+	assert.Equal(t, []int{2, 3}, review.NoRetryExitCodes)
+
+	// Negative values are reported and ignored
+	assert.Zero(t, cfg.Agents["codex-dev"].MaxRestarts)
+	assert.Contains(t, cfg.Warnings, "invalid value for agents.codex-dev.max_restarts: -1 (expected >= 0)")
+}
+
+func TestLoader_Load_Watch(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	globalConfig := `
+[watch]
+idle_timeout = 600
+nudge = "Keep going"
+interval = -5
+
+[hooks]
+on_stalled = "notify-send stalled"
+`
+	err := os.WriteFile(filepath.Join(globalDir, domain.ConfigFileName), []byte(globalConfig), 0o644)
+	require.NoError(t, err)
+	repoConfig := `
+[watch]
+permission_timeout = 300
+permission_nudge = "1"
+unknown = true
+`
+	err = os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(repoConfig), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.WatchConfig{
+		Nudge:             "Keep going",
+		PermissionNudge:   "1",
+		Interval:          domain.DefaultWatchInterval,
+		IdleTimeout:       600,
+		PermissionTimeout: 300,
+	}, cfg.Watch)
+	assert.Equal(t, "notify-send stalled", cfg.Hooks.OnStalled)
+	assert.Contains(t, cfg.Warnings, "invalid value for watch.interval: -5 (expected >= 1)")
+	assert.Contains(t, cfg.Warnings, "unknown key in [watch]: unknown")
+}
+
 func TestLoader_LoadRepo(t *testing.T) {
 	// Setup
 	crewDir := t.TempDir()
@@ -584,6 +707,223 @@ args = "--model test"
 	assert.Empty(t, cfg.Worktree.Copy)
 }
 
+func TestLoader_Load_SchedulerConfig(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	globalConfig := `
+[scheduler]
+max_parallel = 5
+agent = "codex"
+
+[scheduler.agent_limits]
+claude = 1
+codex = 4
+`
+	err := os.WriteFile(filepath.Join(globalDir, domain.ConfigFileName), []byte(globalConfig), 0o644)
+	require.NoError(t, err)
+
+	repoConfig := `
+[scheduler]
+interval = 30
+
+[scheduler.agent_limits]
+claude = 2
+`
+	err = os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(repoConfig), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	// Verify scheduler config is merged field by field
+	assert.Equal(t, 5, cfg.Scheduler.MaxParallel)
+	assert.Equal(t, 30, cfg.Scheduler.Interval)
+	assert.Equal(t, "codex", cfg.Scheduler.Agent)
+	assert.Equal(t, map[string]int{"claude": 2, "codex": 4}, cfg.Scheduler.AgentLimits)
+	assert.Empty(t, cfg.Warnings)
+}
+
+func TestLoader_Load_SchedulerConfig_Defaults(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	// Load config without any files
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.DefaultSchedulerMaxParallel, cfg.Scheduler.MaxParallel)
+	assert.Equal(t, domain.DefaultSchedulerInterval, cfg.Scheduler.Interval)
+	assert.Empty(t, cfg.Scheduler.AgentLimits)
+}
+
+func TestLoader_Load_SchedulerConfig_Invalid(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	repoConfig := `
+[scheduler]
+max_parallel = 0
+interval = -1
+unknown = true
+
+[scheduler.agent_limits]
+claude = 0
+`
+	err := os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(repoConfig), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	// Invalid values are ignored and reported
+	assert.Equal(t, domain.DefaultSchedulerMaxParallel, cfg.Scheduler.MaxParallel)
+	assert.Equal(t, domain.DefaultSchedulerInterval, cfg.Scheduler.Interval)
+	assert.Empty(t, cfg.Scheduler.AgentLimits)
+	assert.Contains(t, cfg.Warnings, "invalid value for scheduler.max_parallel: 0 (expected >= 1)")
+	assert.Contains(t, cfg.Warnings, "invalid value for scheduler.interval: -1 (expected >= 1)")
+	assert.Contains(t, cfg.Warnings, "invalid value for scheduler.agent_limits.claude: 0 (expected >= 1)")
+	assert.Contains(t, cfg.Warnings, "unknown key in [scheduler]: unknown")
+}
+
+func TestLoader_Load_HooksConfig(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	globalConfig := `
+[hooks]
+on_error = "notify-send failed"
+on_done = "echo global"
+`
+	err := os.WriteFile(filepath.Join(globalDir, domain.ConfigFileName), []byte(globalConfig), 0o644)
+	require.NoError(t, err)
+
+	repoConfig := `
+[hooks]
+on_done = "echo {{.TaskID}}"
+on_substate = "echo {{.New}}"
+on_finish = "echo unknown"
+on_merge = 1
+`
+	err = os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(repoConfig), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	// Verify hooks are merged field by field
+	assert.Equal(t, "notify-send failed", cfg.Hooks.OnError)
+	assert.Equal(t, "echo {{.TaskID}}", cfg.Hooks.OnDone)
+	assert.Equal(t, "echo {{.New}}", cfg.Hooks.OnSubstate)
+	assert.Empty(t, cfg.Hooks.OnMerge)
+	assert.Contains(t, cfg.Warnings, "unknown key in [hooks]: on_finish")
+	assert.Contains(t, cfg.Warnings, "invalid value for hooks.on_merge: 1 (expected a command string)")
+}
+
+func TestLoader_Load_SessionBackend(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(globalDir, domain.ConfigFileName), []byte("[session]\nbackend = \"pty\"\n"), 0o644)
+	require.NoError(t, err)
+	repoConfig := `
+[session]
+backend = "screen"
+socket = "x"
+`
+	err = os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(repoConfig), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	// Invalid repo value keeps the global backend
+	assert.Equal(t, domain.SessionBackendPTY, cfg.Session.Backend)
+	assert.Contains(t, cfg.Warnings, `invalid value for session.backend: "screen" (expected "tmux" or "pty")`)
+	assert.Contains(t, cfg.Warnings, "unknown key in [session]: socket")
+}
+
+func TestLoader_Load_MergeConfig(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	globalConfig := `
+[merge]
+strategy = "squash"
+message = "{{.Title}}"
+`
+	err := os.WriteFile(filepath.Join(globalDir, domain.ConfigFileName), []byte(globalConfig), 0o644)
+	require.NoError(t, err)
+	repoConfig := `
+[merge]
+strategy = "octopus"
+message = "{{.Title}} (#{{.Issue}})"
+delete_branch = false
+`
+	err = os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(repoConfig), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	// Invalid repo strategy keeps the global strategy; the message is overridden
+	assert.Equal(t, domain.MergeStrategySquash, cfg.Merge.Strategy)
+	assert.Equal(t, "{{.Title}} (#{{.Issue}})", cfg.Merge.Message)
+	assert.Contains(t, cfg.Warnings, `invalid value for merge.strategy: "octopus" (expected "merge", "squash", "rebase", or "ff-only")`)
+	assert.Contains(t, cfg.Warnings, "unknown key in [merge]: delete_branch")
+}
+
+func TestLoader_Load_ConflictResolverConfig(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	globalConfig := `
+[agents]
+conflict_resolver_default = "global-resolver"
+conflict_resolver_prompt = "Keep both sides."
+`
+	err := os.WriteFile(filepath.Join(globalDir, domain.ConfigFileName), []byte(globalConfig), 0o644)
+	require.NoError(t, err)
+	repoConfig := `
+[agents]
+conflict_resolver_default = "repo-resolver"
+
+[agents.repo-resolver]
+role = "conflict_resolver"
+command_template = "resolve {{.Prompt}}"
+`
+	err = os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(repoConfig), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	// Repo default wins; the global prompt is kept
+	assert.Equal(t, "repo-resolver", cfg.AgentsConfig.DefaultConflictResolver)
+	assert.Equal(t, "Keep both sides.", cfg.AgentsConfig.ConflictResolverPrompt)
+	assert.Equal(t, domain.RoleConflictResolver, cfg.Agents["repo-resolver"].Role)
+}
+
 func TestLoader_Load_Priority(t *testing.T) {
 	// Setup
 	repoRootDir := t.TempDir()
@@ -1001,6 +1341,220 @@ auto_fix_max_retries = 5
 	assert.Equal(t, 5, cfg.Complete.AutoFixMaxRetries)
 }
 
+func TestLoader_Load_CompleteReviewers(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	config := `
+[complete]
+reviewers = ["claude-reviewer", "codex-reviewer", "claude-reviewer"]
+review_policy = "majority"
+`
+	err := os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(config), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	// Verify reviewers (duplicates dropped) and policy
+	assert.Equal(t, []string{"claude-reviewer", "codex-reviewer"}, cfg.Complete.Reviewers)
+	assert.Equal(t, domain.ReviewPolicyMajority, cfg.Complete.ReviewPolicy)
+	assert.Contains(t, cfg.Warnings, "duplicate reviewer in complete.reviewers: claude-reviewer")
+}
+
+func TestLoader_Load_CompleteReviewPolicyInvalid(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	config := `
+[complete]
+review_policy = "unanimous"
+`
+	err := os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(config), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	// Verify the invalid policy is ignored with a warning
+	assert.Empty(t, cfg.Complete.ReviewPolicy)
+	assert.Contains(t, cfg.Warnings, `invalid value for complete.review_policy: "unanimous" (expected "all", "majority", or "any")`)
+}
+
+func TestLoader_Load_CompleteGates(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	config := `
+[complete]
+command = "mise run ci"
+
+[[complete.gates]]
+name = "lint"
+command = "golangci-lint run"
+parallel = "checks"
+
+[[complete.gates]]
+name = "test"
+command = "go test ./..."
+parallel = "checks"
+timeout = 900
+
+[[complete.gates]]
+name = "build"
+command = "go build ./..."
+required = false
+
+[[complete.gates]]
+name = "bad,name"
+command = "true"
+
+[[complete.gates]]
+name = "empty"
+
+[[complete.gates]]
+name = "lint"
+command = "duplicate"
+`
+	err := os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(config), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	// Verify gates (invalid entries are skipped with warnings)
+	assert.Equal(t, "mise run ci", cfg.Complete.Command)
+	assert.Equal(t, []domain.CompleteGate{
+		{Name: "lint", Command: "golangci-lint run", Parallel: "checks", Required: true},
+		{Name: "test", Command: "go test ./...", Parallel: "checks", Timeout: 900, Required: true},
+		{Name: "build", Command: "go build ./...", Required: false},
+	}, cfg.Complete.Gates)
+	assert.Contains(t, cfg.Warnings, `invalid value for complete.gates[3].name: "bad,name" (expected letters, digits, '.', '_' or '-')`)
+	assert.Contains(t, cfg.Warnings, `missing command for complete gate "empty"`)
+	assert.Contains(t, cfg.Warnings, `duplicate complete gate "lint"`)
+}
+
+func TestLoader_Load_CompleteGates_RepoOverridesGlobal(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	globalConfig := `
+[[complete.gates]]
+name = "global"
+command = "true"
+`
+	err := os.WriteFile(filepath.Join(globalDir, domain.ConfigFileName), []byte(globalConfig), 0o644)
+	require.NoError(t, err)
+	repoConfig := `
+[[complete.gates]]
+name = "repo"
+command = "make check"
+`
+	err = os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(repoConfig), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	// The repo gate list replaces the global one
+	require.Len(t, cfg.Complete.Gates, 1)
+	assert.Equal(t, "repo", cfg.Complete.Gates[0].Name)
+}
+
+func TestLoader_Load_AgentsRouting(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	config := `
+[agents]
+worker_default = "claude"
+
+[[agents.routing]]
+name = "docs"
+agent = "claude-fast"
+labels = ["docs", "typo"]
+
+[[agents.routing]]
+agent = "codex"
+title = "(?i)refactor"
+size = "large"
+parent = 12
+
+[[agents.routing]]
+name = "no-agent"
+labels = ["bug"]
+
+[[agents.routing]]
+agent = "claude"
+description = "("
+
+[[agents.routing]]
+agent = "claude"
+size = ["huge"]
+priority = 1
+`
+	err := os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(config), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	// Verify rules (invalid entries are skipped with warnings)
+	assert.Equal(t, []domain.RoutingRule{
+		{Name: "docs", Agent: "claude-fast", Labels: []string{"docs", "typo"}},
+		{Agent: "codex", Title: "(?i)refactor", Sizes: []domain.TaskSize{domain.TaskSizeLarge}, Parent: 12},
+	}, cfg.AgentsConfig.Routing)
+	assert.Contains(t, cfg.Warnings, `invalid routing rule "no-agent": missing agent`)
+	assert.Contains(t, cfg.Warnings, `invalid routing rule "rule 4": invalid description pattern "(": error parsing regexp: missing closing ): `+"`(`")
+	assert.Contains(t, cfg.Warnings, `invalid routing rule "rule 5": invalid size "huge" (expected small, medium, or large)`)
+	assert.Contains(t, cfg.Warnings, "unknown key in [[agents.routing]]: priority")
+	assert.NotContains(t, cfg.Warnings, "unknown key in [agents]: routing")
+}
+
+func TestLoader_Load_AgentsRouting_RepoOverridesGlobal(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	globalDir := t.TempDir()
+
+	globalConfig := `
+[[agents.routing]]
+agent = "global-agent"
+`
+	err := os.WriteFile(filepath.Join(globalDir, domain.ConfigFileName), []byte(globalConfig), 0o644)
+	require.NoError(t, err)
+	repoConfig := `
+[[agents.routing]]
+agent = "repo-agent"
+labels = ["docs"]
+`
+	err = os.WriteFile(filepath.Join(crewDir, domain.ConfigFileName), []byte(repoConfig), 0o644)
+	require.NoError(t, err)
+
+	// Load config
+	loader := NewLoaderWithGlobalDir(crewDir, "", globalDir)
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	// The repo rule list replaces the global one
+	require.Len(t, cfg.AgentsConfig.Routing, 1)
+	assert.Equal(t, "repo-agent", cfg.AgentsConfig.Routing[0].Agent)
+}
+
 func TestLoader_Load_CompleteAutoFix_Merge(t *testing.T) {
 	// Setup
 	crewDir := t.TempDir()
@@ -0,0 +1,106 @@
+// Package eventlog stores task events as JSON lines in .crew/events.jsonl.
+package eventlog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// Ensure Log implements domain.EventLog interface.
+var _ domain.EventLog = (*Log)(nil)
+
+// Log is a file-backed, append-only event log.
+// Each event is written as a single JSON line with O_APPEND, so concurrent
+// crew processes can append without coordination.
+type Log struct {
+	path string
+}
+
+// New creates a Log writing to path.
+func New(path string) *Log {
+	return &Log{path: path}
+}
+
+// Append appends events to the log.
+func (l *Log) Append(events ...domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, event := range events {
+		if err := enc.Encode(event); err != nil {
+			return fmt.Errorf("encode event: %w", err)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o750); err != nil {
+		return fmt.Errorf("create event log directory: %w", err)
+	}
+	// G302: Event log is append-only and readable by repository users like the other logs
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640) //nolint:gosec // Event log readable by owner and group
+	if err != nil {
+		return fmt.Errorf("open event log: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	// A single write keeps the lines of one change together
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("write event log: %w", err)
+	}
+	return nil
+}
+
+// ReadFrom returns the events stored after offset and the offset to continue from.
+func (l *Log) ReadFrom(offset int64) ([]domain.Event, int64, error) {
+	f, err := os.Open(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, offset, fmt.Errorf("open event log: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, offset, fmt.Errorf("stat event log: %w", err)
+	}
+	if info.Size() < offset {
+		offset = 0
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, offset, fmt.Errorf("seek event log: %w", err)
+	}
+
+	var events []domain.Event
+	reader := bufio.NewReader(f)
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		if errors.Is(readErr, io.EOF) {
+			// Leave an incomplete line for the next read
+			break
+		}
+		if readErr != nil {
+			return events, offset, fmt.Errorf("read event log: %w", readErr)
+		}
+		offset += int64(len(line))
+
+		var event domain.Event
+		if err := json.Unmarshal(line, &event); err != nil {
+			// Skip corrupted lines rather than blocking the whole log
+			continue
+		}
+		events = append(events, event)
+	}
+	return events, offset, nil
+}
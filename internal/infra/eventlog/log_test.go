@@ -0,0 +1,88 @@
+package eventlog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLog_AppendAndReadFrom(t *testing.T) {
+	// Setup
+	log := New(filepath.Join(t.TempDir(), ".crew", "events.jsonl"))
+	at := time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC)
+
+	// Execute
+	require.NoError(t, log.Append(
+		domain.Event{Time: at, Type: domain.EventStatus, New: "todo", TaskID: 1},
+		domain.Event{Time: at, Type: domain.EventStatus, Old: "todo", New: "in_progress", TaskID: 1},
+	))
+	first, offset, err := log.ReadFrom(0)
+	require.NoError(t, err)
+	require.NoError(t, log.Append(domain.Event{Time: at, Type: domain.EventMerge, New: "main", TaskID: 2}))
+	second, next, err := log.ReadFrom(offset)
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, first, 2)
+	assert.Equal(t, "in_progress", first[1].New)
+	assert.True(t, first[0].Time.Equal(at))
+	require.Len(t, second, 1)
+	assert.Equal(t, domain.EventMerge, second[0].Type)
+	assert.Greater(t, next, offset)
+}
+
+func TestLog_ReadFrom_MissingFile(t *testing.T) {
+	// Setup
+	log := New(filepath.Join(t.TempDir(), "events.jsonl"))
+
+	// Execute
+	events, offset, err := log.ReadFrom(0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	assert.Equal(t, int64(0), offset)
+}
+
+func TestLog_ReadFrom_PartialAndCorruptLines(t *testing.T) {
+	// Setup
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	content := `{"type":"status","new":"todo","task_id":1}` + "\n" +
+		"not json\n" +
+		`{"type":"status","new":"in_pro`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	log := New(path)
+
+	// Execute
+	events, offset, err := log.ReadFrom(0)
+
+	// Assert: corrupt line skipped, partial line left for the next read
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, 1, events[0].TaskID)
+	assert.Equal(t, int64(len(content)-len(`{"type":"status","new":"in_pro`)), offset)
+}
+
+func TestLog_ReadFrom_Truncated(t *testing.T) {
+	// Setup
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	log := New(path)
+	require.NoError(t, log.Append(domain.Event{Type: domain.EventStatus, New: "todo", TaskID: 1}))
+	_, offset, err := log.ReadFrom(0)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+	require.NoError(t, log.Append(domain.Event{Type: domain.EventStatus, New: "todo", TaskID: 2}))
+
+	// Execute: offset is past the end after truncation, so reading restarts
+	events, _, err := log.ReadFrom(offset + 100)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, 2, events[0].TaskID)
+}
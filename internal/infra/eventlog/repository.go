@@ -0,0 +1,94 @@
+package eventlog
+
+import (
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// Ensure Repository implements domain.TaskRepository interface.
+var _ domain.TaskRepository = (*Repository)(nil)
+
+// Repository decorates a TaskRepository and records an event for every
+// task state change that is saved through it.
+// Recording failures are logged and never fail the save.
+type Repository struct {
+	domain.TaskRepository
+	events   domain.EventLog
+	clock    domain.Clock
+	logger   domain.Logger
+	actor    string
+	handlers []domain.EventHandler
+}
+
+// NewRepository wraps tasks so that saved changes are appended to events.
+// actor identifies who makes the changes in this process.
+func NewRepository(tasks domain.TaskRepository, events domain.EventLog, clock domain.Clock, logger domain.Logger, actor string) *Repository {
+	return &Repository{
+		TaskRepository: tasks,
+		events:         events,
+		clock:          clock,
+		logger:         logger,
+		actor:          actor,
+	}
+}
+
+// WithHandler adds a handler that is notified of recorded events.
+func (r *Repository) WithHandler(handler domain.EventHandler) *Repository {
+	r.handlers = append(r.handlers, handler)
+	return r
+}
+
+// Save saves the task and records its state changes.
+func (r *Repository) Save(task *domain.Task) error {
+	old := r.previous(task)
+	if err := r.TaskRepository.Save(task); err != nil {
+		return err
+	}
+	r.record(old, task)
+	return nil
+}
+
+// SaveTaskWithComments saves the task and comments and records the task state changes.
+func (r *Repository) SaveTaskWithComments(task *domain.Task, comments []domain.Comment) error {
+	old := r.previous(task)
+	if err := r.TaskRepository.SaveTaskWithComments(task, comments); err != nil {
+		return err
+	}
+	r.record(old, task)
+	return nil
+}
+
+// ListAll lists tasks across all namespaces when the wrapped repository supports it.
+func (r *Repository) ListAll(filter domain.TaskFilter) ([]*domain.Task, error) {
+	if lister, ok := r.TaskRepository.(interface {
+		ListAll(filter domain.TaskFilter) ([]*domain.Task, error)
+	}); ok {
+		return lister.ListAll(filter)
+	}
+	return r.TaskRepository.List(filter)
+}
+
+// previous returns a copy of the stored task before it is overwritten.
+func (r *Repository) previous(task *domain.Task) *domain.Task {
+	if task == nil {
+		return nil
+	}
+	old, err := r.TaskRepository.Get(task.ID)
+	if err != nil || old == nil {
+		return nil
+	}
+	copied := *old
+	return &copied
+}
+
+func (r *Repository) record(old, task *domain.Task) {
+	events := domain.TaskEvents(old, task, r.clock.Now(), r.actor)
+	if len(events) == 0 {
+		return
+	}
+	if err := r.events.Append(events...); err != nil && r.logger != nil {
+		r.logger.Warn(task.ID, "events", "failed to record events: "+err.Error())
+	}
+	for _, handler := range r.handlers {
+		handler.HandleEvents(task, events)
+	}
+}
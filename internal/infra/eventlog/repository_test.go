@@ -0,0 +1,162 @@
+package eventlog
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Save_RecordsEvents(t *testing.T) {
+	// Setup
+	tasks := testutil.NewMockTaskRepository()
+	events := testutil.NewMockEventLog()
+	now := time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC)
+	repo := NewRepository(tasks, events, &testutil.MockClock{NowTime: now}, testutil.NewMockLogger(), "worker")
+
+	// Execute
+	require.NoError(t, repo.Save(&domain.Task{ID: 1, Status: domain.StatusTodo}))
+	require.NoError(t, repo.Save(&domain.Task{ID: 1, Status: domain.StatusInProgress, Session: "crew-1"}))
+
+	// Assert
+	require.Len(t, events.Events, 3)
+	assert.Equal(t, domain.EventStatus, events.Events[0].Type)
+	assert.Equal(t, "todo", events.Events[0].New)
+	assert.Equal(t, "todo", events.Events[1].Old)
+	assert.Equal(t, "in_progress", events.Events[1].New)
+	assert.Equal(t, domain.EventSessionStart, events.Events[2].Type)
+	assert.Equal(t, "worker", events.Events[2].Actor)
+	assert.Equal(t, now, events.Events[2].Time)
+}
+
+func TestRepository_SaveTaskWithComments_RecordsEvents(t *testing.T) {
+	// Setup
+	tasks := testutil.NewMockTaskRepository()
+	tasks.Tasks[1] = &domain.Task{ID: 1, Status: domain.StatusDone}
+	events := testutil.NewMockEventLog()
+	repo := NewRepository(tasks, events, &testutil.MockClock{}, testutil.NewMockLogger(), "alice")
+
+	// Execute
+	err := repo.SaveTaskWithComments(&domain.Task{ID: 1, Status: domain.StatusInProgress}, []domain.Comment{{Text: "fix"}})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, "done", events.Events[0].Old)
+	assert.Equal(t, "in_progress", events.Events[0].New)
+}
+
+func TestRepository_Save_AppendErrorDoesNotFail(t *testing.T) {
+	// Setup
+	tasks := testutil.NewMockTaskRepository()
+	events := testutil.NewMockEventLog()
+	events.AppendErr = errors.New("disk full")
+	repo := NewRepository(tasks, events, &testutil.MockClock{}, testutil.NewMockLogger(), "alice")
+
+	// Execute
+	err := repo.Save(&domain.Task{ID: 1, Status: domain.StatusTodo})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, tasks.Tasks, 1)
+}
+
+func TestRepository_Save_ErrorRecordsNothing(t *testing.T) {
+	// Setup
+	tasks := testutil.NewMockTaskRepository()
+	tasks.SaveErr = errors.New("save failed")
+	events := testutil.NewMockEventLog()
+	repo := NewRepository(tasks, events, &testutil.MockClock{}, testutil.NewMockLogger(), "alice")
+
+	// Execute
+	err := repo.Save(&domain.Task{ID: 1, Status: domain.StatusTodo})
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, events.Events)
+}
+
+type recordingHandler struct {
+	tasks  []*domain.Task
+	events []domain.Event
+}
+
+func (h *recordingHandler) HandleEvents(task *domain.Task, events []domain.Event) {
+	h.tasks = append(h.tasks, task)
+	h.events = append(h.events, events...)
+}
+
+func TestRepository_Save_NotifiesHandlers(t *testing.T) {
+	// Setup
+	tasks := testutil.NewMockTaskRepository()
+	tasks.Tasks[1] = &domain.Task{ID: 1, Status: domain.StatusInProgress}
+	handler := &recordingHandler{}
+	repo := NewRepository(tasks, testutil.NewMockEventLog(), &testutil.MockClock{}, testutil.NewMockLogger(), "worker").WithHandler(handler)
+
+	// Execute
+	require.NoError(t, repo.Save(&domain.Task{ID: 1, Status: domain.StatusError}))
+	require.NoError(t, repo.Save(&domain.Task{ID: 1, Status: domain.StatusError, Title: "renamed"}))
+
+	// Assert: unchanged state does not notify
+	require.Len(t, handler.tasks, 1)
+	require.Len(t, handler.events, 1)
+	assert.Equal(t, "error", handler.events[0].New)
+}
+
+// goroutineLauncher runs launched hooks in the background, standing in for
+// the detached crew process the container starts.
+type goroutineLauncher struct {
+	runner *shared.HookRunner
+	done   chan struct{}
+}
+
+func (l *goroutineLauncher) LaunchHook(taskID int, hook domain.Hook, command, dir string) error {
+	go func() {
+		l.runner.Exec(taskID, hook, command, dir)
+		close(l.done)
+	}()
+	return nil
+}
+
+func TestRepository_Save_DoesNotWaitForHooks(t *testing.T) {
+	// Setup: a hook that runs until released
+	tasks := testutil.NewMockTaskRepository()
+	tasks.Tasks[1] = &domain.Task{ID: 1, Status: domain.StatusInProgress}
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config.Hooks = domain.HooksConfig{OnError: "sleep 600"}
+	release := make(chan struct{})
+	executor := testutil.NewMockCommandExecutor()
+	executor.OnExecuteWithContext = func() { <-release }
+	runner := shared.NewHookRunner(configLoader, nil, executor, testutil.NewMockLogger(), "/repo", "/repo/.git")
+	launcher := &goroutineLauncher{runner: runner, done: make(chan struct{})}
+	repo := NewRepository(tasks, testutil.NewMockEventLog(), &testutil.MockClock{}, testutil.NewMockLogger(), "worker").
+		WithHandler(runner.WithLauncher(launcher))
+
+	// Execute
+	saved := make(chan error, 1)
+	go func() {
+		saved <- repo.Save(&domain.Task{ID: 1, Status: domain.StatusError})
+	}()
+
+	// Assert: Save returns while the hook is still running
+	select {
+	case err := <-saved:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Save waited for the hook")
+	}
+	select {
+	case <-launcher.done:
+		t.Fatal("hook finished before it was released")
+	default:
+	}
+
+	close(release)
+	<-launcher.done
+	assert.Equal(t, []string{"-c", "sleep 600"}, executor.ExecutedCmd.Args)
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"syscall"
 
 	"github.com/runoshun/git-crew/v2/internal/domain"
 )
@@ -55,3 +56,19 @@ func (c *Client) ExecuteWithContext(ctx context.Context, cmd *domain.ExecCommand
 	execCmd.Stderr = stderr
 	return execCmd.Run()
 }
+
+// StartDetached starts a command in a new session and returns without waiting
+// for it, so it keeps running after the caller (e.g., a tmux pane) goes away.
+// Its output is discarded.
+func StartDetached(cmd *domain.ExecCommand) error {
+	// #nosec G204 - cmd.Program and cmd.Args come from trusted UseCase code
+	execCmd := exec.Command(cmd.Program, cmd.Args...)
+	if cmd.Dir != "" {
+		execCmd.Dir = cmd.Dir
+	}
+	execCmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	if err := execCmd.Start(); err != nil {
+		return err
+	}
+	return execCmd.Process.Release()
+}
@@ -0,0 +1,427 @@
+package filestore
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// Snapshots are gzipped tarballs of the namespace directory stored under
+// .crew/snapshots/<namespace>/<mainSHA>_<seq>.tar.gz. The snapshot ref is the
+// file name without extension (e.g., 1a2b3c..._001). The "current" file records
+// the ref of the snapshot the task state was last saved to or restored from.
+const (
+	snapshotExt         = ".tar.gz"
+	currentSnapshotFile = "current"
+	namespaceMetaName   = "meta.json"
+)
+
+// === Snapshot operations ===
+
+// SaveSnapshot saves the current task state as a snapshot associated with mainSHA.
+func (s *Store) SaveSnapshot(mainSHA string) error {
+	if !isValidSnapshotSHA(mainSHA) {
+		return fmt.Errorf("invalid snapshot SHA: %q", mainSHA)
+	}
+
+	return s.withLockWrite(func() error {
+		if err := s.ensureInitialized(); err != nil {
+			return err
+		}
+
+		// Find next sequence number for this mainSHA
+		seq := 1
+		snapshots, err := s.listSnapshotsLocked(mainSHA)
+		if err != nil {
+			return err
+		}
+		if len(snapshots) > 0 {
+			seq = snapshots[len(snapshots)-1].Seq + 1
+		}
+
+		data, err := s.archiveNamespace()
+		if err != nil {
+			return err
+		}
+
+		if err := os.MkdirAll(s.snapshotDir(), 0o750); err != nil {
+			return fmt.Errorf("create snapshot dir: %w", err)
+		}
+		ref := snapshotRef(mainSHA, seq)
+		if err := writeAtomic(s.snapshotPath(ref), data, 0o644); err != nil {
+			return fmt.Errorf("write snapshot %s: %w", ref, err)
+		}
+
+		return s.writeCurrentSnapshot(ref)
+	})
+}
+
+// RestoreSnapshot replaces the task state with the contents of a snapshot.
+// The namespace next_id never moves backwards so that task IDs (and their
+// branches) are not reused after a restore.
+func (s *Store) RestoreSnapshot(ref string) error {
+	return s.withLockWrite(func() error {
+		return s.restoreSnapshotLocked(ref)
+	})
+}
+
+// restoreSnapshotLocked restores from a snapshot without acquiring the lock.
+func (s *Store) restoreSnapshotLocked(ref string) error {
+	if _, _, ok := parseSnapshotRef(ref); !ok {
+		return fmt.Errorf("%w: %s", domain.ErrSnapshotNotFound, ref)
+	}
+
+	files, err := s.readSnapshotArchive(ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := files[namespaceMetaName]; !ok {
+		return fmt.Errorf("snapshot %s: missing %s", ref, namespaceMetaName)
+	}
+
+	// Remember the current next_id before it is overwritten
+	currentMeta, currentMetaErr := s.readNamespaceMeta()
+
+	if err := os.MkdirAll(s.namespaceDir(), 0o750); err != nil {
+		return fmt.Errorf("create namespace dir: %w", err)
+	}
+
+	// Write snapshot files first, then remove files that are not in the snapshot,
+	// so that an interrupted restore never loses tasks.
+	for name, content := range files {
+		if err := writeAtomic(filepath.Join(s.namespaceDir(), name), content, 0o644); err != nil {
+			return fmt.Errorf("restore %s: %w", name, err)
+		}
+	}
+	names, err := s.namespaceFiles()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if _, ok := files[name]; ok {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.namespaceDir(), name)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove %s: %w", name, err)
+		}
+	}
+
+	if currentMetaErr == nil {
+		restoredMeta, err := s.readNamespaceMeta()
+		if err != nil {
+			return err
+		}
+		if restoredMeta.NextID < currentMeta.NextID {
+			restoredMeta.NextID = currentMeta.NextID
+			if err := s.writeNamespaceMeta(restoredMeta); err != nil {
+				return err
+			}
+		}
+	}
+
+	return s.writeCurrentSnapshot(ref)
+}
+
+// ListSnapshots returns all snapshots for a given main SHA, sorted by SHA and sequence.
+// If mainSHA is empty, returns all snapshots.
+func (s *Store) ListSnapshots(mainSHA string) ([]domain.SnapshotInfo, error) {
+	var snapshots []domain.SnapshotInfo
+	err := s.withLock(func() error {
+		var err error
+		snapshots, err = s.listSnapshotsLocked(mainSHA)
+		return err
+	})
+	return snapshots, err
+}
+
+// listSnapshotsLocked lists snapshots without locking.
+func (s *Store) listSnapshotsLocked(mainSHA string) ([]domain.SnapshotInfo, error) {
+	entries, err := os.ReadDir(s.snapshotDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read snapshot dir: %w", err)
+	}
+
+	var snapshots []domain.SnapshotInfo
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, snapshotExt) {
+			continue
+		}
+		ref := strings.TrimSuffix(name, snapshotExt)
+		sha, seq, ok := parseSnapshotRef(ref)
+		if !ok {
+			continue
+		}
+		if mainSHA != "" && sha != mainSHA {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("stat snapshot %s: %w", ref, err)
+		}
+		snapshots = append(snapshots, domain.SnapshotInfo{
+			Ref:       ref,
+			MainSHA:   sha,
+			Seq:       seq,
+			CreatedAt: info.ModTime(),
+		})
+	}
+
+	slices.SortFunc(snapshots, func(a, b domain.SnapshotInfo) int {
+		if a.MainSHA != b.MainSHA {
+			return strings.Compare(a.MainSHA, b.MainSHA)
+		}
+		return a.Seq - b.Seq
+	})
+
+	return snapshots, nil
+}
+
+// SyncSnapshot syncs task state with the current git HEAD.
+// If a snapshot exists for HEAD and it is not the current one, the latest is restored.
+func (s *Store) SyncSnapshot() error {
+	headSHA, err := s.headSHA()
+	if err != nil {
+		return err
+	}
+
+	return s.withLockWrite(func() error {
+		snapshots, err := s.listSnapshotsLocked(headSHA)
+		if err != nil {
+			return err
+		}
+		if len(snapshots) == 0 {
+			// No snapshot for current HEAD, nothing to do
+			return nil
+		}
+
+		latest := snapshots[len(snapshots)-1]
+		current, err := s.readCurrentSnapshot()
+		if err != nil {
+			return err
+		}
+		if current == latest.Ref {
+			// Already synced
+			return nil
+		}
+		return s.restoreSnapshotLocked(latest.Ref)
+	})
+}
+
+// PruneSnapshots removes old snapshots, keeping the most recent keepCount per mainSHA.
+func (s *Store) PruneSnapshots(keepCount int) error {
+	if keepCount < 0 {
+		keepCount = 0
+	}
+
+	return s.withLockWrite(func() error {
+		snapshots, err := s.listSnapshotsLocked("")
+		if err != nil {
+			return err
+		}
+
+		// Group by mainSHA (snapshots are sorted by seq within each group)
+		byMainSHA := make(map[string][]domain.SnapshotInfo)
+		for _, snap := range snapshots {
+			byMainSHA[snap.MainSHA] = append(byMainSHA[snap.MainSHA], snap)
+		}
+
+		for _, snaps := range byMainSHA {
+			if len(snaps) <= keepCount {
+				continue
+			}
+			for _, snap := range snaps[:len(snaps)-keepCount] {
+				if err := os.Remove(s.snapshotPath(snap.Ref)); err != nil && !os.IsNotExist(err) {
+					return fmt.Errorf("remove snapshot %s: %w", snap.Ref, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Store) snapshotDir() string {
+	return filepath.Join(filepath.Dir(s.rootDir), "snapshots", s.namespace)
+}
+
+func (s *Store) snapshotPath(ref string) string {
+	return filepath.Join(s.snapshotDir(), ref+snapshotExt)
+}
+
+func (s *Store) readCurrentSnapshot() (string, error) {
+	content, err := os.ReadFile(filepath.Join(s.snapshotDir(), currentSnapshotFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("read current snapshot: %w", err)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+func (s *Store) writeCurrentSnapshot(ref string) error {
+	if err := os.MkdirAll(s.snapshotDir(), 0o750); err != nil {
+		return fmt.Errorf("create snapshot dir: %w", err)
+	}
+	return writeAtomic(filepath.Join(s.snapshotDir(), currentSnapshotFile), []byte(ref+"\n"), 0o644)
+}
+
+// headSHA resolves HEAD of the repository that contains the .crew directory.
+func (s *Store) headSHA() (string, error) {
+	out, err := s.git("rev-parse", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("get HEAD: %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// namespaceFiles returns the names of the task and metadata files in the namespace directory.
+func (s *Store) namespaceFiles() ([]string, error) {
+	entries, err := os.ReadDir(s.namespaceDir())
+	if err != nil {
+		return nil, fmt.Errorf("read namespace dir: %w", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && isSnapshotFile(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+// archiveNamespace returns a gzipped tarball of the namespace files.
+func (s *Store) archiveNamespace() ([]byte, error) {
+	names, err := s.namespaceFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, name := range names {
+		path := filepath.Join(s.namespaceDir(), name)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", name, err)
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("stat %s: %w", name, err)
+		}
+		header := &tar.Header{
+			Name:    name,
+			Mode:    0o644,
+			Size:    int64(len(content)),
+			ModTime: info.ModTime(),
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, fmt.Errorf("write snapshot header %s: %w", name, err)
+		}
+		if _, err := tw.Write(content); err != nil {
+			return nil, fmt.Errorf("write snapshot entry %s: %w", name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("close snapshot archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("close snapshot archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// readSnapshotArchive reads the files of a snapshot keyed by file name.
+func (s *Store) readSnapshotArchive(ref string) (map[string][]byte, error) {
+	file, err := os.Open(s.snapshotPath(ref))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrSnapshotNotFound, ref)
+		}
+		return nil, fmt.Errorf("open snapshot %s: %w", ref, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot %s: %w", ref, err)
+	}
+	defer func() { _ = gz.Close() }()
+
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read snapshot %s: %w", ref, err)
+		}
+		// Only accept plain task files; never write outside the namespace directory
+		if header.Typeflag != tar.TypeReg || filepath.Base(header.Name) != header.Name || !isSnapshotFile(header.Name) {
+			return nil, fmt.Errorf("snapshot %s: unexpected entry %q", ref, header.Name)
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("read snapshot %s: %w", ref, err)
+		}
+		files[header.Name] = content
+	}
+	return files, nil
+}
+
+// isSnapshotFile reports whether a namespace file is part of the task state.
+// Lock and temporary files are excluded.
+func isSnapshotFile(name string) bool {
+	if strings.HasPrefix(name, ".") {
+		return false
+	}
+	return name == namespaceMetaName || strings.HasSuffix(name, ".md") || strings.HasSuffix(name, ".meta.json")
+}
+
+func snapshotRef(mainSHA string, seq int) string {
+	return fmt.Sprintf("%s_%03d", mainSHA, seq)
+}
+
+// parseSnapshotRef splits a snapshot ref of the form <mainSHA>_<seq>.
+func parseSnapshotRef(ref string) (string, int, bool) {
+	idx := strings.LastIndex(ref, "_")
+	if idx < 0 {
+		return "", 0, false
+	}
+	sha := ref[:idx]
+	seq, err := strconv.Atoi(ref[idx+1:])
+	if err != nil || seq <= 0 || !isValidSnapshotSHA(sha) {
+		return "", 0, false
+	}
+	return sha, seq, true
+}
+
+// isValidSnapshotSHA reports whether s is usable as the SHA part of a snapshot file name.
+func isValidSnapshotSHA(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
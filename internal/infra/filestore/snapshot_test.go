@@ -0,0 +1,191 @@
+package filestore
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSHA = "0123456789abcdef0123456789abcdef01234567"
+
+func newSnapshotTestStore(t *testing.T) (*Store, string) {
+	t.Helper()
+
+	crewDir := filepath.Join(t.TempDir(), ".crew")
+	store := New(crewDir, "default")
+	_, err := store.Initialize()
+	require.NoError(t, err)
+	return store, crewDir
+}
+
+func saveSnapshotTestTask(t *testing.T, store *Store, id int, title string) {
+	t.Helper()
+
+	require.NoError(t, store.Save(&domain.Task{
+		ID:            id,
+		Title:         title,
+		Status:        domain.StatusTodo,
+		Created:       time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC),
+		BaseBranch:    "main",
+		StatusVersion: domain.StatusVersionCurrent,
+	}))
+	nextID, err := store.NextID()
+	require.NoError(t, err)
+	require.Equal(t, id, nextID)
+}
+
+func TestStore_SaveSnapshot_List(t *testing.T) {
+	store, crewDir := newSnapshotTestStore(t)
+	saveSnapshotTestTask(t, store, 1, "First")
+
+	require.NoError(t, store.SaveSnapshot(testSHA))
+	require.NoError(t, store.SaveSnapshot(testSHA))
+	require.NoError(t, store.SaveSnapshot("fedcba"))
+
+	snapshots, err := store.ListSnapshots("")
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	assert.Equal(t, testSHA+"_001", snapshots[0].Ref)
+	assert.Equal(t, testSHA+"_002", snapshots[1].Ref)
+	assert.Equal(t, 2, snapshots[1].Seq)
+	assert.Equal(t, "fedcba_001", snapshots[2].Ref)
+	assert.False(t, snapshots[0].CreatedAt.IsZero())
+
+	filtered, err := store.ListSnapshots("fedcba")
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "fedcba", filtered[0].MainSHA)
+
+	assert.FileExists(t, filepath.Join(crewDir, "snapshots", "default", testSHA+"_001.tar.gz"))
+	current, err := store.readCurrentSnapshot()
+	require.NoError(t, err)
+	assert.Equal(t, "fedcba_001", current)
+}
+
+func TestStore_SaveSnapshot_InvalidSHA(t *testing.T) {
+	store, _ := newSnapshotTestStore(t)
+
+	assert.Error(t, store.SaveSnapshot(""))
+	assert.Error(t, store.SaveSnapshot("../escape"))
+}
+
+func TestStore_ListSnapshots_Empty(t *testing.T) {
+	store, _ := newSnapshotTestStore(t)
+
+	snapshots, err := store.ListSnapshots("")
+
+	require.NoError(t, err)
+	assert.Empty(t, snapshots)
+}
+
+func TestStore_RestoreSnapshot(t *testing.T) {
+	store, _ := newSnapshotTestStore(t)
+	saveSnapshotTestTask(t, store, 1, "First")
+	require.NoError(t, store.AddComment(1, domain.Comment{Text: "keep me", Author: "worker", Time: time.Date(2026, 1, 18, 11, 0, 0, 0, time.UTC)}))
+	require.NoError(t, store.SaveSnapshot(testSHA))
+
+	// Bad bulk edit: rename, delete comments, add another task
+	task, err := store.Get(1)
+	require.NoError(t, err)
+	task.Title = "Broken"
+	require.NoError(t, store.SaveTaskWithComments(task, nil))
+	saveSnapshotTestTask(t, store, 2, "Second")
+
+	require.NoError(t, store.RestoreSnapshot(testSHA+"_001"))
+
+	tasks, err := store.List(domain.TaskFilter{})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "First", tasks[0].Title)
+	comments, err := store.GetComments(1)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	assert.Equal(t, "keep me", comments[0].Text)
+
+	// next_id does not move backwards, so task #2 is not reused
+	nextID, err := store.NextID()
+	require.NoError(t, err)
+	assert.Equal(t, 3, nextID)
+}
+
+func TestStore_RestoreSnapshot_NotFound(t *testing.T) {
+	store, _ := newSnapshotTestStore(t)
+
+	err := store.RestoreSnapshot(testSHA + "_001")
+	require.ErrorIs(t, err, domain.ErrSnapshotNotFound)
+
+	err = store.RestoreSnapshot("../../etc/passwd")
+	require.ErrorIs(t, err, domain.ErrSnapshotNotFound)
+}
+
+func TestStore_PruneSnapshots(t *testing.T) {
+	store, _ := newSnapshotTestStore(t)
+	saveSnapshotTestTask(t, store, 1, "First")
+	for range 3 {
+		require.NoError(t, store.SaveSnapshot(testSHA))
+	}
+	require.NoError(t, store.SaveSnapshot("fedcba"))
+
+	require.NoError(t, store.PruneSnapshots(1))
+
+	snapshots, err := store.ListSnapshots("")
+	require.NoError(t, err)
+	refs := make([]string, 0, len(snapshots))
+	for _, snap := range snapshots {
+		refs = append(refs, snap.Ref)
+	}
+	assert.Equal(t, []string{testSHA + "_003", "fedcba_001"}, refs)
+}
+
+func TestStore_SyncSnapshot(t *testing.T) {
+	repoDir := t.TempDir()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@example.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git %v: %s", args, out)
+	}
+	out, err := exec.Command("git", "-C", repoDir, "rev-parse", "HEAD").Output()
+	require.NoError(t, err)
+	head := strings.TrimSpace(string(out))
+
+	store := New(filepath.Join(repoDir, ".crew"), "default")
+	_, err = store.Initialize()
+	require.NoError(t, err)
+	saveSnapshotTestTask(t, store, 1, "First")
+	require.NoError(t, store.SaveSnapshot(head))
+	require.NoError(t, store.SaveSnapshot("fedcba"))
+	saveSnapshotTestTask(t, store, 2, "Second")
+
+	require.NoError(t, store.SyncSnapshot())
+
+	tasks, err := store.List(domain.TaskFilter{})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	current, err := store.readCurrentSnapshot()
+	require.NoError(t, err)
+	assert.Equal(t, head+"_001", current)
+}
+
+func TestStore_ReadSnapshotArchive_RejectsUnexpectedEntries(t *testing.T) {
+	store, _ := newSnapshotTestStore(t)
+	require.NoError(t, os.MkdirAll(store.snapshotDir(), 0o750))
+	require.NoError(t, os.WriteFile(store.snapshotPath(testSHA+"_001"), []byte("not a tarball"), 0o644))
+
+	err := store.RestoreSnapshot(testSHA + "_001")
+
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, domain.ErrSnapshotNotFound)
+}
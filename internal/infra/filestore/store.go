@@ -340,50 +340,6 @@ func (s *Store) Initialize() (bool, error) {
 	return repaired, nil
 }
 
-// === Snapshot operations (no-op for file store) ===
-
-// SaveSnapshot is a no-op for file store.
-func (s *Store) SaveSnapshot(mainSHA string) error {
-	return nil
-}
-
-// RestoreSnapshot is a no-op for file store.
-func (s *Store) RestoreSnapshot(snapshotRef string) error {
-	return nil
-}
-
-// ListSnapshots returns empty for file store.
-func (s *Store) ListSnapshots(mainSHA string) ([]domain.SnapshotInfo, error) {
-	return nil, nil
-}
-
-// SyncSnapshot is a no-op for file store.
-func (s *Store) SyncSnapshot() error {
-	return nil
-}
-
-// PruneSnapshots is a no-op for file store.
-func (s *Store) PruneSnapshots(keepCount int) error {
-	return nil
-}
-
-// === Remote sync operations (no-op for file store) ===
-
-// Push is a no-op for file store.
-func (s *Store) Push() error {
-	return nil
-}
-
-// Fetch is a no-op for file store.
-func (s *Store) Fetch(namespace string) error {
-	return nil
-}
-
-// ListNamespaces returns empty for file store.
-func (s *Store) ListNamespaces() ([]string, error) {
-	return nil, nil
-}
-
 // Ensure Store implements TaskRepository.
 var _ domain.TaskRepository = (*Store)(nil)
 
@@ -414,13 +370,18 @@ type taskMetaPayload struct {
 
 	Agent       string `json:"agent,omitempty"`
 	Session     string `json:"session,omitempty"`
+	Substate    string `json:"execution_substate,omitempty"`
+	Stalled     string `json:"stalled,omitempty"`
 	CloseReason string `json:"close_reason,omitempty"`
 	BlockReason string `json:"block_reason,omitempty"`
 
+	DependsOn []int `json:"depends_on,omitempty"`
+
 	Issue             int `json:"issue,omitempty"`
 	PR                int `json:"pr,omitempty"`
 	ReviewCount       int `json:"review_count,omitempty"`
 	AutoFixRetryCount int `json:"auto_fix_retry_count,omitempty"`
+	RestartCount      int `json:"restart_count,omitempty"`
 }
 
 type taskMeta struct {
@@ -429,17 +390,22 @@ type taskMeta struct {
 	LastReviewAt time.Time
 
 	Status      domain.Status
+	Substate    domain.ExecutionSubstate
+	Stalled     domain.StallReason
 	CloseReason domain.CloseReason
 	Agent       string
 	Session     string
 	BaseBranch  string
 	BlockReason string
 
+	DependsOn []int
+
 	Schema              int
 	Issue               int
 	PR                  int
 	ReviewCount         int
 	AutoFixRetryCount   int
+	RestartCount        int
 	StatusVersion       int
 	LastReviewIsLGTM    bool
 	LastReviewIsLGTMSet bool
@@ -657,13 +623,17 @@ func (s *Store) readTask(id int) (*domain.Task, []domain.Comment, error) {
 		Session:           meta.Session,
 		BaseBranch:        meta.BaseBranch,
 		Status:            meta.Status,
+		ExecutionSubstate: meta.Substate,
+		Stalled:           meta.Stalled,
 		CloseReason:       meta.CloseReason,
 		BlockReason:       meta.BlockReason,
+		DependsOn:         meta.DependsOn,
 		Issue:             meta.Issue,
 		PR:                meta.PR,
 		ReviewCount:       meta.ReviewCount,
 		LastReviewIsLGTM:  lastReviewIsLGTM,
 		AutoFixRetryCount: meta.AutoFixRetryCount,
+		RestartCount:      meta.RestartCount,
 		StatusVersion:     meta.StatusVersion,
 	}
 
@@ -715,6 +685,14 @@ func parseTaskMeta(content []byte) (taskMeta, error) {
 	if closeReason != domain.CloseReasonNone && closeReason != domain.CloseReasonMerged && closeReason != domain.CloseReasonAbandoned {
 		return taskMeta{}, fmt.Errorf("invalid close_reason: %s", payload.CloseReason)
 	}
+	substate := domain.ExecutionSubstate(payload.Substate)
+	if substate != "" && !substate.IsValid() {
+		return taskMeta{}, fmt.Errorf("invalid execution_substate: %s", payload.Substate)
+	}
+	stalled := domain.StallReason(payload.Stalled)
+	if stalled != "" && !stalled.IsValid() {
+		return taskMeta{}, fmt.Errorf("invalid stalled: %s", payload.Stalled)
+	}
 	if *payload.StatusVersion < 0 {
 		return taskMeta{}, errors.New("status_version must be non-negative")
 	}
@@ -734,12 +712,16 @@ func parseTaskMeta(content []byte) (taskMeta, error) {
 		Agent:               payload.Agent,
 		Session:             payload.Session,
 		BaseBranch:          *payload.BaseBranch,
+		Substate:            substate,
+		Stalled:             stalled,
 		CloseReason:         closeReason,
 		BlockReason:         payload.BlockReason,
+		DependsOn:           domain.NormalizeDependencies(payload.DependsOn),
 		Issue:               payload.Issue,
 		PR:                  payload.PR,
 		ReviewCount:         payload.ReviewCount,
 		AutoFixRetryCount:   payload.AutoFixRetryCount,
+		RestartCount:        payload.RestartCount,
 		StatusVersion:       *payload.StatusVersion,
 		LastReviewIsLGTM:    lastReviewIsLGTM,
 		LastReviewIsLGTMSet: lastReviewIsLGTMSet,
@@ -765,9 +747,12 @@ func (s *Store) writeTask(task *domain.Task, comments []domain.Comment) error {
 		Created:       strPtr(task.Created.Format(time.RFC3339)),
 		Agent:         task.Agent,
 		Session:       task.Session,
+		Substate:      string(task.ExecutionSubstate),
+		Stalled:       string(task.Stalled),
 		BaseBranch:    strPtr(task.BaseBranch),
 		CloseReason:   string(task.CloseReason),
 		BlockReason:   task.BlockReason,
+		DependsOn:     domain.NormalizeDependencies(task.DependsOn),
 		Issue:         task.Issue,
 		PR:            task.PR,
 		StatusVersion: intPtr(task.StatusVersion),
@@ -787,6 +772,9 @@ func (s *Store) writeTask(task *domain.Task, comments []domain.Comment) error {
 	if task.AutoFixRetryCount != 0 {
 		metaPayload.AutoFixRetryCount = task.AutoFixRetryCount
 	}
+	if task.RestartCount != 0 {
+		metaPayload.RestartCount = task.RestartCount
+	}
 
 	metaContent, err := json.MarshalIndent(metaPayload, "", "  ")
 	if err != nil {
@@ -1051,6 +1039,7 @@ func parseCommentBlock(block string) (indexedComment, error) {
 			Type:     header.Type,
 			Tags:     header.Tags,
 			Metadata: header.Metadata,
+			Findings: header.Findings,
 		},
 	}, nil
 }
@@ -1061,6 +1050,7 @@ type commentHeader struct {
 	Metadata map[string]string
 	Time     time.Time
 	Tags     []string
+	Findings []domain.ReviewFinding
 	Index    int
 }
 
@@ -1111,6 +1101,12 @@ func parseCommentHeader(lines []string) (commentHeader, int, error) {
 				return commentHeader{}, 0, err
 			}
 			header.Metadata = metadata
+		case strings.HasPrefix(line, "# Findings:"):
+			findings, err := parseCommentFindingsValue(line[11:])
+			if err != nil {
+				return commentHeader{}, 0, err
+			}
+			header.Findings = findings
 		case line == "":
 			return commentHeader{}, 0, domain.ErrInvalidCommentMeta
 		default:
@@ -1316,3 +1312,18 @@ func intPtr(v int) *int {
 func strPtr(v string) *string {
 	return &v
 }
+
+func parseCommentFindingsValue(value string) ([]domain.ReviewFinding, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return nil, nil
+	}
+	var findings []domain.ReviewFinding
+	if err := json.Unmarshal([]byte(trimmed), &findings); err != nil {
+		return nil, domain.ErrInvalidCommentMeta
+	}
+	if len(findings) == 0 {
+		return nil, nil
+	}
+	return findings, nil
+}
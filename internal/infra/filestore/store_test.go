@@ -59,16 +59,19 @@ func TestStore_Save_Get_Comments(t *testing.T) {
 	skipReview := true
 
 	task := &domain.Task{
-		ID:            1,
-		Title:         "Task",
-		Description:   "Body",
-		Labels:        []string{"bug", "urgent"},
-		ParentID:      &parentID,
-		SkipReview:    &skipReview,
-		Status:        domain.StatusTodo,
-		Created:       now,
-		BaseBranch:    "main",
-		StatusVersion: domain.StatusVersionCurrent,
+		ID:                1,
+		Title:             "Task",
+		Description:       "Body",
+		Labels:            []string{"bug", "urgent"},
+		ParentID:          &parentID,
+		SkipReview:        &skipReview,
+		Status:            domain.StatusTodo,
+		Created:           now,
+		BaseBranch:        "main",
+		StatusVersion:     domain.StatusVersionCurrent,
+		RestartCount:      2,
+		Stalled:           domain.StallPermission,
+		ExecutionSubstate: domain.SubstateAwaitingPermission,
 	}
 	require.NoError(t, store.Save(task))
 
@@ -82,6 +85,9 @@ func TestStore_Save_Get_Comments(t *testing.T) {
 	assert.Equal(t, parentID, *loaded.ParentID)
 	require.NotNil(t, loaded.SkipReview)
 	assert.True(t, *loaded.SkipReview)
+	assert.Equal(t, 2, loaded.RestartCount)
+	assert.Equal(t, domain.SubstateAwaitingPermission, loaded.ExecutionSubstate)
+	assert.Equal(t, domain.StallPermission, loaded.Stalled)
 
 	comment := domain.Comment{
 		Text:     "First",
@@ -118,6 +124,19 @@ func TestStore_Save_Get_Comments(t *testing.T) {
 	assert.Equal(t, domain.CommentTypeFriction, comments[0].Type)
 	assert.Equal(t, []string{"testing"}, comments[0].Tags)
 	assert.Equal(t, map[string]string{"priority": "high"}, comments[0].Metadata)
+
+	review := domain.Comment{
+		Text:     "❌ Needs changes",
+		Author:   domain.ReviewCommentAuthor,
+		Time:     now,
+		Findings: []domain.ReviewFinding{{File: "a.go", Line: 3, EndLine: 5, Severity: domain.ReviewSeverityBlocking, Message: "Fix, then test"}},
+	}
+	require.NoError(t, store.AddComment(1, review))
+
+	comments, err = store.GetComments(1)
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+	assert.Equal(t, review.Findings, comments[1].Findings)
 }
 
 func TestStore_StrictValidation(t *testing.T) {
@@ -384,3 +403,36 @@ func writeNamespaceMetaForTest(t *testing.T, path string, meta namespaceMetaFile
 	require.NoError(t, err)
 	require.NoError(t, os.WriteFile(path, content, 0o644))
 }
+
+func TestStore_DependsOn(t *testing.T) {
+	crewDir := filepath.Join(t.TempDir(), ".crew")
+	store := New(crewDir, "default")
+	_, err := store.Initialize()
+	require.NoError(t, err)
+
+	now := time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC)
+	task := &domain.Task{
+		ID:            3,
+		Title:         "Dependent",
+		Status:        domain.StatusTodo,
+		Created:       now,
+		BaseBranch:    "main",
+		DependsOn:     []int{2, 1, 2},
+		BlockReason:   "Depends on #1, #2",
+		StatusVersion: domain.StatusVersionCurrent,
+	}
+	require.NoError(t, store.Save(task))
+
+	loaded, err := store.Get(3)
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+	assert.Equal(t, []int{1, 2}, loaded.DependsOn)
+	assert.Equal(t, "Depends on #1, #2", loaded.BlockReason)
+
+	loaded.DependsOn = nil
+	require.NoError(t, store.Save(loaded))
+
+	metaContent, err := os.ReadFile(filepath.Join(crewDir, "tasks", "default", "3.meta.json"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(metaContent), "depends_on")
+}
@@ -0,0 +1,604 @@
+package filestore
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// Namespaces are shared by mirroring them into git refs:
+//
+//	refs/crew-sync/<namespace>         → commit of the last synced state (pushed to the remote)
+//	refs/crew-sync-remote/<namespace>  → commit fetched from the remote
+//
+// Each commit's tree holds the namespace files plus sync.json, which records
+// when each task was last modified. Fetching merges the remote state per task:
+// a change made on only one side wins, and when both sides changed the same
+// task the most recently modified version wins (last-writer-wins).
+const (
+	syncRemote          = "origin"
+	syncRefPrefix       = "refs/crew-sync/"
+	syncRemoteRefPrefix = "refs/crew-sync-remote/"
+	syncManifestName    = "sync.json"
+)
+
+// syncManifest records per-task modification times in a synced tree.
+type syncManifest struct {
+	Updated map[string]time.Time `json:"updated"` // Task ID → last modification time
+}
+
+// syncTask identifies the content of a task's files by blob hash.
+// A zero hash means the file does not exist.
+type syncTask struct {
+	Markdown plumbing.Hash
+	Meta     plumbing.Hash
+}
+
+// === Remote sync operations ===
+
+// Push publishes the namespace to the remote.
+// Namespaces pulled from teammates are pushed too, so edits to their tasks are shared.
+// Remote changes are merged into the local state before pushing.
+func (s *Store) Push() error {
+	namespaces, err := s.pushNamespaces()
+	if err != nil {
+		return err
+	}
+	crewDir := filepath.Dir(s.rootDir)
+	for _, namespace := range namespaces {
+		if err := New(crewDir, namespace).pushNamespace(); err != nil {
+			return fmt.Errorf("push namespace %s: %w", namespace, err)
+		}
+	}
+	return nil
+}
+
+// Fetch fetches a namespace from the remote and merges it into the local task state.
+// If namespace is empty, the store's namespace is fetched.
+func (s *Store) Fetch(namespace string) error {
+	if namespace == "" {
+		namespace = s.namespace
+	}
+	if domain.SanitizeNamespace(namespace) != namespace {
+		return fmt.Errorf("%w: %q", domain.ErrInvalidNamespace, namespace)
+	}
+
+	found, err := New(filepath.Dir(s.rootDir), namespace).fetchNamespace()
+	if err != nil {
+		return fmt.Errorf("fetch namespace %s: %w", namespace, err)
+	}
+	if !found {
+		return fmt.Errorf("%w: %s", domain.ErrRemoteNamespaceNotFound, namespace)
+	}
+	return nil
+}
+
+// ListNamespaces returns the namespaces available on the remote.
+func (s *Store) ListNamespaces() ([]string, error) {
+	out, err := s.git("ls-remote", "--refs", syncRemote, syncRefPrefix+"*")
+	if err != nil {
+		return nil, fmt.Errorf("list remote namespaces: %w", err)
+	}
+
+	var namespaces []string
+	for _, line := range strings.Split(string(out), "\n") {
+		// Format: <sha>\trefs/crew-sync/<namespace>
+		_, ref, ok := strings.Cut(strings.TrimSpace(line), "\t")
+		if !ok {
+			continue
+		}
+		namespace := strings.TrimPrefix(ref, syncRefPrefix)
+		if namespace == ref || namespace == "" {
+			continue
+		}
+		namespaces = append(namespaces, namespace)
+	}
+	slices.Sort(namespaces)
+	return namespaces, nil
+}
+
+// pushNamespaces returns the namespaces to push: this store's namespace and
+// every local namespace that has been synced before.
+func (s *Store) pushNamespaces() ([]string, error) {
+	namespaces := []string{s.namespace}
+
+	entries, err := os.ReadDir(s.rootDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, domain.ErrNotInitialized
+		}
+		return nil, fmt.Errorf("read tasks dir: %w", err)
+	}
+	repo, err := s.openRepo()
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		namespace := entry.Name()
+		if !entry.IsDir() || namespace == s.namespace || strings.HasPrefix(namespace, ".") {
+			continue
+		}
+		if _, err := repo.Reference(syncRef(namespace), true); err == nil {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces, nil
+}
+
+// pushNamespace merges remote changes, commits the local state and pushes it.
+func (s *Store) pushNamespace() error {
+	if err := s.ensureInitialized(); err != nil {
+		return err
+	}
+
+	remoteExists, err := s.fetchNamespace()
+	if err != nil {
+		return err
+	}
+
+	repo, err := s.openRepo()
+	if err != nil {
+		return err
+	}
+
+	var treeHash plumbing.Hash
+	err = s.withLock(func() error {
+		var buildErr error
+		treeHash, buildErr = s.buildSyncTree(repo)
+		return buildErr
+	})
+	if err != nil {
+		return err
+	}
+
+	// Reuse the last synced commit if nothing changed
+	var parents []plumbing.Hash
+	commitHash := plumbing.ZeroHash
+	if parent, parentErr := repo.Reference(syncRef(s.namespace), true); parentErr == nil {
+		parentCommit, err := repo.CommitObject(parent.Hash())
+		if err != nil {
+			return fmt.Errorf("read sync commit: %w", err)
+		}
+		if parentCommit.TreeHash == treeHash {
+			commitHash = parent.Hash()
+		}
+		parents = append(parents, parent.Hash())
+	}
+	if commitHash.IsZero() {
+		commitHash, err = writeSyncCommit(repo, treeHash, parents, "crew sync "+s.namespace)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Skip the push if the remote already has this commit
+	if remoteExists {
+		if remote, err := repo.Reference(syncRemoteRef(s.namespace), true); err == nil && remote.Hash() == commitHash {
+			return nil
+		}
+	}
+
+	// The sync ref is the merge base for the next fetch, so only move it once the push succeeded
+	if _, err := s.git("push", syncRemote, commitHash.String()+":"+string(syncRef(s.namespace))); err != nil {
+		return fmt.Errorf("push: %w", err)
+	}
+	for _, ref := range []plumbing.ReferenceName{syncRef(s.namespace), syncRemoteRef(s.namespace)} {
+		if err := repo.Storer.SetReference(plumbing.NewHashReference(ref, commitHash)); err != nil {
+			return fmt.Errorf("update sync ref: %w", err)
+		}
+	}
+	return nil
+}
+
+// fetchNamespace fetches the namespace from the remote and merges it into the local state.
+// Returns false if the namespace does not exist on the remote.
+func (s *Store) fetchNamespace() (bool, error) {
+	ref := string(syncRef(s.namespace))
+	out, err := s.git("ls-remote", "--refs", syncRemote, ref)
+	if err != nil {
+		return false, fmt.Errorf("ls-remote: %w", err)
+	}
+	if strings.TrimSpace(string(out)) == "" {
+		return false, nil
+	}
+	if _, err := s.git("fetch", syncRemote, "+"+ref+":"+string(syncRemoteRef(s.namespace))); err != nil {
+		return false, fmt.Errorf("fetch: %w", err)
+	}
+
+	repo, err := s.openRepo()
+	if err != nil {
+		return false, err
+	}
+	remoteRef, err := repo.Reference(syncRemoteRef(s.namespace), true)
+	if err != nil {
+		return false, fmt.Errorf("read fetched ref: %w", err)
+	}
+	remoteTree, err := commitTree(repo, remoteRef.Hash())
+	if err != nil {
+		return false, err
+	}
+
+	// The last synced state is the merge base
+	var baseTree *object.Tree
+	if baseRef, err := repo.Reference(syncRef(s.namespace), true); err == nil {
+		if baseRef.Hash() == remoteRef.Hash() {
+			return true, nil
+		}
+		baseTree, err = commitTree(repo, baseRef.Hash())
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = s.withLockWrite(func() error {
+		return s.mergeSyncTree(repo, baseTree, remoteTree)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(syncRef(s.namespace), remoteRef.Hash())); err != nil {
+		return false, fmt.Errorf("update sync ref: %w", err)
+	}
+	return true, nil
+}
+
+// buildSyncTree writes the namespace files and manifest as a tree object.
+func (s *Store) buildSyncTree(repo *git.Repository) (plumbing.Hash, error) {
+	names, err := s.namespaceFiles()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	manifest := syncManifest{Updated: make(map[string]time.Time)}
+	entries := make([]object.TreeEntry, 0, len(names)+1)
+	for _, name := range names {
+		path := filepath.Join(s.namespaceDir(), name)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("read %s: %w", name, err)
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("stat %s: %w", name, err)
+		}
+		if key, ok := syncTaskKey(name); ok {
+			if modTime := info.ModTime().UTC(); modTime.After(manifest.Updated[key]) {
+				manifest.Updated[key] = modTime
+			}
+		}
+
+		hash, err := writeSyncBlob(repo, content)
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+		entries = append(entries, object.TreeEntry{Name: name, Mode: filemode.Regular, Hash: hash})
+	}
+
+	manifestContent, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("marshal sync manifest: %w", err)
+	}
+	manifestHash, err := writeSyncBlob(repo, manifestContent)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	entries = append(entries, object.TreeEntry{Name: syncManifestName, Mode: filemode.Regular, Hash: manifestHash})
+
+	// Git requires tree entries sorted by name
+	slices.SortFunc(entries, func(a, b object.TreeEntry) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	tree := &object.Tree{Entries: entries}
+	obj := repo.Storer.NewEncodedObject()
+	if err := tree.Encode(obj); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("encode tree: %w", err)
+	}
+	hash, err := repo.Storer.SetEncodedObject(obj)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("store tree: %w", err)
+	}
+	return hash, nil
+}
+
+// mergeSyncTree merges the remote tree into the namespace directory using base
+// as the common ancestor. base may be nil if the namespace was never synced.
+func (s *Store) mergeSyncTree(repo *git.Repository, base, remote *object.Tree) error {
+	if err := os.MkdirAll(s.namespaceDir(), 0o750); err != nil {
+		return fmt.Errorf("create namespace dir: %w", err)
+	}
+
+	// Collect the task state on each side
+	local := make(map[string]syncTask)
+	localUpdated := make(map[string]time.Time)
+	names, err := s.namespaceFiles()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		key, ok := syncTaskKey(name)
+		if !ok {
+			continue
+		}
+		path := filepath.Join(s.namespaceDir(), name)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", name, err)
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("stat %s: %w", name, err)
+		}
+		local[key] = setSyncTaskFile(local[key], name, plumbing.ComputeHash(plumbing.BlobObject, content))
+		if modTime := info.ModTime(); modTime.After(localUpdated[key]) {
+			localUpdated[key] = modTime
+		}
+	}
+	baseTasks := syncTreeTasks(base)
+	remoteTasks := syncTreeTasks(remote)
+	manifest, err := readSyncManifest(remote)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]bool)
+	for _, tasks := range []map[string]syncTask{local, baseTasks, remoteTasks} {
+		for key := range tasks {
+			keys[key] = true
+		}
+	}
+	for key := range keys {
+		localTask, remoteTask := local[key], remoteTasks[key]
+		if localTask == remoteTask || remoteTask == baseTasks[key] {
+			// Same on both sides, or only changed locally
+			continue
+		}
+		if localTask != baseTasks[key] && !manifest.Updated[key].After(localUpdated[key]) {
+			// Both sides changed: the local version is newer
+			continue
+		}
+		if err := s.applySyncTask(repo, key, remoteTask, manifest.Updated[key]); err != nil {
+			return err
+		}
+	}
+
+	return s.mergeSyncMeta(repo, remote)
+}
+
+// applySyncTask replaces the local files of a task with the remote version.
+func (s *Store) applySyncTask(repo *git.Repository, key string, task syncTask, updated time.Time) error {
+	files := []struct {
+		name string
+		hash plumbing.Hash
+	}{
+		{key + ".md", task.Markdown},
+		{key + ".meta.json", task.Meta},
+	}
+	for _, file := range files {
+		path := filepath.Join(s.namespaceDir(), file.name)
+		if file.hash.IsZero() {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("remove %s: %w", file.name, err)
+			}
+			continue
+		}
+		content, err := readSyncBlob(repo, file.hash)
+		if err != nil {
+			return err
+		}
+		if err := writeAtomic(path, content, 0o644); err != nil {
+			return fmt.Errorf("write %s: %w", file.name, err)
+		}
+		// Keep the remote modification time so it is not mistaken for a local edit
+		if !updated.IsZero() {
+			if err := os.Chtimes(path, updated, updated); err != nil {
+				return fmt.Errorf("set mtime %s: %w", file.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// mergeSyncMeta merges the namespace metadata, keeping the highest next_id.
+func (s *Store) mergeSyncMeta(repo *git.Repository, remote *object.Tree) error {
+	entry, err := remote.FindEntry(namespaceMetaName)
+	if err != nil {
+		return nil
+	}
+	content, err := readSyncBlob(repo, entry.Hash)
+	if err != nil {
+		return err
+	}
+
+	localMeta, err := s.readNamespaceMeta()
+	if err != nil {
+		// Not initialized locally yet (e.g., first fetch of a teammate's namespace)
+		return writeAtomic(s.namespaceMetaPath(), content, 0o644)
+	}
+
+	var payload namespaceMetaPayload
+	if err := decodeJSONStrict(content, &payload); err != nil {
+		return fmt.Errorf("parse remote namespace meta: %w", err)
+	}
+	if payload.NextID != nil && *payload.NextID > localMeta.NextID {
+		localMeta.NextID = *payload.NextID
+		return s.writeNamespaceMeta(localMeta)
+	}
+	return nil
+}
+
+func (s *Store) repoRoot() string {
+	return filepath.Dir(filepath.Dir(s.rootDir))
+}
+
+func (s *Store) openRepo() (*git.Repository, error) {
+	repo, err := git.PlainOpen(s.repoRoot())
+	if err != nil {
+		return nil, fmt.Errorf("open git repository: %w", err)
+	}
+	return repo, nil
+}
+
+// git runs a git command in the repository and returns its stdout.
+func (s *Store) git(args ...string) ([]byte, error) {
+	cmd := exec.Command("git", append([]string{"-C", s.repoRoot()}, args...)...) //nolint:gosec // args are constructed from trusted namespace
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+func syncRef(namespace string) plumbing.ReferenceName {
+	return plumbing.ReferenceName(syncRefPrefix + namespace)
+}
+
+func syncRemoteRef(namespace string) plumbing.ReferenceName {
+	return plumbing.ReferenceName(syncRemoteRefPrefix + namespace)
+}
+
+// syncTaskKey returns the task ID part of a task file name.
+func syncTaskKey(name string) (string, bool) {
+	key, ok := strings.CutSuffix(name, ".meta.json")
+	if !ok {
+		key, ok = strings.CutSuffix(name, ".md")
+	}
+	if !ok {
+		return "", false
+	}
+	if id, err := strconv.Atoi(key); err != nil || id <= 0 {
+		return "", false
+	}
+	return key, true
+}
+
+func setSyncTaskFile(task syncTask, name string, hash plumbing.Hash) syncTask {
+	if strings.HasSuffix(name, ".md") {
+		task.Markdown = hash
+	} else {
+		task.Meta = hash
+	}
+	return task
+}
+
+// syncTreeTasks returns the tasks in a synced tree. A nil tree has no tasks.
+func syncTreeTasks(tree *object.Tree) map[string]syncTask {
+	tasks := make(map[string]syncTask)
+	if tree == nil {
+		return tasks
+	}
+	for _, entry := range tree.Entries {
+		if !isSnapshotFile(entry.Name) {
+			continue
+		}
+		if key, ok := syncTaskKey(entry.Name); ok {
+			tasks[key] = setSyncTaskFile(tasks[key], entry.Name, entry.Hash)
+		}
+	}
+	return tasks
+}
+
+func readSyncManifest(tree *object.Tree) (syncManifest, error) {
+	manifest := syncManifest{}
+	file, err := tree.File(syncManifestName)
+	if err != nil {
+		return manifest, nil
+	}
+	content, err := file.Contents()
+	if err != nil {
+		return manifest, fmt.Errorf("read sync manifest: %w", err)
+	}
+	if err := json.Unmarshal([]byte(content), &manifest); err != nil {
+		return manifest, fmt.Errorf("parse sync manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+func commitTree(repo *git.Repository, hash plumbing.Hash) (*object.Tree, error) {
+	commit, err := repo.CommitObject(hash)
+	if err != nil {
+		return nil, fmt.Errorf("read sync commit: %w", err)
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("read sync tree: %w", err)
+	}
+	return tree, nil
+}
+
+func writeSyncBlob(repo *git.Repository, content []byte) (plumbing.Hash, error) {
+	obj := repo.Storer.NewEncodedObject()
+	obj.SetType(plumbing.BlobObject)
+	obj.SetSize(int64(len(content)))
+
+	writer, err := obj.Writer()
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("create blob writer: %w", err)
+	}
+	if _, err := writer.Write(content); err != nil {
+		_ = writer.Close()
+		return plumbing.ZeroHash, fmt.Errorf("write blob: %w", err)
+	}
+	_ = writer.Close()
+
+	hash, err := repo.Storer.SetEncodedObject(obj)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("store blob: %w", err)
+	}
+	return hash, nil
+}
+
+func readSyncBlob(repo *git.Repository, hash plumbing.Hash) ([]byte, error) {
+	blob, err := repo.BlobObject(hash)
+	if err != nil {
+		return nil, fmt.Errorf("get blob: %w", err)
+	}
+	reader, err := blob.Reader()
+	if err != nil {
+		return nil, fmt.Errorf("read blob: %w", err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("read blob data: %w", err)
+	}
+	return content, nil
+}
+
+func writeSyncCommit(repo *git.Repository, tree plumbing.Hash, parents []plumbing.Hash, message string) (plumbing.Hash, error) {
+	signature := object.Signature{Name: "git-crew", Email: "git-crew@localhost", When: time.Now()}
+	commit := &object.Commit{
+		Author:       signature,
+		Committer:    signature,
+		Message:      message,
+		TreeHash:     tree,
+		ParentHashes: parents,
+	}
+	obj := repo.Storer.NewEncodedObject()
+	if err := commit.Encode(obj); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("encode commit: %w", err)
+	}
+	hash, err := repo.Storer.SetEncodedObject(obj)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("store commit: %w", err)
+	}
+	return hash, nil
+}
@@ -0,0 +1,227 @@
+package filestore
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupSyncRepos creates a bare remote and two clones, and returns the .crew
+// directory of each clone.
+func setupSyncRepos(t *testing.T) (string, string) {
+	t.Helper()
+
+	remoteDir := t.TempDir()
+	runSyncGit(t, remoteDir, "init", "--bare")
+
+	clone := func() string {
+		dir := t.TempDir()
+		runSyncGit(t, dir, "init")
+		runSyncGit(t, dir, "config", "user.email", "test@example.com")
+		runSyncGit(t, dir, "config", "user.name", "Test")
+		runSyncGit(t, dir, "remote", "add", "origin", remoteDir)
+		return filepath.Join(dir, ".crew")
+	}
+	return clone(), clone()
+}
+
+func runSyncGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %v: %s", args, out)
+}
+
+func newSyncTestStore(t *testing.T, crewDir, namespace string) *Store {
+	t.Helper()
+
+	store := New(crewDir, namespace)
+	_, err := store.Initialize()
+	require.NoError(t, err)
+	return store
+}
+
+func createSyncTestTask(t *testing.T, store *Store, title string) int {
+	t.Helper()
+
+	id, err := store.NextID()
+	require.NoError(t, err)
+	require.NoError(t, store.Save(&domain.Task{
+		ID:            id,
+		Title:         title,
+		Status:        domain.StatusTodo,
+		Created:       time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC),
+		BaseBranch:    "main",
+		StatusVersion: domain.StatusVersionCurrent,
+	}))
+	return id
+}
+
+func renameSyncTestTask(t *testing.T, store *Store, id int, title string, modTime time.Time) {
+	t.Helper()
+
+	task, err := store.Get(id)
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	task.Title = title
+	require.NoError(t, store.Save(task))
+	for _, path := range []string{store.taskMarkdownPath(id), store.taskMetaPath(id)} {
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+}
+
+func syncTestTitle(t *testing.T, store *Store, id int) string {
+	t.Helper()
+
+	task, err := store.Get(id)
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	return task.Title
+}
+
+func TestStore_PushFetch_SharesNamespace(t *testing.T) {
+	aliceDir, bobDir := setupSyncRepos(t)
+	alice := newSyncTestStore(t, aliceDir, "alice")
+	bob := newSyncTestStore(t, bobDir, "bob")
+	createSyncTestTask(t, alice, "Alice task")
+
+	require.NoError(t, alice.Push())
+	require.NoError(t, bob.Push())
+
+	namespaces, err := bob.ListNamespaces()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice", "bob"}, namespaces)
+
+	require.NoError(t, bob.Fetch("alice"))
+
+	tasks, err := bob.ListAll(domain.TaskFilter{})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "alice", tasks[0].Namespace)
+	assert.Equal(t, "Alice task", tasks[0].Title)
+}
+
+func TestStore_PushFetch_MergesDifferentTasks(t *testing.T) {
+	aliceDir, bobDir := setupSyncRepos(t)
+	alice := newSyncTestStore(t, aliceDir, "alice")
+	first := createSyncTestTask(t, alice, "First")
+	second := createSyncTestTask(t, alice, "Second")
+	require.NoError(t, alice.Push())
+
+	// Bob edits the first task of Alice's namespace and pushes it back
+	bob := newSyncTestStore(t, bobDir, "bob")
+	require.NoError(t, bob.Fetch("alice"))
+	bobAlice := New(bobDir, "alice")
+	renameSyncTestTask(t, bobAlice, first, "First (Bob)", time.Now())
+	require.NoError(t, bob.Push())
+
+	// Meanwhile Alice edits the second task; pushing merges Bob's change
+	renameSyncTestTask(t, alice, second, "Second (Alice)", time.Now())
+	require.NoError(t, alice.Push())
+	assert.Equal(t, "First (Bob)", syncTestTitle(t, alice, first))
+	assert.Equal(t, "Second (Alice)", syncTestTitle(t, alice, second))
+
+	require.NoError(t, bob.Fetch("alice"))
+	assert.Equal(t, "First (Bob)", syncTestTitle(t, bobAlice, first))
+	assert.Equal(t, "Second (Alice)", syncTestTitle(t, bobAlice, second))
+}
+
+func TestStore_Fetch_LastWriterWins(t *testing.T) {
+	aliceDir, bobDir := setupSyncRepos(t)
+	alice := newSyncTestStore(t, aliceDir, "alice")
+	id := createSyncTestTask(t, alice, "Original")
+	require.NoError(t, alice.Push())
+
+	bob := newSyncTestStore(t, bobDir, "bob")
+	require.NoError(t, bob.Fetch("alice"))
+	bobAlice := New(bobDir, "alice")
+
+	// Both edit the same task; Bob's edit is newer
+	now := time.Now()
+	renameSyncTestTask(t, alice, id, "Alice edit", now.Add(-time.Hour))
+	renameSyncTestTask(t, bobAlice, id, "Bob edit", now)
+	require.NoError(t, bob.Push())
+
+	require.NoError(t, alice.Fetch(""))
+	assert.Equal(t, "Bob edit", syncTestTitle(t, alice, id))
+}
+
+func TestStore_Fetch_KeepsNewerLocalEdit(t *testing.T) {
+	aliceDir, bobDir := setupSyncRepos(t)
+	alice := newSyncTestStore(t, aliceDir, "alice")
+	id := createSyncTestTask(t, alice, "Original")
+	require.NoError(t, alice.Push())
+
+	bob := newSyncTestStore(t, bobDir, "bob")
+	require.NoError(t, bob.Fetch("alice"))
+	bobAlice := New(bobDir, "alice")
+
+	// Both edit the same task; Alice's edit is newer
+	now := time.Now()
+	renameSyncTestTask(t, bobAlice, id, "Bob edit", now.Add(-time.Hour))
+	require.NoError(t, bob.Push())
+	renameSyncTestTask(t, alice, id, "Alice edit", now)
+
+	require.NoError(t, alice.Fetch(""))
+	assert.Equal(t, "Alice edit", syncTestTitle(t, alice, id))
+}
+
+func TestStore_Fetch_RemoteDeletion(t *testing.T) {
+	aliceDir, bobDir := setupSyncRepos(t)
+	alice := newSyncTestStore(t, aliceDir, "alice")
+	id := createSyncTestTask(t, alice, "Doomed")
+	require.NoError(t, alice.Push())
+
+	bob := newSyncTestStore(t, bobDir, "bob")
+	require.NoError(t, bob.Fetch("alice"))
+
+	require.NoError(t, alice.Delete(id))
+	require.NoError(t, alice.Push())
+	require.NoError(t, bob.Fetch("alice"))
+
+	task, err := New(bobDir, "alice").Get(id)
+	require.NoError(t, err)
+	assert.Nil(t, task)
+
+	// next_id is kept so the ID is not reused
+	nextID, err := New(bobDir, "alice").NextID()
+	require.NoError(t, err)
+	assert.Equal(t, id+1, nextID)
+}
+
+func TestStore_Fetch_NotFound(t *testing.T) {
+	aliceDir, _ := setupSyncRepos(t)
+	alice := newSyncTestStore(t, aliceDir, "alice")
+
+	err := alice.Fetch("nobody")
+	require.ErrorIs(t, err, domain.ErrRemoteNamespaceNotFound)
+
+	err = alice.Fetch("../escape")
+	require.ErrorIs(t, err, domain.ErrInvalidNamespace)
+}
+
+func TestStore_Push_NoChanges(t *testing.T) {
+	aliceDir, _ := setupSyncRepos(t)
+	alice := newSyncTestStore(t, aliceDir, "alice")
+	createSyncTestTask(t, alice, "Task")
+	require.NoError(t, alice.Push())
+
+	repo, err := alice.openRepo()
+	require.NoError(t, err)
+	before, err := repo.Reference(syncRef("alice"), true)
+	require.NoError(t, err)
+
+	require.NoError(t, alice.Push())
+
+	after, err := repo.Reference(syncRef("alice"), true)
+	require.NoError(t, err)
+	assert.Equal(t, before.Hash(), after.Hash())
+}
@@ -141,6 +141,22 @@ func (c *Client) GetMergeConflictFiles(branch, target string) ([]string, error)
 	return parseMergeTreeConflicts(string(out)), nil
 }
 
+// BranchDiff returns the changes made on branch since it diverged from base
+// (git diff base...branch), limited to paths when given.
+func (c *Client) BranchDiff(base, branch string, paths []string) (string, error) {
+	args := []string{"diff", base + "..." + branch}
+	if len(paths) > 0 {
+		args = append(append(args, "--"), paths...)
+	}
+	cmd := exec.Command("git", args...)
+	cmd.Dir = c.repoRoot
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to diff %s...%s: %w", base, branch, err)
+	}
+	return string(out), nil
+}
+
 // parseMergeTreeConflicts extracts conflicting file names from git merge-tree output.
 // Handles various conflict formats:
 //   - CONFLICT (content): Merge conflict in <file>
@@ -204,29 +220,144 @@ func parseMergeTreeConflicts(output string) []string {
 	return files
 }
 
-// Merge merges a branch into the current branch.
-// If noFF is true, a merge commit is always created (--no-ff).
-// On conflict, automatically runs git merge --abort to restore clean state.
-func (c *Client) Merge(branch string, noFF bool) error {
-	args := []string{"merge"}
-	if noFF {
-		args = append(args, "--no-ff")
+// Merge integrates a branch into the current branch using opts.Strategy.
+// On failure (including conflicts), the repository is restored to its previous state.
+func (c *Client) Merge(branch string, opts domain.MergeOptions) error {
+	switch opts.Strategy {
+	case domain.MergeStrategyMerge, "":
+		args := []string{"merge", "--no-ff"}
+		if opts.Message != "" {
+			args = append(args, "-m", opts.Message)
+		}
+		if out, err := c.run(c.repoRoot, append(args, branch)...); err != nil {
+			// Abort the merge to restore clean state
+			_, _ = c.run(c.repoRoot, "merge", "--abort") // Merge may have failed before creating merge state
+			return fmt.Errorf("failed to merge branch %s: %w: %s", branch, err, out)
+		}
+		return nil
+	case domain.MergeStrategySquash:
+		return c.squashMerge(branch, opts.Message)
+	case domain.MergeStrategyRebase:
+		return c.rebaseMerge(branch, opts.WorktreeDir)
+	case domain.MergeStrategyFFOnly:
+		if out, err := c.run(c.repoRoot, "merge", "--ff-only", branch); err != nil {
+			return fmt.Errorf("failed to fast-forward to branch %s (use the rebase strategy if the base branch has moved): %w: %s", branch, err, out)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown merge strategy: %q", opts.Strategy)
 	}
-	args = append(args, branch)
+}
 
-	cmd := exec.Command("git", args...)
-	cmd.Dir = c.repoRoot
-	if out, err := cmd.CombinedOutput(); err != nil {
-		// On merge failure (including conflicts), abort the merge to restore clean state
-		abortCmd := exec.Command("git", "merge", "--abort")
-		abortCmd.Dir = c.repoRoot
-		_ = abortCmd.Run() // Ignore abort errors - merge may have failed before creating merge state
+// squashMerge stages the changes of branch as a single commit on the current branch.
+func (c *Client) squashMerge(branch, message string) error {
+	if out, err := c.run(c.repoRoot, "merge", "--squash", branch); err != nil {
+		_, _ = c.run(c.repoRoot, "reset", "--merge")
+		return fmt.Errorf("failed to squash branch %s: %w: %s", branch, err, out)
+	}
+	// Exit code 0 means nothing is staged
+	if _, err := c.run(c.repoRoot, "diff", "--cached", "--quiet"); err == nil {
+		return fmt.Errorf("failed to squash branch %s: no changes to commit", branch)
+	}
+	if message == "" {
+		message = "Squashed branch " + branch
+	}
+	if out, err := c.run(c.repoRoot, "commit", "-m", message); err != nil {
+		_, _ = c.run(c.repoRoot, "reset", "--merge")
+		return fmt.Errorf("failed to commit squashed branch %s: %w: %s", branch, err, out)
+	}
+	return nil
+}
+
+// rebaseMerge rebases branch onto the current branch and fast-forwards to it.
+// The rebase runs in worktreeDir when the branch is checked out there.
+func (c *Client) rebaseMerge(branch, worktreeDir string) error {
+	out, err := c.run(c.repoRoot, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return fmt.Errorf("failed to get current branch: %w: %s", err, out)
+	}
+	base := strings.TrimSpace(out)
+
+	if worktreeDir != "" {
+		if out, err := c.run(worktreeDir, "rebase", base); err != nil {
+			_, _ = c.run(worktreeDir, "rebase", "--abort")
+			return fmt.Errorf("failed to rebase branch %s onto %s: %w: %s", branch, base, err, out)
+		}
+	} else {
+		// git rebase <base> <branch> checks out branch; always return to base
+		out, err := c.run(c.repoRoot, "rebase", base, branch)
+		if err != nil {
+			_, _ = c.run(c.repoRoot, "rebase", "--abort")
+		}
+		if _, checkoutErr := c.run(c.repoRoot, "checkout", base); checkoutErr != nil && err == nil {
+			return fmt.Errorf("failed to check out %s after rebase: %w", base, checkoutErr)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to rebase branch %s onto %s: %w: %s", branch, base, err, out)
+		}
+	}
 
-		return fmt.Errorf("failed to merge branch %s: %w: %s", branch, err, string(out))
+	if out, err := c.run(c.repoRoot, "merge", "--ff-only", branch); err != nil {
+		return fmt.Errorf("failed to fast-forward to branch %s: %w: %s", branch, err, out)
 	}
 	return nil
 }
 
+// UpdateBranch brings the branch checked out in dir up to date with base,
+// by rebasing onto it (MergeStrategyRebase) or merging it in (MergeStrategyMerge).
+// If conflicts occur, the operation is aborted so dir is left unchanged, and the
+// conflicting files are returned with domain.ErrMergeConflict.
+// For rebase, the files are those of the first commit that does not apply cleanly.
+func (c *Client) UpdateBranch(dir, base string, strategy domain.MergeStrategy) ([]string, error) {
+	var args, abort []string
+	switch strategy {
+	case domain.MergeStrategyRebase, "":
+		args, abort = []string{"rebase", base}, []string{"rebase", "--abort"}
+	case domain.MergeStrategyMerge:
+		args, abort = []string{"merge", "--no-edit", base}, []string{"merge", "--abort"}
+	default:
+		return nil, fmt.Errorf("unsupported update strategy: %q", strategy)
+	}
+	return c.runUpdate(dir, base, args, abort)
+}
+
+// RebaseOnto replays the commits of the branch checked out in dir that are not
+// in upstream onto the onto branch (git rebase --onto onto upstream).
+// Conflicts are handled as in UpdateBranch.
+func (c *Client) RebaseOnto(dir, onto, upstream string) ([]string, error) {
+	return c.runUpdate(dir, onto, []string{"rebase", "--onto", onto, upstream}, []string{"rebase", "--abort"})
+}
+
+// runUpdate runs a rebase or merge in dir, aborting it and collecting the
+// conflicting files if it fails.
+func (c *Client) runUpdate(dir, base string, args, abort []string) ([]string, error) {
+	out, err := c.run(dir, args...)
+	if err == nil {
+		return nil, nil
+	}
+
+	unmerged, _ := c.run(dir, "diff", "--name-only", "--diff-filter=U")
+	_, _ = c.run(dir, abort...) // The command may have failed before starting
+	var conflicts []string
+	for _, line := range strings.Split(unmerged, "\n") {
+		if file := strings.TrimSpace(line); file != "" {
+			conflicts = append(conflicts, file)
+		}
+	}
+	if len(conflicts) > 0 {
+		return conflicts, domain.ErrMergeConflict
+	}
+	return nil, fmt.Errorf("failed to update branch onto %s: %w: %s", base, err, out)
+}
+
+// run runs a git command in dir and returns its combined output.
+func (c *Client) run(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
+
 // DeleteBranch deletes a branch.
 // If force is true, it uses -D (force delete), otherwise -d.
 func (c *Client) DeleteBranch(branch string, force bool) error {
@@ -292,6 +423,18 @@ func (c *Client) GetDefaultBranch() (string, error) {
 	return "main", nil
 }
 
+// RevParse resolves a revision (branch, tag, or SHA) to a full commit SHA.
+func (c *Client) RevParse(rev string) (string, error) {
+	//nolint:gosec // rev is used as argument, not shell command
+	cmd := exec.Command("git", "rev-parse", "--verify", "--quiet", rev+"^{commit}")
+	cmd.Dir = c.repoRoot
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve revision %s: %w", rev, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
 // Ensure Client implements domain.Git interface.
 var _ domain.Git = (*Client)(nil)
 
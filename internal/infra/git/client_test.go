@@ -173,7 +173,7 @@ func TestClient_Merge_Success(t *testing.T) {
 	client, err := NewClient(dir)
 	require.NoError(t, err)
 
-	err = client.Merge("feature", false)
+	err = client.Merge("feature", domain.MergeOptions{})
 	require.NoError(t, err)
 
 	// Verify merge was successful
@@ -209,7 +209,7 @@ func TestClient_Merge_Conflict_AutoAbort(t *testing.T) {
 	runGit(t, dir, "checkout", mainBranch)
 
 	// Attempt to merge feature into main (will conflict)
-	err = client.Merge("feature", false)
+	err = client.Merge("feature", domain.MergeOptions{})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to merge")
 
@@ -229,10 +229,254 @@ func TestClient_Merge_Conflict_AutoAbort(t *testing.T) {
 	assert.True(t, os.IsNotExist(err), "MERGE_HEAD should not exist after abort")
 }
 
+// gitOutput runs a git command and returns its trimmed output.
+func gitOutput(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %v failed: %s", args, out)
+	return strings.TrimSpace(string(out))
+}
+
+// setupDivergedRepo creates a feature branch with two commits and a new commit on
+// the main branch that does not conflict with it. Returns the repo and main branch name.
+func setupDivergedRepo(t *testing.T) (string, string) {
+	t.Helper()
+	dir := setupGitRepo(t)
+	mainBranch := gitOutput(t, dir, "rev-parse", "--abbrev-ref", "HEAD")
+
+	runGit(t, dir, "checkout", "-b", "feature")
+	for _, name := range []string{"a.txt", "b.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name+"\n"), 0o644))
+		runGit(t, dir, "add", ".")
+		runGit(t, dir, "commit", "-m", "Add "+name)
+	}
+
+	runGit(t, dir, "checkout", mainBranch)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.txt"), []byte("main\n"), 0o644))
+	runGit(t, dir, "add", ".")
+	runGit(t, dir, "commit", "-m", "Main change")
+	return dir, mainBranch
+}
+
+func TestClient_Merge_MergeCommitMessage(t *testing.T) {
+	dir, _ := setupDivergedRepo(t)
+	client, err := NewClient(dir)
+	require.NoError(t, err)
+
+	err = client.Merge("feature", domain.MergeOptions{Strategy: domain.MergeStrategyMerge, Message: "Merge task #1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Merge task #1", gitOutput(t, dir, "log", "-1", "--format=%s"))
+	assert.Len(t, strings.Fields(gitOutput(t, dir, "log", "-1", "--format=%p")), 2, "HEAD should be a merge commit")
+}
+
+func TestClient_Merge_Squash(t *testing.T) {
+	dir, mainBranch := setupDivergedRepo(t)
+	client, err := NewClient(dir)
+	require.NoError(t, err)
+	before := gitOutput(t, dir, "rev-parse", "HEAD")
+
+	err = client.Merge("feature", domain.MergeOptions{Strategy: domain.MergeStrategySquash, Message: "Add files\n\nCrew-Task: 1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, before, gitOutput(t, dir, "rev-parse", "HEAD~1"), "squash should add a single commit")
+	assert.Equal(t, "Add files", gitOutput(t, dir, "log", "-1", "--format=%s"))
+	assert.Equal(t, "Crew-Task: 1", gitOutput(t, dir, "log", "-1", "--format=%b"))
+	assert.FileExists(t, filepath.Join(dir, "a.txt"))
+	assert.FileExists(t, filepath.Join(dir, "b.txt"))
+	assert.Equal(t, mainBranch, gitOutput(t, dir, "rev-parse", "--abbrev-ref", "HEAD"))
+}
+
+func TestClient_Merge_Squash_Conflict_Restores(t *testing.T) {
+	dir, _ := setupDivergedRepo(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("conflict\n"), 0o644))
+	runGit(t, dir, "add", ".")
+	runGit(t, dir, "commit", "-m", "Conflicting change")
+	client, err := NewClient(dir)
+	require.NoError(t, err)
+	before := gitOutput(t, dir, "rev-parse", "HEAD")
+
+	err = client.Merge("feature", domain.MergeOptions{Strategy: domain.MergeStrategySquash, Message: "Squash"})
+
+	require.Error(t, err)
+	assert.Equal(t, before, gitOutput(t, dir, "rev-parse", "HEAD"))
+	hasChanges, err := client.HasUncommittedChanges(dir)
+	require.NoError(t, err)
+	assert.False(t, hasChanges, "working tree should be clean after a failed squash")
+}
+
+func TestClient_Merge_Rebase(t *testing.T) {
+	dir, mainBranch := setupDivergedRepo(t)
+	client, err := NewClient(dir)
+	require.NoError(t, err)
+
+	err = client.Merge("feature", domain.MergeOptions{Strategy: domain.MergeStrategyRebase})
+
+	require.NoError(t, err)
+	assert.Equal(t, mainBranch, gitOutput(t, dir, "rev-parse", "--abbrev-ref", "HEAD"))
+	assert.Equal(t, "Add b.txt\nAdd a.txt\nMain change\nInitial commit", gitOutput(t, dir, "log", "--format=%s"))
+	assert.Equal(t, "0", gitOutput(t, dir, "rev-list", "--count", "--merges", "HEAD"), "history should be linear")
+}
+
+func TestClient_Merge_Rebase_InWorktree(t *testing.T) {
+	dir, mainBranch := setupDivergedRepo(t)
+	wtDir := filepath.Join(t.TempDir(), "wt")
+	runGit(t, dir, "worktree", "add", wtDir, "feature")
+	client, err := NewClient(dir)
+	require.NoError(t, err)
+
+	err = client.Merge("feature", domain.MergeOptions{Strategy: domain.MergeStrategyRebase, WorktreeDir: wtDir})
+
+	require.NoError(t, err)
+	assert.Equal(t, mainBranch, gitOutput(t, dir, "rev-parse", "--abbrev-ref", "HEAD"))
+	assert.Equal(t, gitOutput(t, dir, "rev-parse", "feature"), gitOutput(t, dir, "rev-parse", "HEAD"))
+	assert.Equal(t, "0", gitOutput(t, dir, "rev-list", "--count", "--merges", "HEAD"))
+}
+
+func TestClient_Merge_FFOnly(t *testing.T) {
+	t.Run("fails when the base branch has moved", func(t *testing.T) {
+		dir, _ := setupDivergedRepo(t)
+		client, err := NewClient(dir)
+		require.NoError(t, err)
+		before := gitOutput(t, dir, "rev-parse", "HEAD")
+
+		err = client.Merge("feature", domain.MergeOptions{Strategy: domain.MergeStrategyFFOnly})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "rebase strategy")
+		assert.Equal(t, before, gitOutput(t, dir, "rev-parse", "HEAD"))
+	})
+
+	t.Run("fast-forwards", func(t *testing.T) {
+		dir := setupGitRepo(t)
+		runGit(t, dir, "checkout", "-b", "feature")
+		runGit(t, dir, "commit", "--allow-empty", "-m", "Feature")
+		runGit(t, dir, "checkout", "-")
+		client, err := NewClient(dir)
+		require.NoError(t, err)
+
+		err = client.Merge("feature", domain.MergeOptions{Strategy: domain.MergeStrategyFFOnly})
+
+		require.NoError(t, err)
+		assert.Equal(t, gitOutput(t, dir, "rev-parse", "feature"), gitOutput(t, dir, "rev-parse", "HEAD"))
+	})
+}
+
 // =============================================================================
 // GetDefaultBranch Tests
 // =============================================================================
 
+func TestClient_UpdateBranch_Rebase(t *testing.T) {
+	dir, mainBranch := setupDivergedRepo(t)
+	wtDir := filepath.Join(t.TempDir(), "wt")
+	runGit(t, dir, "worktree", "add", wtDir, "feature")
+	client, err := NewClient(dir)
+	require.NoError(t, err)
+
+	conflicts, err := client.UpdateBranch(wtDir, mainBranch, domain.MergeStrategyRebase)
+
+	require.NoError(t, err)
+	assert.Empty(t, conflicts)
+	assert.Equal(t, gitOutput(t, dir, "rev-parse", mainBranch), gitOutput(t, wtDir, "rev-parse", "HEAD~2"))
+	assert.FileExists(t, filepath.Join(wtDir, "main.txt"))
+}
+
+func TestClient_UpdateBranch_Merge(t *testing.T) {
+	dir, mainBranch := setupDivergedRepo(t)
+	wtDir := filepath.Join(t.TempDir(), "wt")
+	runGit(t, dir, "worktree", "add", wtDir, "feature")
+	client, err := NewClient(dir)
+	require.NoError(t, err)
+
+	_, err = client.UpdateBranch(wtDir, mainBranch, domain.MergeStrategyMerge)
+
+	require.NoError(t, err)
+	assert.Len(t, strings.Fields(gitOutput(t, wtDir, "log", "-1", "--format=%p")), 2, "HEAD should be a merge commit")
+	assert.FileExists(t, filepath.Join(wtDir, "main.txt"))
+}
+
+func TestClient_UpdateBranch_Conflict_Restores(t *testing.T) {
+	for _, strategy := range []domain.MergeStrategy{domain.MergeStrategyRebase, domain.MergeStrategyMerge} {
+		t.Run(string(strategy), func(t *testing.T) {
+			dir, mainBranch := setupDivergedRepo(t)
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("main version\n"), 0o644))
+			runGit(t, dir, "add", ".")
+			runGit(t, dir, "commit", "-m", "Conflicting change")
+			wtDir := filepath.Join(t.TempDir(), "wt")
+			runGit(t, dir, "worktree", "add", wtDir, "feature")
+			client, err := NewClient(dir)
+			require.NoError(t, err)
+			before := gitOutput(t, wtDir, "rev-parse", "HEAD")
+
+			conflicts, err := client.UpdateBranch(wtDir, mainBranch, strategy)
+
+			require.ErrorIs(t, err, domain.ErrMergeConflict)
+			assert.Equal(t, []string{"a.txt"}, conflicts)
+			assert.Equal(t, before, gitOutput(t, wtDir, "rev-parse", "HEAD"))
+			assert.Equal(t, "feature", gitOutput(t, wtDir, "rev-parse", "--abbrev-ref", "HEAD"))
+			assert.Empty(t, gitOutput(t, wtDir, "status", "--porcelain"))
+		})
+	}
+}
+
+func TestClient_UpdateBranch_UnknownBase(t *testing.T) {
+	dir, _ := setupDivergedRepo(t)
+	wtDir := filepath.Join(t.TempDir(), "wt")
+	runGit(t, dir, "worktree", "add", wtDir, "feature")
+	client, err := NewClient(dir)
+	require.NoError(t, err)
+
+	_, err = client.UpdateBranch(wtDir, "no-such-branch", domain.MergeStrategyRebase)
+
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, domain.ErrMergeConflict)
+}
+
+func TestClient_RebaseOnto_AfterSquashMerge(t *testing.T) {
+	dir, mainBranch := setupDivergedRepo(t)
+	// Stack a child branch on feature, then squash feature into main
+	runGit(t, dir, "branch", "child", "feature")
+	wtDir := filepath.Join(t.TempDir(), "wt")
+	runGit(t, dir, "worktree", "add", wtDir, "child")
+	require.NoError(t, os.WriteFile(filepath.Join(wtDir, "c.txt"), []byte("c\n"), 0o644))
+	runGit(t, wtDir, "add", ".")
+	runGit(t, wtDir, "commit", "-m", "Add c.txt")
+	featureTip := gitOutput(t, dir, "rev-parse", "feature")
+	runGit(t, dir, "merge", "--squash", "feature")
+	runGit(t, dir, "commit", "-m", "Squash feature")
+	client, err := NewClient(dir)
+	require.NoError(t, err)
+
+	conflicts, err := client.RebaseOnto(wtDir, mainBranch, featureTip)
+
+	require.NoError(t, err)
+	assert.Empty(t, conflicts)
+	// Only the child's own commit is replayed onto main
+	assert.Equal(t, gitOutput(t, dir, "rev-parse", mainBranch), gitOutput(t, wtDir, "rev-parse", "HEAD~1"))
+	assert.Equal(t, "Add c.txt", gitOutput(t, wtDir, "log", "-1", "--format=%s"))
+}
+
+func TestClient_BranchDiff(t *testing.T) {
+	dir, mainBranch := setupDivergedRepo(t)
+	client, err := NewClient(dir)
+	require.NoError(t, err)
+
+	// Task side: only the branch's own changes, limited to the given paths
+	diff, err := client.BranchDiff(mainBranch, "feature", []string{"a.txt"})
+	require.NoError(t, err)
+	assert.Contains(t, diff, "+a.txt")
+	assert.NotContains(t, diff, "b.txt")
+	assert.NotContains(t, diff, "main.txt")
+
+	// Base side
+	diff, err = client.BranchDiff("feature", mainBranch, nil)
+	require.NoError(t, err)
+	assert.Contains(t, diff, "+main")
+	assert.NotContains(t, diff, "a.txt")
+}
+
 func TestClient_GetDefaultBranch_FromConfig(t *testing.T) {
 	dir := setupGitRepo(t)
 
@@ -287,6 +531,36 @@ func TestClient_GetDefaultBranch_Fallback(t *testing.T) {
 	assert.Equal(t, "main", branch)
 }
 
+// =============================================================================
+// RevParse Tests
+// =============================================================================
+
+func TestClient_RevParse(t *testing.T) {
+	dir := setupGitRepo(t)
+	runGit(t, dir, "branch", "feature")
+
+	client, err := NewClient(dir)
+	require.NoError(t, err)
+
+	sha, err := client.RevParse("feature")
+	require.NoError(t, err)
+	assert.Len(t, sha, 40)
+
+	head, err := client.RevParse("HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, head, sha)
+}
+
+func TestClient_RevParse_Unknown(t *testing.T) {
+	dir := setupGitRepo(t)
+
+	client, err := NewClient(dir)
+	require.NoError(t, err)
+
+	_, err = client.RevParse("no-such-branch")
+	assert.Error(t, err)
+}
+
 // =============================================================================
 // HasMergeConflict / GetMergeConflictFiles Tests
 // =============================================================================
@@ -0,0 +1,191 @@
+// Package github provides GitHub integration backed by the gh CLI.
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// Ensure Client implements domain.GitHub interface.
+var _ domain.GitHub = (*Client)(nil)
+
+// Client implements domain.GitHub using the gh and git CLIs.
+type Client struct {
+	executor domain.CommandExecutor
+	dir      string // Working directory for commands (repository root)
+	remote   string // Git remote to push to
+}
+
+// NewClient creates a new GitHub client that runs commands in dir.
+func NewClient(executor domain.CommandExecutor, dir string) *Client {
+	return &Client{
+		executor: executor,
+		dir:      dir,
+		remote:   "origin",
+	}
+}
+
+// issueJSON is the JSON shape returned by `gh issue view/list --json`.
+type issueJSON struct {
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+	Labels []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	Number int `json:"number"`
+}
+
+// toDomain converts the gh JSON representation to a domain.Issue.
+func (r issueJSON) toDomain() *domain.Issue {
+	issue := &domain.Issue{
+		Number: r.Number,
+		Title:  r.Title,
+		Body:   r.Body,
+	}
+	for _, label := range r.Labels {
+		issue.Labels = append(issue.Labels, label.Name)
+	}
+	return issue
+}
+
+// GetIssue retrieves issue information.
+func (c *Client) GetIssue(number int) (*domain.Issue, error) {
+	out, err := c.gh("issue", "view", strconv.Itoa(number), "--json", "number,title,body,labels")
+	if err != nil {
+		return nil, fmt.Errorf("get issue #%d: %w", number, err)
+	}
+
+	var raw issueJSON
+	if err := json.Unmarshal(out, &raw); err != nil {
+		return nil, fmt.Errorf("parse issue #%d: %w", number, err)
+	}
+
+	return raw.toDomain(), nil
+}
+
+// ListIssues lists issues matching the options.
+func (c *Client) ListIssues(opts domain.ListIssuesOptions) ([]*domain.Issue, error) {
+	args := []string{"issue", "list", "--json", "number,title,body,labels"}
+	for _, label := range opts.Labels {
+		args = append(args, "--label", label)
+	}
+	if opts.State != "" {
+		args = append(args, "--state", opts.State)
+	}
+	if opts.Limit > 0 {
+		args = append(args, "--limit", strconv.Itoa(opts.Limit))
+	}
+	out, err := c.gh(args...)
+	if err != nil {
+		return nil, fmt.Errorf("list issues: %w", err)
+	}
+
+	var raw []issueJSON
+	if err := json.Unmarshal(out, &raw); err != nil {
+		return nil, fmt.Errorf("parse issue list: %w", err)
+	}
+
+	issues := make([]*domain.Issue, 0, len(raw))
+	for _, r := range raw {
+		issues = append(issues, r.toDomain())
+	}
+	return issues, nil
+}
+
+// CreatePR creates a pull request and returns its number.
+func (c *Client) CreatePR(opts domain.CreatePROptions) (int, error) {
+	args := []string{"pr", "create", "--head", opts.Branch, "--title", opts.Title, "--body", opts.Body}
+	if opts.Base != "" {
+		args = append(args, "--base", opts.Base)
+	}
+	out, err := c.gh(args...)
+	if err != nil {
+		return 0, fmt.Errorf("create pr: %w", err)
+	}
+
+	number, err := parsePRNumber(out)
+	if err != nil {
+		return 0, fmt.Errorf("create pr: %w", err)
+	}
+	return number, nil
+}
+
+// UpdatePR updates the title and body of an existing pull request.
+func (c *Client) UpdatePR(number int, opts domain.UpdatePROptions) error {
+	if _, err := c.gh("pr", "edit", strconv.Itoa(number), "--title", opts.Title, "--body", opts.Body); err != nil {
+		return fmt.Errorf("update pr #%d: %w", number, err)
+	}
+	return nil
+}
+
+// FindPRByBranch finds an open pull request by head branch name.
+// Returns 0 if no open pull request exists for the branch.
+func (c *Client) FindPRByBranch(branch string) (int, error) {
+	out, err := c.gh("pr", "list", "--head", branch, "--state", "open", "--json", "number", "--limit", "1")
+	if err != nil {
+		return 0, fmt.Errorf("find pr for %s: %w", branch, err)
+	}
+
+	var prs []struct {
+		Number int `json:"number"`
+	}
+	if err := json.Unmarshal(out, &prs); err != nil {
+		return 0, fmt.Errorf("parse pr list: %w", err)
+	}
+	if len(prs) == 0 {
+		return 0, nil
+	}
+	return prs[0].Number, nil
+}
+
+// Push pushes a branch to the remote and sets its upstream.
+func (c *Client) Push(branch string) error {
+	cmd := domain.NewCommand("git", []string{"push", "--set-upstream", c.remote, branch}, c.dir)
+	if _, err := c.run(cmd); err != nil {
+		return fmt.Errorf("push %s: %w", branch, err)
+	}
+	return nil
+}
+
+// gh runs a gh CLI command and returns its stdout.
+func (c *Client) gh(args ...string) ([]byte, error) {
+	return c.run(domain.NewCommand("gh", args, c.dir))
+}
+
+// run executes a command and returns its stdout.
+// On failure, stderr is included in the returned error.
+func (c *Client) run(cmd *domain.ExecCommand) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	if err := c.executor.ExecuteWithContext(context.Background(), cmd, &stdout, &stderr); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
+
+// parsePRNumber extracts the PR number from `gh pr create` output,
+// which ends with the PR URL (e.g., https://github.com/owner/repo/pull/42).
+func parsePRNumber(out []byte) (int, error) {
+	fields := strings.Fields(string(out))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("empty output from gh pr create")
+	}
+	url := fields[len(fields)-1]
+	idx := strings.LastIndex(url, "/pull/")
+	if idx < 0 {
+		return 0, fmt.Errorf("unexpected output from gh pr create: %q", url)
+	}
+	number, err := strconv.Atoi(strings.TrimSuffix(url[idx+len("/pull/"):], "/"))
+	if err != nil {
+		return 0, fmt.Errorf("unexpected output from gh pr create: %q", url)
+	}
+	return number, nil
+}
@@ -0,0 +1,230 @@
+package github
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/infra/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGH is a gh replacement that logs its arguments and prints canned responses.
+const fakeGH = `#!/bin/sh
+printf '%s\n' "$*" >> "$GH_LOG"
+case "$1 $2" in
+  "issue view")
+    if [ "$3" = "404" ]; then
+      echo "GraphQL: Could not resolve to an issue with the number of 404." >&2
+      exit 1
+    fi
+    echo '{"number":'"$3"',"title":"Issue title","body":"Issue body","labels":[{"name":"bug"},{"name":"crew"}]}'
+    ;;
+  "issue list")
+    echo '[{"number":3,"title":"First","body":"","labels":[{"name":"crew"}]},{"number":5,"title":"Second","body":"Body","labels":[]}]'
+    ;;
+  "pr create")
+    echo "Warning: 1 uncommitted change" >&2
+    echo "https://github.com/owner/repo/pull/42"
+    ;;
+  "pr edit")
+    echo "https://github.com/owner/repo/pull/$3"
+    ;;
+  "pr list")
+    echo "${GH_PR_LIST:-[]}"
+    ;;
+  *)
+    echo "unknown command: $*" >&2
+    exit 1
+    ;;
+esac
+`
+
+// setupFakeGH installs the fake gh script on PATH and returns the path of its argument log.
+func setupFakeGH(t *testing.T) string {
+	t.Helper()
+
+	binDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "gh"), []byte(fakeGH), 0o755))
+
+	logPath := filepath.Join(t.TempDir(), "gh.log")
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("GH_LOG", logPath)
+	return logPath
+}
+
+// readGHLog returns the logged gh invocations.
+func readGHLog(t *testing.T, logPath string) []string {
+	t.Helper()
+
+	data, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
+
+func TestClient_GetIssue(t *testing.T) {
+	logPath := setupFakeGH(t)
+	client := NewClient(executor.NewClient(), t.TempDir())
+
+	issue, err := client.GetIssue(12)
+
+	require.NoError(t, err)
+	assert.Equal(t, &domain.Issue{
+		Number: 12,
+		Title:  "Issue title",
+		Body:   "Issue body",
+		Labels: []string{"bug", "crew"},
+	}, issue)
+	assert.Equal(t, []string{"issue view 12 --json number,title,body,labels"}, readGHLog(t, logPath))
+}
+
+func TestClient_GetIssue_Error(t *testing.T) {
+	setupFakeGH(t)
+	client := NewClient(executor.NewClient(), t.TempDir())
+
+	_, err := client.GetIssue(404)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "get issue #404")
+	assert.Contains(t, err.Error(), "Could not resolve to an issue")
+}
+
+func TestClient_ListIssues(t *testing.T) {
+	logPath := setupFakeGH(t)
+	client := NewClient(executor.NewClient(), t.TempDir())
+
+	issues, err := client.ListIssues(domain.ListIssuesOptions{
+		Labels: []string{"crew", "ready"},
+		State:  "open",
+		Limit:  50,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.Issue{
+		{Number: 3, Title: "First", Labels: []string{"crew"}},
+		{Number: 5, Title: "Second", Body: "Body"},
+	}, issues)
+	assert.Equal(t, []string{"issue list --json number,title,body,labels --label crew --label ready --state open --limit 50"}, readGHLog(t, logPath))
+}
+
+func TestClient_CreatePR(t *testing.T) {
+	logPath := setupFakeGH(t)
+	client := NewClient(executor.NewClient(), t.TempDir())
+
+	number, err := client.CreatePR(domain.CreatePROptions{
+		Title:  "Add feature",
+		Body:   "Details",
+		Branch: "crew-1",
+		Base:   "main",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 42, number)
+	assert.Equal(t, []string{"pr create --head crew-1 --title Add feature --body Details --base main"}, readGHLog(t, logPath))
+}
+
+func TestClient_UpdatePR(t *testing.T) {
+	logPath := setupFakeGH(t)
+	client := NewClient(executor.NewClient(), t.TempDir())
+
+	err := client.UpdatePR(7, domain.UpdatePROptions{Title: "New title", Body: "New body"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pr edit 7 --title New title --body New body"}, readGHLog(t, logPath))
+}
+
+func TestClient_FindPRByBranch(t *testing.T) {
+	logPath := setupFakeGH(t)
+	t.Setenv("GH_PR_LIST", `[{"number":9}]`)
+	client := NewClient(executor.NewClient(), t.TempDir())
+
+	number, err := client.FindPRByBranch("crew-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 9, number)
+	assert.Equal(t, []string{"pr list --head crew-1 --state open --json number --limit 1"}, readGHLog(t, logPath))
+}
+
+func TestClient_FindPRByBranch_NotFound(t *testing.T) {
+	setupFakeGH(t)
+	client := NewClient(executor.NewClient(), t.TempDir())
+
+	number, err := client.FindPRByBranch("crew-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, number)
+}
+
+func TestClient_Push(t *testing.T) {
+	// Setup a repository with a bare remote
+	remoteDir := t.TempDir()
+	repoDir := t.TempDir()
+	runGit(t, remoteDir, "init", "--bare")
+	runGit(t, repoDir, "init")
+	runGit(t, repoDir, "config", "user.email", "test@example.com")
+	runGit(t, repoDir, "config", "user.name", "Test")
+	runGit(t, repoDir, "commit", "--allow-empty", "-m", "initial")
+	runGit(t, repoDir, "branch", "crew-1")
+	runGit(t, repoDir, "remote", "add", "origin", remoteDir)
+
+	client := NewClient(executor.NewClient(), repoDir)
+
+	err := client.Push("crew-1")
+
+	require.NoError(t, err)
+	out := runGit(t, remoteDir, "branch", "--list", "crew-1")
+	assert.Contains(t, out, "crew-1")
+}
+
+func TestClient_Push_Error(t *testing.T) {
+	repoDir := t.TempDir()
+	runGit(t, repoDir, "init")
+
+	client := NewClient(executor.NewClient(), repoDir)
+
+	err := client.Push("crew-1")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "push crew-1")
+}
+
+func TestParsePRNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		want    int
+		wantErr bool
+	}{
+		{name: "url", output: "https://github.com/owner/repo/pull/42\n", want: 42},
+		{name: "url with preceding text", output: "Creating pull request\nhttps://github.com/owner/repo/pull/7", want: 7},
+		{name: "empty", output: "", wantErr: true},
+		{name: "no pull url", output: "https://github.com/owner/repo", wantErr: true},
+		{name: "invalid number", output: "https://github.com/owner/repo/pull/abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePRNumber([]byte(tt.output))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %v: %s", args, out)
+	return string(out)
+}
@@ -2,6 +2,7 @@
 package gitstore
 
 import (
+	"errors"
 	"fmt"
 	"os/exec"
 	"slices"
@@ -789,6 +790,9 @@ func (s *Store) RestoreSnapshot(snapshotRefStr string) error {
 	// Get snapshot tree
 	snapshotRefName := plumbing.ReferenceName(snapshotRefStr)
 	ref, err := s.repo.Reference(snapshotRefName, true)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return fmt.Errorf("%w: %s", domain.ErrSnapshotNotFound, snapshotRefStr)
+	}
 	if err != nil {
 		return fmt.Errorf("get snapshot ref: %w", err)
 	}
@@ -981,6 +985,9 @@ func (s *Store) restoreSnapshotLocked(snapshotRefStr string) error {
 	// Get snapshot tree
 	snapshotRefName := plumbing.ReferenceName(snapshotRefStr)
 	ref, err := s.repo.Reference(snapshotRefName, true)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return fmt.Errorf("%w: %s", domain.ErrSnapshotNotFound, snapshotRefStr)
+	}
 	if err != nil {
 		return fmt.Errorf("get snapshot ref: %w", err)
 	}
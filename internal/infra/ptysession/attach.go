@@ -0,0 +1,116 @@
+package ptysession
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"golang.org/x/term"
+)
+
+// detachKey detaches the terminal from a session (Ctrl+G).
+const detachKey = 0x07
+
+// errDetached is returned by the input relay when the user presses the detach key.
+var errDetached = errors.New("detached")
+
+// attach relays the terminal (in, out) to the session host on socketPath until
+// the user detaches or the session ends.
+func attach(socketPath, sessionName string, in, out *os.File) error {
+	rows, cols := 0, 0
+	if w, h, err := term.GetSize(int(out.Fd())); err == nil {
+		rows, cols = h, w
+	}
+
+	conn, err := net.DialTimeout("unix", socketPath, dialTimeout)
+	if err != nil {
+		return fmt.Errorf("attach session: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if err := json.NewEncoder(conn).Encode(request{Op: opAttach, Rows: rows, Cols: cols}); err != nil {
+		return fmt.Errorf("attach session: %w", err)
+	}
+	reader := bufio.NewReader(conn)
+	// Read only the reply line; everything after it is terminal output
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		return fmt.Errorf("attach session: read response: %w", err)
+	}
+	var resp response
+	if err := json.Unmarshal(line, &resp); err != nil {
+		return fmt.Errorf("attach session: read response: %w", err)
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("attach session: %s", resp.Error)
+	}
+
+	if term.IsTerminal(int(in.Fd())) {
+		state, err := term.MakeRaw(int(in.Fd()))
+		if err != nil {
+			return fmt.Errorf("attach session: set raw mode: %w", err)
+		}
+		defer func() { _ = term.Restore(int(in.Fd()), state) }()
+	}
+
+	// Follow terminal size changes while attached
+	winch := make(chan os.Signal, 1)
+	signal.Notify(winch, syscall.SIGWINCH)
+	defer signal.Stop(winch)
+	go func() {
+		for range winch {
+			if w, h, err := term.GetSize(int(out.Fd())); err == nil {
+				_, _ = call(socketPath, request{Op: opResize, Rows: h, Cols: w}, dialTimeout)
+			}
+		}
+	}()
+
+	ended := make(chan struct{})
+	go func() {
+		defer close(ended)
+		_, _ = io.Copy(out, reader)
+	}()
+
+	inputErr := make(chan error, 1)
+	go func() { inputErr <- relayInput(in, conn) }()
+
+	select {
+	case <-ended:
+		_, _ = fmt.Fprintf(out, "\r\n[session %s ended]\r\n", sessionName)
+	case err := <-inputErr:
+		if errors.Is(err, errDetached) {
+			_, _ = fmt.Fprintf(out, "\r\n[detached from %s]\r\n", sessionName)
+		}
+	}
+	return nil
+}
+
+// relayInput copies terminal input to conn until the detach key is pressed.
+func relayInput(in io.Reader, conn io.Writer) error {
+	buf := make([]byte, 1024)
+	for {
+		n, err := in.Read(buf)
+		if n > 0 {
+			chunk := buf[:n]
+			if i := bytes.IndexByte(chunk, detachKey); i >= 0 {
+				if i > 0 {
+					_, _ = conn.Write(chunk[:i])
+				}
+				return errDetached
+			}
+			if _, werr := conn.Write(chunk); werr != nil {
+				return werr
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package ptysession
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// ringBuffer keeps the most recent output of a session.
+type ringBuffer struct {
+	data []byte
+	size int
+	mu   sync.Mutex
+}
+
+// newRingBuffer creates a ring buffer holding at most size bytes.
+func newRingBuffer(size int) *ringBuffer {
+	return &ringBuffer{size: size}
+}
+
+// Write appends p, discarding the oldest bytes beyond the buffer size.
+func (b *ringBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.data = append(b.data, p...)
+	if over := len(b.data) - b.size; over > 0 {
+		// Compact in place so the backing array does not grow without bound
+		b.data = append(b.data[:0], b.data[over:]...)
+	}
+	return len(p), nil
+}
+
+// Bytes returns a copy of the buffered output.
+func (b *ringBuffer) Bytes() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return bytes.Clone(b.data)
+}
+
+// Tail returns the buffered output starting at a line boundary within the last n bytes.
+// It is used to replay recent output to an attaching terminal.
+func (b *ringBuffer) Tail(n int) []byte {
+	data := b.Bytes()
+	if len(data) <= n {
+		return data
+	}
+	data = data[len(data)-n:]
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		data = data[i+1:]
+	}
+	return data
+}
+
+// screenLines converts raw terminal output to the last n text lines.
+// Carriage returns overwrite the line like a terminal would; cursor movement
+// is not emulated, so full-screen programs are approximated.
+// Escape sequences are kept only when escape is true.
+func screenLines(data []byte, n int, escape bool) string {
+	text := string(data)
+	if !escape {
+		text = ansi.Strip(text)
+	}
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		line = strings.TrimSuffix(line, "\r")
+		if j := strings.LastIndexByte(line, '\r'); j >= 0 {
+			line = line[j+1:]
+		}
+		lines[i] = line
+	}
+
+	// Drop trailing blank lines like tmux capture-pane
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if n > 0 && len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
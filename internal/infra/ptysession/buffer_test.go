@@ -0,0 +1,77 @@
+package ptysession
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRingBuffer_Write_DiscardsOldest(t *testing.T) {
+	b := newRingBuffer(8)
+
+	_, _ = b.Write([]byte("hello "))
+	_, _ = b.Write([]byte("world"))
+
+	assert.Equal(t, "lo world", string(b.Bytes()))
+}
+
+func TestRingBuffer_Tail(t *testing.T) {
+	b := newRingBuffer(64)
+	_, _ = b.Write([]byte("first line\nsecond line\nthird"))
+
+	t.Run("whole buffer when it fits", func(t *testing.T) {
+		assert.Equal(t, "first line\nsecond line\nthird", string(b.Tail(64)))
+	})
+
+	t.Run("starts at a line boundary", func(t *testing.T) {
+		assert.Equal(t, "third", string(b.Tail(10)))
+	})
+}
+
+func TestScreenLines(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		want   string
+		lines  int
+		escape bool
+	}{
+		{
+			name:  "last n lines",
+			data:  "one\ntwo\nthree\n",
+			lines: 2,
+			want:  "two\nthree",
+		},
+		{
+			name:  "drops trailing blank lines",
+			data:  "one\r\ntwo\r\n\r\n   \r\n",
+			lines: 10,
+			want:  "one\ntwo",
+		},
+		{
+			name:  "carriage return overwrites the line",
+			data:  "progress 10%\rprogress 100%\ndone",
+			lines: 10,
+			want:  "progress 100%\ndone",
+		},
+		{
+			name:  "strips escape sequences",
+			data:  "\x1b[31mred\x1b[0m text",
+			lines: 10,
+			want:  "red text",
+		},
+		{
+			name:   "keeps escape sequences",
+			data:   "\x1b[31mred\x1b[0m",
+			lines:  10,
+			escape: true,
+			want:   "\x1b[31mred\x1b[0m",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, screenLines([]byte(tt.data), tt.lines, tt.escape))
+		})
+	}
+}
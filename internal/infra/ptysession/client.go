@@ -0,0 +1,308 @@
+// Package ptysession provides session management without tmux.
+// Each session is owned by a crew host process that runs the command under a
+// pty, keeps recent output for Peek and serves control requests on a Unix socket.
+package ptysession
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// HostCommand is the hidden crew command that runs a session host.
+const HostCommand = "_pty-host"
+
+// AttachHostCommand is the hidden crew command that attaches the terminal to a session.
+const AttachHostCommand = "_pty-attach"
+
+const (
+	startTimeout = 5 * time.Second             // Time allowed for a new host to accept requests
+	pollInterval = 50 * time.Millisecond       // Poll interval while waiting for a host to start or stop
+	waitInterval = time.Second                 // Poll interval for Wait
+	peekTimeout  = 5 * time.Second             // Time allowed for a control request
+	stopDeadline = stopTimeout + 5*time.Second // Time allowed for Stop, including SIGKILL escalation
+)
+
+// Client manages pty sessions for git-crew.
+type Client struct {
+	crewDir    string // Path to .crew directory
+	executable string // Path to the crew binary used to run session hosts
+}
+
+// NewClient creates a new pty session client.
+// executable is the crew binary that runs session hosts (see HostCommand).
+func NewClient(crewDir, executable string) *Client {
+	return &Client{
+		crewDir:    crewDir,
+		executable: executable,
+	}
+}
+
+// Ensure Client implements domain.SessionManager interface.
+var _ domain.SessionManager = (*Client)(nil)
+
+// Start starts a session host in the background and waits until it accepts requests.
+// The host runs in its own process session so it outlives the calling command,
+// and records the session to domain.RecordingPath.
+func (c *Client) Start(ctx context.Context, opts domain.StartSessionOptions) error {
+	running, err := c.IsRunning(opts.Name)
+	if err != nil {
+		return fmt.Errorf("check session: %w", err)
+	}
+	if running {
+		return domain.ErrSessionRunning
+	}
+
+	socketPath := c.socketPath(opts.Name)
+	if err := os.MkdirAll(filepath.Dir(socketPath), 0o750); err != nil {
+		return fmt.Errorf("create sessions directory: %w", err)
+	}
+	// Host errors go to the session log, next to the session's own output
+	logPath := domain.SessionLogPath(c.crewDir, opts.Name)
+	if err := os.MkdirAll(filepath.Dir(logPath), 0o750); err != nil {
+		return fmt.Errorf("create logs directory: %w", err)
+	}
+	logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open session log: %w", err)
+	}
+	defer func() { _ = logFile.Close() }()
+
+	// #nosec G204 - executable is the running crew binary and opts come from UseCase code
+	cmd := exec.Command(c.executable, HostCommand,
+		"--socket", socketPath,
+		"--dir", opts.Dir,
+		"--record", domain.RecordingPath(c.crewDir, opts.Name),
+		"--",
+		opts.Name,
+		opts.Command,
+	)
+	cmd.Dir = opts.Dir
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start session host: %w", err)
+	}
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+
+	deadline := time.NewTimer(startTimeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-exited:
+			return fmt.Errorf("start session: host exited: %v (see %s)", err, logPath)
+		case <-deadline.C:
+			return fmt.Errorf("start session: host did not start within %s (see %s)", startTimeout, logPath)
+		case <-ticker.C:
+			if running, _ := c.IsRunning(opts.Name); running {
+				return nil
+			}
+		}
+	}
+}
+
+// Stop terminates a session.
+// The host sends SIGTERM to the session's process group, escalating to SIGKILL.
+func (c *Client) Stop(sessionName string) error {
+	running, err := c.IsRunning(sessionName)
+	if err != nil {
+		return fmt.Errorf("check session: %w", err)
+	}
+	if !running {
+		return nil // Session already stopped, nothing to do
+	}
+
+	// The host may exit before replying; the session state below is what matters
+	_, _ = call(c.socketPath(sessionName), request{Op: opStop}, stopDeadline)
+
+	deadline := time.Now().Add(stopDeadline)
+	for time.Now().Before(deadline) {
+		if running, _ := c.IsRunning(sessionName); !running {
+			return nil
+		}
+		time.Sleep(pollInterval)
+	}
+	return fmt.Errorf("stop session: %s is still running", sessionName)
+}
+
+// Attach attaches the current terminal to a session until detached with Ctrl+G
+// or until the session ends.
+func (c *Client) Attach(sessionName string) error {
+	running, err := c.IsRunning(sessionName)
+	if err != nil {
+		return fmt.Errorf("check session: %w", err)
+	}
+	if !running {
+		return domain.ErrNoSession
+	}
+	return attach(c.socketPath(sessionName), sessionName, os.Stdin, os.Stdout)
+}
+
+// AttachCommand returns the command that attaches a terminal to a session.
+// It is used by callers that must keep running while attached (e.g., the TUI).
+func (c *Client) AttachCommand(sessionName string) *domain.ExecCommand {
+	return domain.NewCommand(c.executable, []string{AttachHostCommand, sessionName}, "")
+}
+
+// Peek captures the last N lines from a session.
+func (c *Client) Peek(sessionName string, lines int, escape bool) (string, error) {
+	running, err := c.IsRunning(sessionName)
+	if err != nil {
+		return "", fmt.Errorf("check session: %w", err)
+	}
+	if !running {
+		return "", domain.ErrNoSession
+	}
+
+	resp, err := call(c.socketPath(sessionName), request{Op: opPeek, Lines: lines, Escape: escape}, peekTimeout)
+	if err != nil {
+		return "", fmt.Errorf("peek session: %w", err)
+	}
+	return resp.Output, nil
+}
+
+// Send sends keys to a session.
+// Key names accepted by tmux send-keys (Enter, Escape, Tab, C-c, ...) are translated.
+func (c *Client) Send(sessionName string, keys string) error {
+	running, err := c.IsRunning(sessionName)
+	if err != nil {
+		return fmt.Errorf("check session: %w", err)
+	}
+	if !running {
+		return domain.ErrNoSession
+	}
+
+	if _, err := call(c.socketPath(sessionName), request{Op: opSend, Keys: keys}, peekTimeout); err != nil {
+		return fmt.Errorf("send keys: %w", err)
+	}
+	return nil
+}
+
+// IsRunning checks if a session is running.
+// A session is running while its host accepts requests; stale sockets are removed.
+func (c *Client) IsRunning(sessionName string) (bool, error) {
+	socketPath := c.socketPath(sessionName)
+	if _, err := os.Stat(socketPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("check session: %w", err)
+	}
+
+	if _, err := call(socketPath, request{Op: opInfo}, peekTimeout); err != nil {
+		if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, os.ErrNotExist) {
+			// Host is gone (e.g., killed); remove its socket
+			_ = os.Remove(socketPath)
+			return false, nil
+		}
+		return false, fmt.Errorf("check session: %w", err)
+	}
+	return true, nil
+}
+
+// Wait waits for a session to stop running.
+// It polls every second and can be cancelled via context.
+func (c *Client) Wait(ctx context.Context, sessionName string) error {
+	ticker := time.NewTicker(waitInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			running, err := c.IsRunning(sessionName)
+			if err != nil {
+				return err
+			}
+			if !running {
+				return nil
+			}
+		}
+	}
+}
+
+// GetPaneProcesses retrieves process information for a session.
+// It returns the session's root process and all its children recursively.
+func (c *Client) GetPaneProcesses(sessionName string) ([]domain.ProcessInfo, error) {
+	running, err := c.IsRunning(sessionName)
+	if err != nil {
+		return nil, fmt.Errorf("check session: %w", err)
+	}
+	if !running {
+		return nil, domain.ErrNoSession
+	}
+
+	resp, err := call(c.socketPath(sessionName), request{Op: opInfo}, peekTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("get session pid: %w", err)
+	}
+	if resp.PID <= 0 {
+		return nil, fmt.Errorf("no process found for session %s", sessionName)
+	}
+	return processTree(resp.PID)
+}
+
+func (c *Client) socketPath(sessionName string) string {
+	return domain.SessionSocketPath(c.crewDir, sessionName)
+}
+
+// processTree returns rootPID and all its descendants using ps (macOS/Linux compatible).
+func processTree(rootPID int) ([]domain.ProcessInfo, error) {
+	out, err := exec.Command("ps", "-o", "pid,ppid,state,comm", "-ax").Output()
+	if err != nil {
+		return nil, fmt.Errorf("ps command failed: %w", err)
+	}
+
+	processes := make(map[int]domain.ProcessInfo)
+	children := make(map[int][]int)
+	for i, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if i == 0 || len(fields) < 4 {
+			continue // Skip header and malformed lines
+		}
+		pid, pidErr := strconv.Atoi(fields[0])
+		ppid, ppidErr := strconv.Atoi(fields[1])
+		if pidErr != nil || ppidErr != nil {
+			continue
+		}
+		processes[pid] = domain.ProcessInfo{
+			PID:     pid,
+			PPID:    ppid,
+			State:   fields[2],
+			Command: strings.Join(fields[3:], " "),
+		}
+		children[ppid] = append(children[ppid], pid)
+	}
+
+	var result []domain.ProcessInfo
+	visited := make(map[int]bool)
+	queue := []int{rootPID}
+	for len(queue) > 0 {
+		pid := queue[0]
+		queue = queue[1:]
+		proc, ok := processes[pid]
+		if !ok || visited[pid] {
+			continue
+		}
+		visited[pid] = true
+		result = append(result, proc)
+		queue = append(queue, children[pid]...)
+	}
+	return result, nil
+}
@@ -0,0 +1,217 @@
+package ptysession
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMain lets the test binary act as the crew binary: Client.Start runs it
+// with HostCommand to host sessions.
+func TestMain(m *testing.M) {
+	if len(os.Args) > 1 && os.Args[1] == HostCommand {
+		os.Exit(runTestHost(os.Args[2:]))
+	}
+	os.Exit(m.Run())
+}
+
+// runTestHost parses "--socket <path> --dir <dir> --record <path> -- <name> <command>" like the crew command.
+func runTestHost(args []string) int {
+	var opts HostOptions
+	for len(args) > 0 {
+		switch args[0] {
+		case "--socket":
+			opts.SocketPath, args = args[1], args[2:]
+		case "--dir":
+			opts.Dir, args = args[1], args[2:]
+		case "--record":
+			opts.RecordPath, args = args[1], args[2:]
+		case "--":
+			opts.Name, opts.Command, args = args[1], args[2], nil
+		default:
+			return 2
+		}
+	}
+	if err := Serve(context.Background(), opts); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// setupTestClient creates a client whose sessions are hosted by the test binary.
+func setupTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	// Keep socket paths short (Unix socket paths are limited to ~100 bytes)
+	crewDir, err := os.MkdirTemp("", "pty-test-*")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(crewDir) })
+
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	return NewClient(crewDir, exe)
+}
+
+func startTestSession(t *testing.T, client *Client, name, command string) {
+	t.Helper()
+	err := client.Start(context.Background(), domain.StartSessionOptions{
+		Name:    name,
+		Dir:     os.TempDir(),
+		Command: command,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Stop(name) })
+}
+
+// waitForOutput polls Peek until the session output contains want.
+func waitForOutput(t *testing.T, client *Client, name, want string) string {
+	t.Helper()
+	var output string
+	require.Eventually(t, func() bool {
+		out, err := client.Peek(name, 50, false)
+		output = out
+		return err == nil && strings.Contains(out, want)
+	}, 5*time.Second, 50*time.Millisecond, "output: %q", output)
+	return output
+}
+
+func TestNewClient(t *testing.T) {
+	client := NewClient("/path/to/crew", "/usr/bin/crew")
+
+	assert.Equal(t, "/path/to/crew", client.crewDir)
+	assert.Equal(t, "/usr/bin/crew", client.executable)
+}
+
+func TestClient_AttachCommand(t *testing.T) {
+	client := NewClient("/path/to/crew", "/usr/bin/crew")
+
+	cmd := client.AttachCommand("crew-1")
+
+	assert.Equal(t, "/usr/bin/crew", cmd.Program)
+	assert.Equal(t, []string{AttachHostCommand, "crew-1"}, cmd.Args)
+}
+
+func TestClient_IsRunning_NoSession(t *testing.T) {
+	client := setupTestClient(t)
+
+	running, err := client.IsRunning("crew-1")
+
+	require.NoError(t, err)
+	assert.False(t, running)
+}
+
+func TestClient_IsRunning_RemovesStaleSocket(t *testing.T) {
+	client := setupTestClient(t)
+	socketPath := client.socketPath("crew-1")
+	require.NoError(t, os.MkdirAll(filepath.Dir(socketPath), 0o750))
+	require.NoError(t, os.WriteFile(socketPath, nil, 0o600))
+
+	running, err := client.IsRunning("crew-1")
+
+	require.NoError(t, err)
+	assert.False(t, running)
+	assert.NoFileExists(t, socketPath)
+}
+
+func TestClient_Start_Peek_Stop(t *testing.T) {
+	// Setup
+	client := setupTestClient(t)
+
+	// Execute
+	startTestSession(t, client, "crew-1", "echo hello from pty; sleep 30")
+
+	// Assert
+	running, err := client.IsRunning("crew-1")
+	require.NoError(t, err)
+	assert.True(t, running)
+	waitForOutput(t, client, "crew-1", "hello from pty")
+
+	err = client.Start(context.Background(), domain.StartSessionOptions{Name: "crew-1", Command: "true"})
+	assert.ErrorIs(t, err, domain.ErrSessionRunning)
+
+	require.NoError(t, client.Stop("crew-1"))
+	running, err = client.IsRunning("crew-1")
+	require.NoError(t, err)
+	assert.False(t, running)
+	assert.NoFileExists(t, client.socketPath("crew-1"))
+
+	// The run was recorded next to the base recording path
+	runs, err := filepath.Glob(filepath.Join(client.crewDir, "logs", "crew-1.*.cast"))
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	recording, err := os.ReadFile(runs[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(recording), `"width":200`)
+	assert.Contains(t, string(recording), "hello from pty")
+}
+
+func TestClient_Send(t *testing.T) {
+	// Setup
+	client := setupTestClient(t)
+	startTestSession(t, client, "crew-1", `read line; echo "got:$line"; sleep 30`)
+
+	// Execute
+	require.NoError(t, client.Send("crew-1", "ping"))
+	require.NoError(t, client.Send("crew-1", "Enter"))
+
+	// Assert
+	waitForOutput(t, client, "crew-1", "got:ping")
+}
+
+func TestClient_Wait_SessionExits(t *testing.T) {
+	// Setup
+	client := setupTestClient(t)
+	startTestSession(t, client, "crew-1", "sleep 0.2")
+
+	// Execute
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := client.Wait(ctx, "crew-1")
+
+	// Assert
+	require.NoError(t, err)
+	running, _ := client.IsRunning("crew-1")
+	assert.False(t, running)
+}
+
+func TestClient_GetPaneProcesses(t *testing.T) {
+	// Setup
+	client := setupTestClient(t)
+	startTestSession(t, client, "crew-1", "sleep 30")
+
+	// Execute
+	procs, err := client.GetPaneProcesses("crew-1")
+
+	// Assert
+	require.NoError(t, err)
+	require.NotEmpty(t, procs)
+	var commands []string
+	for _, p := range procs {
+		commands = append(commands, p.Command)
+	}
+	assert.Contains(t, strings.Join(commands, " "), "sleep")
+}
+
+func TestClient_NoSession_Errors(t *testing.T) {
+	client := setupTestClient(t)
+
+	_, err := client.Peek("crew-1", 10, false)
+	assert.ErrorIs(t, err, domain.ErrNoSession)
+
+	err = client.Send("crew-1", "Enter")
+	assert.ErrorIs(t, err, domain.ErrNoSession)
+
+	_, err = client.GetPaneProcesses("crew-1")
+	assert.ErrorIs(t, err, domain.ErrNoSession)
+
+	assert.NoError(t, client.Stop("crew-1"))
+}
@@ -0,0 +1,339 @@
+package ptysession
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/infra/recording"
+)
+
+const (
+	defaultRows       = 50              // Initial pty height (detached sessions have no terminal to follow)
+	defaultCols       = 200             // Initial pty width
+	bufferSize        = 2 << 20         // Output kept for Peek (bytes)
+	attachReplayBytes = 64 << 10        // Recent output replayed to an attaching terminal
+	clientQueueSize   = 256             // Pending output chunks per attached terminal
+	requestTimeout    = 5 * time.Second // Time allowed to read a control request
+	stopTimeout       = 5 * time.Second // Time allowed for the process group to exit after SIGTERM
+	drainTimeout      = time.Second     // Time allowed to read the remaining output after the process exits
+)
+
+// HostOptions configures a session host.
+// Fields are ordered to minimize memory padding.
+type HostOptions struct {
+	Name       string // Session name
+	Dir        string // Working directory
+	Command    string // Shell command to run (default: $SHELL)
+	SocketPath string // Control socket path
+	RecordPath string // Base asciicast recording path, each run gets its own file (empty disables recording)
+	Rows       int    // Initial pty height (default: 50)
+	Cols       int    // Initial pty width (default: 200)
+}
+
+// host owns the pty of a single session and serves control requests.
+// Fields are ordered to minimize memory padding.
+type host struct {
+	master   *os.File
+	cmd      *exec.Cmd
+	buffer   *ringBuffer
+	recorder *recording.Recorder // nil when recording is disabled
+	clients  map[net.Conn]chan []byte
+	exited   chan struct{} // Closed when the session process exits
+	mu       sync.Mutex
+}
+
+// Serve runs opts.Command under a new pty and serves control requests on
+// opts.SocketPath until the command exits or ctx is cancelled.
+// Cancelling ctx terminates the command.
+func Serve(ctx context.Context, opts HostOptions) error {
+	rows, cols := opts.Rows, opts.Cols
+	if rows <= 0 || cols <= 0 {
+		rows, cols = defaultRows, defaultCols
+	}
+
+	master, slave, err := openPTY()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = master.Close() }()
+	if err := setSize(master, rows, cols); err != nil {
+		_ = slave.Close()
+		return fmt.Errorf("set pty size: %w", err)
+	}
+
+	// Listen before starting the command so the session is visible as soon as it runs
+	if err := os.MkdirAll(filepath.Dir(opts.SocketPath), 0o750); err != nil {
+		_ = slave.Close()
+		return fmt.Errorf("create socket directory: %w", err)
+	}
+	_ = os.Remove(opts.SocketPath)
+	listener, err := net.Listen("unix", opts.SocketPath)
+	if err != nil {
+		_ = slave.Close()
+		return fmt.Errorf("listen: %w", err)
+	}
+	defer func() { _ = os.Remove(opts.SocketPath) }()
+	defer func() { _ = listener.Close() }()
+	if err := os.Chmod(opts.SocketPath, 0o600); err != nil {
+		_ = slave.Close()
+		return fmt.Errorf("restrict socket permissions: %w", err)
+	}
+
+	// Like tmux, run the user's shell when no command is given
+	cmd := exec.Command(loginShell()) //nolint:gosec // SHELL is the user's own shell
+	if opts.Command != "" {
+		cmd = exec.Command("sh", "-c", opts.Command) //nolint:gosec // Command is the session script built by crew
+	}
+	cmd.Dir = opts.Dir
+	cmd.Env = append(os.Environ(), "TERM=xterm-256color")
+	cmd.Stdin = slave
+	cmd.Stdout = slave
+	cmd.Stderr = slave
+	// New session with the pty as controlling terminal, so Ctrl+C and
+	// SIGWINCH reach the agent and Stop can signal the whole process group
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true}
+	if err := cmd.Start(); err != nil {
+		_ = slave.Close()
+		return fmt.Errorf("start command: %w", err)
+	}
+	_ = slave.Close()
+
+	h := &host{
+		master:  master,
+		cmd:     cmd,
+		buffer:  newRingBuffer(bufferSize),
+		clients: make(map[net.Conn]chan []byte),
+		exited:  make(chan struct{}),
+	}
+	if opts.RecordPath != "" {
+		// A session without its recording is still useful, so do not fail the session
+		if rec, err := recording.Create(opts.RecordPath, cols, rows, opts.Name); err == nil {
+			h.recorder = rec
+			defer func() { _ = rec.Close() }()
+		} else {
+			_, _ = fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+		}
+	}
+
+	pumped := make(chan struct{})
+	go func() {
+		defer close(pumped)
+		h.pump()
+	}()
+	go h.accept(listener)
+	go func() {
+		_ = cmd.Wait()
+		close(h.exited)
+	}()
+
+	select {
+	case <-h.exited:
+	case <-ctx.Done():
+		h.terminate()
+		<-h.exited
+	}
+
+	// Output written just before exit is still in the pty; processes left in
+	// the background may keep it open, so do not wait for them
+	select {
+	case <-pumped:
+	case <-time.After(drainTimeout):
+	}
+	h.closeClients()
+	return nil
+}
+
+// loginShell returns the user's shell, falling back to sh.
+func loginShell() string {
+	if shell := os.Getenv("SHELL"); shell != "" {
+		return shell
+	}
+	return "sh"
+}
+
+// pump copies pty output to the buffer and attached terminals.
+func (h *host) pump() {
+	buf := make([]byte, 32<<10)
+	for {
+		n, err := h.master.Read(buf)
+		if n > 0 {
+			data := bytes.Clone(buf[:n])
+			h.mu.Lock()
+			_, _ = h.buffer.Write(data)
+			if h.recorder != nil {
+				_, _ = h.recorder.Write(data)
+			}
+			for conn, queue := range h.clients {
+				select {
+				case queue <- data:
+				default:
+					// Disconnect terminals that cannot keep up rather than block the session
+					delete(h.clients, conn)
+					close(queue)
+				}
+			}
+			h.mu.Unlock()
+		}
+		if err != nil {
+			// EIO once the last process holding the pty exits
+			return
+		}
+	}
+}
+
+// accept serves control connections until the listener is closed.
+func (h *host) accept(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go h.handle(conn)
+	}
+}
+
+// handle serves a single control request.
+func (h *host) handle(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+	_ = conn.SetReadDeadline(time.Now().Add(requestTimeout))
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		h.reply(conn, response{Error: fmt.Sprintf("invalid request: %v", err)})
+		_ = conn.Close()
+		return
+	}
+
+	if req.Op == opAttach {
+		h.attach(conn, reader, req)
+		return
+	}
+	defer func() { _ = conn.Close() }()
+
+	switch req.Op {
+	case opInfo:
+		h.reply(conn, response{PID: h.cmd.Process.Pid})
+	case opPeek:
+		h.reply(conn, response{Output: screenLines(h.buffer.Bytes(), req.Lines, req.Escape)})
+	case opSend:
+		if _, err := h.master.Write(keyBytes(req.Keys)); err != nil {
+			h.reply(conn, response{Error: fmt.Sprintf("send keys: %v", err)})
+			return
+		}
+		h.reply(conn, response{})
+	case opResize:
+		if err := h.resize(req.Rows, req.Cols); err != nil {
+			h.reply(conn, response{Error: fmt.Sprintf("resize: %v", err)})
+			return
+		}
+		h.reply(conn, response{})
+	case opStop:
+		h.terminate()
+		h.reply(conn, response{})
+	default:
+		h.reply(conn, response{Error: fmt.Sprintf("unknown operation: %q", req.Op)})
+	}
+}
+
+// attach relays the pty to conn until either side closes.
+// Recent output is replayed first so the terminal shows the current context.
+func (h *host) attach(conn net.Conn, reader *bufio.Reader, req request) {
+	if req.Rows > 0 && req.Cols > 0 {
+		_ = h.resize(req.Rows, req.Cols)
+	}
+	h.reply(conn, response{})
+
+	queue := make(chan []byte, clientQueueSize)
+	h.mu.Lock()
+	replay := h.buffer.Tail(attachReplayBytes)
+	h.clients[conn] = queue
+	h.mu.Unlock()
+
+	go func() {
+		defer func() { _ = conn.Close() }()
+		if _, err := conn.Write(replay); err != nil {
+			return
+		}
+		for data := range queue {
+			if _, err := conn.Write(data); err != nil {
+				return
+			}
+		}
+	}()
+
+	// Terminal input goes straight to the pty
+	_, _ = io.Copy(h.master, reader)
+	h.removeClient(conn)
+}
+
+// resize resizes the pty and records the new size.
+func (h *host) resize(rows, cols int) error {
+	if err := setSize(h.master, rows, cols); err != nil {
+		return err
+	}
+	if h.recorder != nil {
+		_ = h.recorder.Resize(cols, rows)
+	}
+	return nil
+}
+
+// terminate sends SIGTERM to the session process group and escalates to
+// SIGKILL if it does not exit in time.
+func (h *host) terminate() {
+	pgid := h.cmd.Process.Pid
+	_ = syscall.Kill(-pgid, syscall.SIGTERM)
+
+	// A second SIGTERM helps agents that ignore the first one while busy (same as the tmux backend)
+	select {
+	case <-h.exited:
+		return
+	case <-time.After(500 * time.Millisecond):
+		_ = syscall.Kill(-pgid, syscall.SIGTERM)
+	}
+
+	select {
+	case <-h.exited:
+	case <-time.After(stopTimeout):
+		_ = syscall.Kill(-pgid, syscall.SIGKILL)
+		<-h.exited
+	}
+}
+
+func (h *host) reply(conn net.Conn, resp response) {
+	_ = json.NewEncoder(conn).Encode(resp)
+}
+
+func (h *host) removeClient(conn net.Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if queue, ok := h.clients[conn]; ok {
+		delete(h.clients, conn)
+		close(queue)
+	}
+}
+
+func (h *host) closeClients() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for conn, queue := range h.clients {
+		delete(h.clients, conn)
+		close(queue)
+	}
+}
@@ -0,0 +1,103 @@
+package ptysession
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// Control operations understood by a session host.
+const (
+	opInfo   = "info"   // Report the session process
+	opPeek   = "peek"   // Capture recent output
+	opSend   = "send"   // Send keys to the session
+	opStop   = "stop"   // Terminate the session
+	opResize = "resize" // Resize the pty
+	opAttach = "attach" // Switch the connection to a raw terminal relay
+)
+
+// dialTimeout bounds how long a control request may take to connect.
+const dialTimeout = 2 * time.Second
+
+// request is a single control request sent as a JSON line.
+// Fields are ordered to minimize memory padding.
+type request struct {
+	Op     string `json:"op"`
+	Keys   string `json:"keys,omitempty"`
+	Lines  int    `json:"lines,omitempty"`
+	Rows   int    `json:"rows,omitempty"`
+	Cols   int    `json:"cols,omitempty"`
+	Escape bool   `json:"escape,omitempty"`
+}
+
+// response is the reply to a control request sent as a JSON line.
+type response struct {
+	Error  string `json:"error,omitempty"`
+	Output string `json:"output,omitempty"`
+	PID    int    `json:"pid,omitempty"`
+}
+
+// call sends a request to the host listening on socketPath and reads its response.
+func call(socketPath string, req request, timeout time.Duration) (*response, error) {
+	conn, err := net.DialTimeout("unix", socketPath, dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+	_ = conn.SetDeadline(time.Now().Add(timeout))
+
+	if err := json.NewEncoder(conn).Encode(req); err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	var resp response
+	if err := json.NewDecoder(bufio.NewReader(conn)).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if resp.Error != "" {
+		return &resp, errors.New(resp.Error)
+	}
+	return &resp, nil
+}
+
+// namedKeys maps tmux send-keys key names to the bytes they produce.
+var namedKeys = map[string]string{
+	"Enter":    "\r",
+	"Tab":      "\t",
+	"BTab":     "\x1b[Z",
+	"Escape":   "\x1b",
+	"Space":    " ",
+	"BSpace":   "\x7f",
+	"Up":       "\x1b[A",
+	"Down":     "\x1b[B",
+	"Right":    "\x1b[C",
+	"Left":     "\x1b[D",
+	"Home":     "\x1b[H",
+	"End":      "\x1b[F",
+	"PageUp":   "\x1b[5~",
+	"PPage":    "\x1b[5~",
+	"PageDown": "\x1b[6~",
+	"NPage":    "\x1b[6~",
+	"DC":       "\x1b[3~",
+}
+
+// keyBytes converts keys as accepted by tmux send-keys to terminal input.
+// Key names (Enter, Escape, C-c, ...) are translated; anything else is sent literally.
+func keyBytes(keys string) []byte {
+	if seq, ok := namedKeys[keys]; ok {
+		return []byte(seq)
+	}
+	if len(keys) == 3 && strings.HasPrefix(keys, "C-") {
+		c := keys[2]
+		switch {
+		case c >= 'a' && c <= 'z':
+			return []byte{c - 'a' + 1}
+		case c >= '@' && c <= '_':
+			return []byte{c - '@'}
+		}
+	}
+	return []byte(keys)
+}
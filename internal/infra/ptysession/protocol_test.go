@@ -0,0 +1,40 @@
+package ptysession
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyBytes(t *testing.T) {
+	tests := []struct {
+		keys string
+		want string
+	}{
+		{keys: "Enter", want: "\r"},
+		{keys: "Escape", want: "\x1b"},
+		{keys: "Tab", want: "\t"},
+		{keys: "Up", want: "\x1b[A"},
+		{keys: "C-c", want: "\x03"},
+		{keys: "C-[", want: "\x1b"},
+		{keys: "hello", want: "hello"},
+		{keys: "C-", want: "C-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.keys, func(t *testing.T) {
+			assert.Equal(t, tt.want, string(keyBytes(tt.keys)))
+		})
+	}
+}
+
+func TestRelayInput_StopsAtDetachKey(t *testing.T) {
+	var conn bytes.Buffer
+
+	err := relayInput(strings.NewReader("abc\x07def"), &conn)
+
+	assert.ErrorIs(t, err, errDetached)
+	assert.Equal(t, "abc", conn.String())
+}
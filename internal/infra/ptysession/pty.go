@@ -0,0 +1,13 @@
+package ptysession
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// setSize sets the window size of the pty.
+// The kernel delivers SIGWINCH to the foreground process group.
+func setSize(master *os.File, rows, cols int) error {
+	return unix.IoctlSetWinsize(int(master.Fd()), unix.TIOCSWINSZ, &unix.Winsize{Row: uint16(rows), Col: uint16(cols)}) //nolint:gosec // Sizes are bounded terminal dimensions
+}
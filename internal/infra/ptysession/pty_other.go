@@ -0,0 +1,13 @@
+//go:build !linux && !darwin
+
+package ptysession
+
+import (
+	"errors"
+	"os"
+)
+
+// openPTY is not supported on this platform.
+func openPTY() (master, slave *os.File, err error) {
+	return nil, nil, errors.New("pty sessions are not supported on this platform")
+}
@@ -0,0 +1,166 @@
+// Package recording writes session recordings in the asciicast v2 format.
+package recording
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// Recorder writes terminal output to an asciicast v2 file.
+// Each write is flushed immediately so the recording survives a killed session.
+// Fields are ordered to minimize memory padding.
+type Recorder struct {
+	start   time.Time
+	file    *os.File
+	now     func() time.Time
+	path    string
+	pending []byte // Incomplete UTF-8 sequence carried over to the next write
+	mu      sync.Mutex
+}
+
+// Create starts a new recording for the session whose base recording path is base
+// and writes its header. Every call creates its own file named after the start time
+// (see domain.RecordingRunPath), so earlier runs of the session are kept.
+func Create(base string, cols, rows int, title string) (*Recorder, error) {
+	return create(base, cols, rows, title, time.Now)
+}
+
+func create(base string, cols, rows int, title string, now func() time.Time) (*Recorder, error) {
+	if err := os.MkdirAll(filepath.Dir(base), 0o750); err != nil {
+		return nil, fmt.Errorf("create recording directory: %w", err)
+	}
+
+	start := now()
+	path, file, err := createRun(base, start)
+	if err != nil {
+		return nil, fmt.Errorf("create recording: %w", err)
+	}
+
+	header := domain.RecordingHeader{
+		Version:   domain.RecordingVersion,
+		Width:     cols,
+		Height:    rows,
+		Timestamp: start.Unix(),
+		Title:     title,
+		Env:       map[string]string{"TERM": "xterm-256color", "SHELL": os.Getenv("SHELL")},
+	}
+	if err := writeLine(file, header); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("write recording header: %w", err)
+	}
+	return &Recorder{start: start, file: file, now: now, path: path}, nil
+}
+
+// createRun creates the recording file of a run started at start.
+// Runs started within the same millisecond get the next free name.
+func createRun(base string, start time.Time) (string, *os.File, error) {
+	const maxAttempts = 100
+	var err error
+	for i := range maxAttempts {
+		path := domain.RecordingRunPath(base, start.Add(time.Duration(i)*time.Millisecond))
+		var file *os.File
+		file, err = os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			return path, file, nil
+		}
+		if !errors.Is(err, fs.ErrExist) {
+			return "", nil, err
+		}
+	}
+	return "", nil, err
+}
+
+// Path returns the path of the recording file.
+func (r *Recorder) Path() string {
+	return r.path
+}
+
+// Write records p as terminal output.
+func (r *Recorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data := append(r.pending, p...)
+	// Keep a trailing partial UTF-8 sequence for the next write so multi-byte
+	// characters split across reads are not recorded as replacement characters
+	cut := len(data)
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				cut = i
+			}
+			break
+		}
+	}
+	r.pending = append([]byte(nil), data[cut:]...)
+	if cut == 0 {
+		return len(p), nil
+	}
+	if err := r.event(domain.RecordingOutput, string(data[:cut])); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Resize records a terminal size change.
+func (r *Recorder) Resize(cols, rows int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.event(domain.RecordingResize, fmt.Sprintf("%dx%d", cols, rows))
+}
+
+// Close flushes any pending output and closes the recording.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.pending) > 0 {
+		_ = r.event(domain.RecordingOutput, string(r.pending))
+		r.pending = nil
+	}
+	return r.file.Close()
+}
+
+func (r *Recorder) event(eventType, data string) error {
+	return writeLine(r.file, domain.RecordingEvent{
+		Time: r.now().Sub(r.start).Seconds(),
+		Type: eventType,
+		Data: data,
+	})
+}
+
+// Record copies terminal output from src into a new recording for the session
+// with base recording path base until src is closed.
+// It is used to record tmux sessions through pipe-pane.
+func Record(src io.Reader, base string, cols, rows int, title string) error {
+	rec, err := Create(base, cols, rows, title)
+	if err != nil {
+		return err
+	}
+	_, copyErr := io.Copy(rec, src)
+	if err := rec.Close(); err != nil && copyErr == nil {
+		return fmt.Errorf("close recording: %w", err)
+	}
+	if copyErr != nil {
+		return fmt.Errorf("record session: %w", copyErr)
+	}
+	return nil
+}
+
+func writeLine(w io.Writer, v any) error {
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,132 @@
+package recording
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readRecording reads all events of the recording at path.
+func readRecording(t *testing.T, path string) (domain.RecordingHeader, []domain.RecordingEvent) {
+	t.Helper()
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+
+	reader, err := domain.NewRecordingReader(file)
+	require.NoError(t, err)
+	var events []domain.RecordingEvent
+	for {
+		event, err := reader.Next()
+		if err != nil {
+			break
+		}
+		events = append(events, event)
+	}
+	return reader.Header, events
+}
+
+func TestRecorder(t *testing.T) {
+	// Setup
+	path := filepath.Join(t.TempDir(), "logs", "crew-1.cast")
+	start := time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC)
+	now := start
+	rec, err := create(path, 120, 40, "crew-1", func() time.Time { return now })
+	require.NoError(t, err)
+
+	// Execute
+	now = start.Add(500 * time.Millisecond)
+	_, err = rec.Write([]byte("hello "))
+	require.NoError(t, err)
+	// "é" split across two writes
+	now = start.Add(time.Second)
+	_, err = rec.Write([]byte("caf\xc3"))
+	require.NoError(t, err)
+	_, err = rec.Write([]byte("\xa9\r\n"))
+	require.NoError(t, err)
+	require.NoError(t, rec.Resize(100, 30))
+	require.NoError(t, rec.Close())
+
+	// Assert
+	assert.Equal(t, domain.RecordingRunPath(path, start), rec.Path())
+	header, events := readRecording(t, rec.Path())
+	assert.Equal(t, domain.RecordingVersion, header.Version)
+	assert.Equal(t, 120, header.Width)
+	assert.Equal(t, 40, header.Height)
+	assert.Equal(t, start.Unix(), header.Timestamp)
+	assert.Equal(t, "crew-1", header.Title)
+	assert.Equal(t, []domain.RecordingEvent{
+		{Time: 0.5, Type: domain.RecordingOutput, Data: "hello "},
+		{Time: 1, Type: domain.RecordingOutput, Data: "caf"},
+		{Time: 1, Type: domain.RecordingOutput, Data: "é\r\n"},
+		{Time: 1, Type: domain.RecordingResize, Data: "100x30"},
+	}, events)
+}
+
+func TestRecorder_Close_FlushesPartialRune(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "crew-1.cast")
+	rec, err := Create(path, 80, 24, "")
+	require.NoError(t, err)
+
+	_, err = rec.Write([]byte("x\xe2\x82"))
+	require.NoError(t, err)
+	require.NoError(t, rec.Close())
+
+	_, events := readRecording(t, rec.Path())
+	require.Len(t, events, 2)
+	assert.Equal(t, "x", events[0].Data)
+	assert.NotEmpty(t, events[1].Data)
+}
+
+func TestRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "crew-1.cast")
+
+	err := Record(strings.NewReader("line 1\r\nline 2\r\n"), path, 80, 24, "crew-1")
+
+	require.NoError(t, err)
+	runs, err := filepath.Glob(filepath.Join(filepath.Dir(path), "crew-1.*.cast"))
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	header, events := readRecording(t, runs[0])
+	assert.Equal(t, 80, header.Width)
+	var output strings.Builder
+	for _, e := range events {
+		output.WriteString(e.Data)
+	}
+	assert.Equal(t, "line 1\r\nline 2\r\n", output.String())
+}
+
+func TestCreate_KeepsEarlierRuns(t *testing.T) {
+	// Setup
+	path := filepath.Join(t.TempDir(), "logs", "crew-1.cast")
+	start := time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC)
+	now := func() time.Time { return start }
+	first, err := create(path, 80, 24, "crew-1", now)
+	require.NoError(t, err)
+	_, err = first.Write([]byte("first run"))
+	require.NoError(t, err)
+	require.NoError(t, first.Close())
+
+	// Execute: the session is started again within the same millisecond
+	second, err := create(path, 80, 24, "crew-1", now)
+	require.NoError(t, err)
+	_, err = second.Write([]byte("second run"))
+	require.NoError(t, err)
+	require.NoError(t, second.Close())
+
+	// Assert
+	assert.NotEqual(t, first.Path(), second.Path())
+	_, firstEvents := readRecording(t, first.Path())
+	require.Len(t, firstEvents, 1)
+	assert.Equal(t, "first run", firstEvents[0].Data)
+	_, secondEvents := readRecording(t, second.Path())
+	require.Len(t, secondEvents, 1)
+	assert.Equal(t, "second run", secondEvents[0].Data)
+	assert.NoFileExists(t, path)
+}
@@ -25,6 +25,7 @@ type Client struct {
 	socketPath string   // Path to the tmux socket
 	configPath string   // Path to tmux configuration
 	crewDir    string   // Path to .crew directory
+	recorder   string   // crew binary that records sessions (empty disables recording)
 }
 
 // NewClient creates a new tmux client.
@@ -46,6 +47,16 @@ func (c *Client) SetExecFunc(fn ExecFunc) {
 	c.execFunc = fn
 }
 
+// WithRecorder enables session recording.
+// executable is the crew binary whose RecordCommand writes the recording.
+func (c *Client) WithRecorder(executable string) *Client {
+	c.recorder = executable
+	return c
+}
+
+// RecordCommand is the hidden crew command that records session output read from stdin.
+const RecordCommand = "_record"
+
 // Ensure Client implements domain.SessionManager interface.
 var _ domain.SessionManager = (*Client)(nil)
 
@@ -76,6 +87,12 @@ func (c *Client) Start(ctx context.Context, opts domain.StartSessionOptions) err
 		args = append(args, opts.Command)
 	}
 
+	// Record the pane in the same tmux invocation, so the recording starts
+	// before the server reads any output from the new pane
+	if c.recorder != "" {
+		args = append(args, ";", "pipe-pane", "-t", opts.Name, "-o", c.recordCommand(opts.Name))
+	}
+
 	cmd := exec.CommandContext(ctx, "tmux", args...)
 	cmd.Dir = opts.Dir
 
@@ -91,6 +108,21 @@ func (c *Client) Start(ctx context.Context, opts domain.StartSessionOptions) err
 	return nil
 }
 
+// recordCommand returns the pipe-pane command that records a session.
+// tmux expands the pane size formats when starting it.
+func (c *Client) recordCommand(sessionName string) string {
+	return fmt.Sprintf("exec %s %s --cols #{pane_width} --rows #{pane_height} %s",
+		shellQuote(c.recorder),
+		RecordCommand,
+		shellQuote(domain.RecordingPath(c.crewDir, sessionName)),
+	)
+}
+
+// shellQuote quotes s for use as a single shell word.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
 // Stop terminates a tmux session.
 // It first sends SIGTERM to the process group of each pane to terminate all processes
 // (including nested children like AI agents), then kills the session itself.
@@ -207,6 +239,12 @@ func (c *Client) Attach(sessionName string) error {
 	return nil
 }
 
+// AttachCommand returns the command that attaches a terminal to a session.
+// It is used by callers that must keep running while attached (e.g., the TUI).
+func (c *Client) AttachCommand(sessionName string) *domain.ExecCommand {
+	return domain.NewCommand("tmux", []string{"-S", c.socketPath, "attach", "-t", sessionName}, "")
+}
+
 // Peek captures the last N lines from a session.
 func (c *Client) Peek(sessionName string, lines int, escape bool) (string, error) {
 	// Check if session exists
@@ -57,6 +57,23 @@ func TestNewClient(t *testing.T) {
 	assert.Equal(t, crewDir, client.crewDir)
 }
 
+func TestClient_AttachCommand(t *testing.T) {
+	client := NewClient("/path/to/socket", "/path/to/crew")
+
+	cmd := client.AttachCommand("crew-1")
+
+	assert.Equal(t, "tmux", cmd.Program)
+	assert.Equal(t, []string{"-S", "/path/to/socket", "attach", "-t", "crew-1"}, cmd.Args)
+}
+
+func TestClient_RecordCommand(t *testing.T) {
+	client := NewClient("/path/to/socket", "/path/to/it's/crew").WithRecorder("/usr/bin/crew")
+
+	cmd := client.recordCommand("crew-1")
+
+	assert.Equal(t, `exec '/usr/bin/crew' _record --cols #{pane_width} --rows #{pane_height} '/path/to/it'\''s/crew/logs/crew-1.cast'`, cmd)
+}
+
 func TestClient_Start_And_IsRunning(t *testing.T) {
 	socketPath, crewDir, cleanup := setupTestEnv(t)
 	defer cleanup()
@@ -0,0 +1,181 @@
+// Package mcp serves git-crew use cases as Model Context Protocol tools over stdio.
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+)
+
+// latestProtocolVersion is the newest MCP protocol revision implemented by the server.
+const latestProtocolVersion = "2025-06-18"
+
+// supportedProtocolVersions lists the protocol revisions the server can speak.
+var supportedProtocolVersions = []string{"2024-11-05", "2025-03-26", latestProtocolVersion}
+
+// JSON-RPC error codes.
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+// request is a JSON-RPC request or notification (no ID).
+type request struct {
+	ID      json.RawMessage `json:"id,omitempty"`
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// response is a JSON-RPC response.
+type response struct {
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// rpcError is a JSON-RPC error object.
+type rpcError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+// Server serves MCP requests backed by the container's use cases.
+type Server struct {
+	container *app.Container
+	version   string
+}
+
+// New creates a new Server. version is reported to clients as the server version.
+func New(c *app.Container, version string) *Server {
+	return &Server{container: c, version: version}
+}
+
+// Serve reads newline-delimited JSON-RPC messages from r and writes responses to w
+// until r is exhausted or ctx is canceled.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	reader := bufio.NewReader(r)
+	enc := json.NewEncoder(w)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil
+		}
+
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			if resp := s.handleMessage(ctx, line); resp != nil {
+				if encErr := enc.Encode(resp); encErr != nil {
+					return fmt.Errorf("write response: %w", encErr)
+				}
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read request: %w", err)
+		}
+	}
+}
+
+// handleMessage processes a single message and returns the response to send,
+// or nil for notifications and blank lines.
+func (s *Server) handleMessage(ctx context.Context, line []byte) *response {
+	if len(bytes.TrimSpace(line)) == 0 {
+		return nil
+	}
+
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		return errorResponse(json.RawMessage("null"), &rpcError{Code: codeParseError, Message: "parse error: " + err.Error()})
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		if req.ID == nil {
+			return nil
+		}
+		return errorResponse(req.ID, &rpcError{Code: codeInvalidRequest, Message: "invalid request"})
+	}
+
+	result, err := s.dispatch(ctx, req.Method, req.Params)
+
+	// Notifications never get a response
+	if req.ID == nil {
+		return nil
+	}
+	if err != nil {
+		var rpcErr *rpcError
+		if !errors.As(err, &rpcErr) {
+			rpcErr = &rpcError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		return errorResponse(req.ID, rpcErr)
+	}
+	return &response{JSONRPC: "2.0", ID: req.ID, Result: result}
+}
+
+// dispatch routes a method to its handler.
+func (s *Server) dispatch(ctx context.Context, method string, params json.RawMessage) (any, error) {
+	switch method {
+	case "initialize":
+		return s.initialize(params)
+	case "ping":
+		return struct{}{}, nil
+	case "tools/list":
+		return map[string]any{"tools": toolDefinitions()}, nil
+	case "tools/call":
+		return s.callTool(ctx, params)
+	default:
+		if strings.HasPrefix(method, "notifications/") {
+			return nil, nil
+		}
+		return nil, &rpcError{Code: codeMethodNotFound, Message: "method not found: " + method}
+	}
+}
+
+// initialize negotiates the protocol version and advertises tool support.
+func (s *Server) initialize(params json.RawMessage) (any, error) {
+	var in struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &in); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: "invalid initialize params: " + err.Error()}
+		}
+	}
+
+	version := latestProtocolVersion
+	if slices.Contains(supportedProtocolVersions, in.ProtocolVersion) {
+		version = in.ProtocolVersion
+	}
+
+	return map[string]any{
+		"protocolVersion": version,
+		"capabilities": map[string]any{
+			"tools": map[string]any{},
+		},
+		"serverInfo": map[string]any{
+			"name":    "crew",
+			"version": s.version,
+		},
+		"instructions": "Tools for git-crew tasks. Task IDs default to the task of the current crew branch.",
+	}, nil
+}
+
+func errorResponse(id json.RawMessage, err *rpcError) *response {
+	return &response{JSONRPC: "2.0", ID: id, Error: err}
+}
@@ -0,0 +1,306 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer creates a Server backed by mock dependencies.
+func newTestServer(repo *testutil.MockTaskRepository, branch string) *Server {
+	container := app.NewWithDeps(
+		app.Config{},
+		repo,
+		&testutil.MockStoreInitializer{},
+		&testutil.MockClock{NowTime: time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC)},
+		testutil.NewMockLogger(),
+		testutil.NewMockCommandExecutor(),
+	)
+	container.Git = &testutil.MockGit{CurrentBranchName: &branch}
+	container.Worktrees = testutil.NewMockWorktreeManager()
+	container.Sessions = testutil.NewMockSessionManager()
+	return New(container, "1.2.3")
+}
+
+type testResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+	ID     json.RawMessage `json:"id"`
+}
+
+type testToolResult struct {
+	Content []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"content"`
+	IsError bool `json:"isError"`
+}
+
+// serveLines sends the messages to the server and returns the decoded responses.
+func serveLines(t *testing.T, s *Server, messages ...string) []testResponse {
+	t.Helper()
+
+	var out bytes.Buffer
+	err := s.Serve(context.Background(), strings.NewReader(strings.Join(messages, "\n")+"\n"), &out)
+	require.NoError(t, err)
+
+	var responses []testResponse
+	dec := json.NewDecoder(&out)
+	for dec.More() {
+		var resp testResponse
+		require.NoError(t, dec.Decode(&resp))
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+// callTool invokes a single tool and returns its result.
+func callTool(t *testing.T, s *Server, name, args string) testToolResult {
+	t.Helper()
+
+	msg := `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"` + name + `","arguments":` + args + `}}`
+	responses := serveLines(t, s, msg)
+	require.Len(t, responses, 1)
+	require.Nil(t, responses[0].Error)
+
+	var result testToolResult
+	require.NoError(t, json.Unmarshal(responses[0].Result, &result))
+	require.Len(t, result.Content, 1)
+	return result
+}
+
+func TestServer_Initialize(t *testing.T) {
+	// Setup
+	s := newTestServer(testutil.NewMockTaskRepository(), "main")
+
+	// Execute
+	responses := serveLines(t, s,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26","capabilities":{},"clientInfo":{"name":"test","version":"1"}}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"ping"}`,
+	)
+
+	// Assert: notification gets no response
+	require.Len(t, responses, 2)
+	var result struct {
+		ServerInfo struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"serverInfo"`
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	require.NoError(t, json.Unmarshal(responses[0].Result, &result))
+	assert.Equal(t, "2025-03-26", result.ProtocolVersion)
+	assert.Equal(t, "crew", result.ServerInfo.Name)
+	assert.Equal(t, "1.2.3", result.ServerInfo.Version)
+	assert.JSONEq(t, "2", string(responses[1].ID))
+}
+
+func TestServer_Initialize_UnknownProtocolVersion(t *testing.T) {
+	// Setup
+	s := newTestServer(testutil.NewMockTaskRepository(), "main")
+
+	// Execute
+	responses := serveLines(t, s, `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"1999-01-01"}}`)
+
+	// Assert
+	require.Len(t, responses, 1)
+	assert.Contains(t, string(responses[0].Result), latestProtocolVersion)
+}
+
+func TestServer_ToolsList(t *testing.T) {
+	// Setup
+	s := newTestServer(testutil.NewMockTaskRepository(), "main")
+
+	// Execute
+	responses := serveLines(t, s, `{"jsonrpc":"2.0","id":1,"method":"tools/list"}`)
+
+	// Assert
+	require.Len(t, responses, 1)
+	var result struct {
+		Tools []struct {
+			InputSchema map[string]any `json:"inputSchema"`
+			Name        string         `json:"name"`
+		} `json:"tools"`
+	}
+	require.NoError(t, json.Unmarshal(responses[0].Result, &result))
+	names := make([]string, 0, len(result.Tools))
+	for _, tool := range result.Tools {
+		names = append(names, tool.Name)
+		assert.Equal(t, "object", tool.InputSchema["type"], tool.Name)
+	}
+	assert.ElementsMatch(t, []string{"crew_list", "crew_show", "crew_new", "crew_comment", "crew_substate", "crew_complete"}, names)
+}
+
+func TestServer_ProtocolErrors(t *testing.T) {
+	// Setup
+	s := newTestServer(testutil.NewMockTaskRepository(), "main")
+
+	// Execute
+	responses := serveLines(t, s,
+		`not json`,
+		`{"jsonrpc":"2.0","id":2,"method":"resources/list"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"crew_unknown","arguments":{}}}`,
+	)
+
+	// Assert
+	require.Len(t, responses, 3)
+	assert.Equal(t, codeParseError, responses[0].Error.Code)
+	assert.Equal(t, codeMethodNotFound, responses[1].Error.Code)
+	assert.Equal(t, codeInvalidParams, responses[2].Error.Code)
+}
+
+func TestTool_NewAndShow(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	s := newTestServer(repo, "main")
+
+	// Execute
+	created := callTool(t, s, "crew_new", `{"title":"Add MCP","labels":["feature"]}`)
+	repo.Comments[1] = []domain.Comment{{
+		Text:     "Review",
+		Findings: []domain.ReviewFinding{{File: "mcp.go", Line: 3, EndLine: 5, Severity: domain.ReviewSeverityBlocking, Message: "Validate input"}},
+	}}
+	shown := callTool(t, s, "crew_show", `{"task_id":1}`)
+
+	// Assert
+	assert.False(t, created.IsError)
+	assert.Equal(t, "Created task #1", created.Content[0].Text)
+	assert.False(t, shown.IsError)
+	var task map[string]any
+	require.NoError(t, json.Unmarshal([]byte(shown.Content[0].Text), &task))
+	assert.Equal(t, "Add MCP", task["title"])
+	assert.Equal(t, []any{"feature"}, task["labels"])
+	comments, ok := task["comments"].([]any)
+	require.True(t, ok)
+	require.Len(t, comments, 1)
+	comment, ok := comments[0].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, []any{map[string]any{
+		"file": "mcp.go", "line": float64(3), "end_line": float64(5), "severity": "blocking", "message": "Validate input",
+	}}, comment["findings"])
+}
+
+func TestTool_Show_DefaultsToCurrentBranch(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[7] = &domain.Task{ID: 7, Title: "Branch task", Status: domain.StatusInProgress}
+	s := newTestServer(repo, "crew-7")
+
+	// Execute
+	result := callTool(t, s, "crew_show", `{}`)
+
+	// Assert
+	assert.False(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "Branch task")
+}
+
+func TestTool_Show_NotOnCrewBranch(t *testing.T) {
+	// Setup
+	s := newTestServer(testutil.NewMockTaskRepository(), "main")
+
+	// Execute
+	result := callTool(t, s, "crew_show", `{}`)
+
+	// Assert
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "task_id is required")
+}
+
+func TestTool_Show_NotFound(t *testing.T) {
+	// Setup
+	s := newTestServer(testutil.NewMockTaskRepository(), "main")
+
+	// Execute
+	result := callTool(t, s, "crew_show", `{"task_id":42}`)
+
+	// Assert
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "task not found")
+}
+
+func TestTool_List(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Open", Status: domain.StatusTodo}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Merged", Status: domain.StatusMerged}
+	s := newTestServer(repo, "main")
+
+	// Execute
+	result := callTool(t, s, "crew_list", `{}`)
+
+	// Assert
+	assert.False(t, result.IsError)
+	var tasks []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &tasks))
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "Open", tasks[0]["title"])
+}
+
+func TestTool_Comment(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task", Status: domain.StatusInProgress}
+	s := newTestServer(repo, "crew-1")
+
+	// Execute
+	result := callTool(t, s, "crew_comment", `{"message":"Implemented parser","type":"report","author":"worker"}`)
+
+	// Assert
+	assert.False(t, result.IsError)
+	require.Len(t, repo.Comments[1], 1)
+	assert.Equal(t, "Implemented parser", repo.Comments[1][0].Text)
+	assert.Equal(t, domain.CommentTypeReport, repo.Comments[1][0].Type)
+}
+
+func TestTool_Comment_UnknownArgument(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task", Status: domain.StatusInProgress}
+	s := newTestServer(repo, "crew-1")
+
+	// Execute
+	result := callTool(t, s, "crew_comment", `{"message":"x","request_changes":true}`)
+
+	// Assert
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "invalid arguments")
+	assert.Empty(t, repo.Comments[1])
+}
+
+func TestTool_Substate(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task", Status: domain.StatusInProgress}
+	s := newTestServer(repo, "main")
+
+	// Execute
+	result := callTool(t, s, "crew_substate", `{"task_id":1,"substate":"awaiting_user"}`)
+
+	// Assert
+	assert.False(t, result.IsError)
+	assert.Equal(t, domain.SubstateAwaitingUser, repo.Tasks[1].ExecutionSubstate)
+}
+
+func TestTool_Substate_Invalid(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task", Status: domain.StatusInProgress}
+	s := newTestServer(repo, "main")
+
+	// Execute
+	result := callTool(t, s, "crew_substate", `{"task_id":1,"substate":"sleeping"}`)
+
+	// Assert
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "invalid substate")
+}
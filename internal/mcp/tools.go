@@ -0,0 +1,432 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase"
+)
+
+// tool describes an MCP tool and its handler.
+type tool struct {
+	handler     func(s *Server, ctx context.Context, args json.RawMessage) (string, error)
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	InputSchema json.RawMessage `json:"inputSchema"`
+}
+
+// taskIDSchema is the JSON schema of the optional task_id argument.
+const taskIDSchema = `"task_id": {"type": "integer", "minimum": 1, "description": "Task ID (default: task of the current crew branch)"}`
+
+// tools lists the tools served by crew mcp.
+var tools = []tool{
+	{
+		Name:        "crew_list",
+		Description: "List tasks. Merged and closed tasks are omitted unless include_terminal is true.",
+		InputSchema: json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "labels": {"type": "array", "items": {"type": "string"}, "description": "Only list tasks with all of these labels"},
+    "parent_id": {"type": "integer", "minimum": 1, "description": "Only list children of this task"},
+    "include_terminal": {"type": "boolean", "description": "Include merged and closed tasks"}
+  },
+  "additionalProperties": false
+}`),
+		handler: (*Server).toolList,
+	},
+	{
+		Name:        "crew_show",
+		Description: "Show a task with its description, child tasks and comments.",
+		InputSchema: json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    ` + taskIDSchema + `,
+    "comments_by": {"type": "string", "description": "Only include comments by this author"},
+    "last_review": {"type": "boolean", "description": "Only include the latest reviewer comment"}
+  },
+  "additionalProperties": false
+}`),
+		handler: (*Server).toolShow,
+	},
+	{
+		Name:        "crew_new",
+		Description: "Create a new task.",
+		InputSchema: json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "title": {"type": "string", "description": "Task title"},
+    "description": {"type": "string", "description": "Task description (Markdown)"},
+    "labels": {"type": "array", "items": {"type": "string"}},
+    "parent_id": {"type": "integer", "minimum": 1, "description": "Parent task ID"},
+    "base_branch": {"type": "string", "description": "Base branch (default: default branch)"},
+    "stack": {"type": "boolean", "description": "Base the task on the parent task's branch (requires parent_id)"}
+  },
+  "required": ["title"],
+  "additionalProperties": false
+}`),
+		handler: (*Server).toolNew,
+	},
+	{
+		Name:        "crew_comment",
+		Description: "Add a comment to a task, e.g. a work report or a question for the manager.",
+		InputSchema: json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    ` + taskIDSchema + `,
+    "message": {"type": "string", "description": "Comment text"},
+    "type": {"type": "string", "enum": ["report", "message", "suggestion", "friction"], "description": "Comment type"},
+    "tags": {"type": "array", "items": {"type": "string"}},
+    "author": {"type": "string", "description": "Author name (e.g., worker, manager)"}
+  },
+  "required": ["message"],
+  "additionalProperties": false
+}`),
+		handler: (*Server).toolComment,
+	},
+	{
+		Name:        "crew_substate",
+		Description: "Report the execution substate of a running task.",
+		InputSchema: json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    ` + taskIDSchema + `,
+    "substate": {"type": "string", "enum": ["idle", "running", "awaiting_permission", "awaiting_user"]}
+  },
+  "required": ["substate"],
+  "additionalProperties": false
+}`),
+		handler: (*Server).toolSubstate,
+	},
+	{
+		Name:        "crew_complete",
+		Description: "Mark a task as done. Commit all changes first; review runs automatically when required and may take a while.",
+		InputSchema: json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    ` + taskIDSchema + `,
+    "comment": {"type": "string", "description": "Completion comment"}
+  },
+  "additionalProperties": false
+}`),
+		handler: (*Server).toolComplete,
+	},
+}
+
+// toolDefinitions returns the tools advertised by tools/list.
+func toolDefinitions() []tool {
+	return tools
+}
+
+// callTool executes a tools/call request.
+// Failures of the tool itself are reported in the result with isError set,
+// so the model can see and react to them.
+func (s *Server) callTool(ctx context.Context, params json.RawMessage) (any, error) {
+	var in struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(params, &in); err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "invalid tools/call params: " + err.Error()}
+	}
+
+	for _, t := range tools {
+		if t.Name != in.Name {
+			continue
+		}
+		text, err := t.handler(s, ctx, in.Arguments)
+		if err != nil {
+			return toolResult(err.Error(), true), nil
+		}
+		return toolResult(text, false), nil
+	}
+	return nil, &rpcError{Code: codeInvalidParams, Message: "unknown tool: " + in.Name}
+}
+
+func toolResult(text string, isError bool) map[string]any {
+	return map[string]any{
+		"content": []map[string]any{{"type": "text", "text": text}},
+		"isError": isError,
+	}
+}
+
+// decodeArgs decodes tool arguments into v, rejecting unknown fields.
+func decodeArgs(args json.RawMessage, v any) error {
+	if len(args) == 0 || string(args) == "null" {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(args))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+	return nil
+}
+
+// resolveTaskID returns id, or the task of the current crew branch when id is 0.
+func (s *Server) resolveTaskID(id int) (int, error) {
+	if id != 0 {
+		if id < 0 {
+			return 0, fmt.Errorf("invalid task ID: %d", id)
+		}
+		return id, nil
+	}
+
+	if s.container.Git == nil {
+		return 0, errors.New("task_id is required (not on a crew branch)")
+	}
+	branch, err := s.container.Git.CurrentBranch()
+	if err != nil {
+		return 0, fmt.Errorf("failed to detect current branch: %w", err)
+	}
+	id, ok := domain.ParseBranchTaskID(branch)
+	if !ok {
+		return 0, fmt.Errorf("task_id is required (current branch '%s' is not a crew branch)", branch)
+	}
+	return id, nil
+}
+
+// taskJSON is the JSON representation of a task returned by the tools.
+type taskJSON struct {
+	Created     time.Time     `json:"created"`
+	ParentID    *int          `json:"parent_id,omitempty"`
+	Namespace   string        `json:"namespace,omitempty"`
+	Title       string        `json:"title"`
+	Description string        `json:"description,omitempty"`
+	Status      domain.Status `json:"status"`
+	Agent       string        `json:"agent,omitempty"`
+	Branch      string        `json:"branch"`
+	BlockReason string        `json:"blockReason,omitempty"`
+	Labels      []string      `json:"labels,omitempty"`
+	DependsOn   []int         `json:"dependsOn,omitempty"`
+	ID          int           `json:"id"`
+}
+
+func toTaskJSON(task *domain.Task) taskJSON {
+	return taskJSON{
+		Created:     task.Created,
+		ParentID:    task.ParentID,
+		Namespace:   task.Namespace,
+		Title:       task.Title,
+		Description: task.Description,
+		Status:      task.Status,
+		Agent:       task.Agent,
+		Branch:      domain.BranchName(task.ID, task.Issue),
+		BlockReason: task.BlockReason,
+		Labels:      task.Labels,
+		DependsOn:   task.DependsOn,
+		ID:          task.ID,
+	}
+}
+
+func marshalText(v any) (string, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("encode result: %w", err)
+	}
+	return string(data), nil
+}
+
+func (s *Server) toolList(ctx context.Context, args json.RawMessage) (string, error) {
+	var in struct {
+		ParentID        *int     `json:"parent_id"`
+		Labels          []string `json:"labels"`
+		IncludeTerminal bool     `json:"include_terminal"`
+	}
+	if err := decodeArgs(args, &in); err != nil {
+		return "", err
+	}
+
+	out, err := s.container.ListTasksUseCase().Execute(ctx, usecase.ListTasksInput{
+		ParentID:        in.ParentID,
+		Labels:          in.Labels,
+		IncludeTerminal: in.IncludeTerminal,
+		AllNamespaces:   true,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	tasks := make([]taskJSON, 0, len(out.Tasks))
+	for _, task := range out.Tasks {
+		item := toTaskJSON(task)
+		item.Description = ""
+		tasks = append(tasks, item)
+	}
+	return marshalText(tasks)
+}
+
+func (s *Server) toolShow(ctx context.Context, args json.RawMessage) (string, error) {
+	var in struct {
+		CommentsBy string `json:"comments_by"`
+		TaskID     int    `json:"task_id"`
+		LastReview bool   `json:"last_review"`
+	}
+	if err := decodeArgs(args, &in); err != nil {
+		return "", err
+	}
+	taskID, err := s.resolveTaskID(in.TaskID)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := s.container.ShowTaskUseCase().Execute(ctx, usecase.ShowTaskInput{
+		TaskID:     taskID,
+		CommentsBy: in.CommentsBy,
+		LastReview: in.LastReview,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	type commentJSON struct {
+		Time     time.Time              `json:"time"`
+		Text     string                 `json:"text"`
+		Author   string                 `json:"author,omitempty"`
+		Type     domain.CommentType     `json:"type,omitempty"`
+		Tags     []string               `json:"tags,omitempty"`
+		Findings []domain.ReviewFinding `json:"findings,omitempty"`
+	}
+	result := struct {
+		Children []taskJSON    `json:"children,omitempty"`
+		Comments []commentJSON `json:"comments"`
+		taskJSON
+	}{
+		taskJSON: toTaskJSON(out.Task),
+		Comments: make([]commentJSON, 0, len(out.Comments)),
+	}
+	for _, child := range out.Children {
+		result.Children = append(result.Children, toTaskJSON(child))
+	}
+	for _, comment := range out.Comments {
+		result.Comments = append(result.Comments, commentJSON{
+			Time:     comment.Time,
+			Text:     comment.Text,
+			Author:   comment.Author,
+			Type:     comment.Type,
+			Tags:     comment.Tags,
+			Findings: comment.Findings,
+		})
+	}
+	return marshalText(result)
+}
+
+func (s *Server) toolNew(ctx context.Context, args json.RawMessage) (string, error) {
+	var in struct {
+		ParentID    *int     `json:"parent_id"`
+		Title       string   `json:"title"`
+		Description string   `json:"description"`
+		BaseBranch  string   `json:"base_branch"`
+		Labels      []string `json:"labels"`
+		Stack       bool     `json:"stack"`
+	}
+	if err := decodeArgs(args, &in); err != nil {
+		return "", err
+	}
+
+	out, err := s.container.NewTaskUseCase().Execute(ctx, usecase.NewTaskInput{
+		ParentID:    in.ParentID,
+		Title:       in.Title,
+		Description: in.Description,
+		BaseBranch:  in.BaseBranch,
+		Labels:      in.Labels,
+		Stack:       in.Stack,
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Created task #%d", out.TaskID), nil
+}
+
+func (s *Server) toolComment(ctx context.Context, args json.RawMessage) (string, error) {
+	var in struct {
+		Message string             `json:"message"`
+		Author  string             `json:"author"`
+		Type    domain.CommentType `json:"type"`
+		Tags    []string           `json:"tags"`
+		TaskID  int                `json:"task_id"`
+	}
+	if err := decodeArgs(args, &in); err != nil {
+		return "", err
+	}
+	taskID, err := s.resolveTaskID(in.TaskID)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = s.container.AddCommentUseCase().Execute(ctx, usecase.AddCommentInput{
+		TaskID:  taskID,
+		Message: in.Message,
+		Author:  in.Author,
+		Type:    in.Type,
+		Tags:    in.Tags,
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Added comment to task #%d", taskID), nil
+}
+
+func (s *Server) toolSubstate(ctx context.Context, args json.RawMessage) (string, error) {
+	var in struct {
+		Substate domain.ExecutionSubstate `json:"substate"`
+		TaskID   int                      `json:"task_id"`
+	}
+	if err := decodeArgs(args, &in); err != nil {
+		return "", err
+	}
+	if !in.Substate.IsValid() {
+		return "", fmt.Errorf("invalid substate: %q: %w", in.Substate, domain.ErrInvalidExecutionSubstate)
+	}
+	taskID, err := s.resolveTaskID(in.TaskID)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = s.container.SetSubstateUseCase().Execute(ctx, usecase.SetSubstateInput{
+		TaskID:   taskID,
+		Substate: in.Substate,
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Task #%d substate: %s", taskID, in.Substate), nil
+}
+
+func (s *Server) toolComplete(ctx context.Context, args json.RawMessage) (string, error) {
+	var in struct {
+		Comment string `json:"comment"`
+		TaskID  int    `json:"task_id"`
+	}
+	if err := decodeArgs(args, &in); err != nil {
+		return "", err
+	}
+	taskID, err := s.resolveTaskID(in.TaskID)
+	if err != nil {
+		return "", err
+	}
+
+	// stdout is the protocol channel, so use case output must not reach it
+	uc := s.container.CompleteTaskUseCase(io.Discard, io.Discard)
+	out, err := uc.Execute(ctx, usecase.CompleteTaskInput{
+		TaskID:  taskID,
+		Comment: in.Comment,
+	})
+	if err != nil {
+		if out != nil && out.ConflictMessage != "" {
+			return "", fmt.Errorf("%w\n\n%s", err, out.ConflictMessage)
+		}
+		return "", err
+	}
+
+	text := fmt.Sprintf("Completed task #%d: %s", out.Task.ID, out.Task.Title)
+	if out.ReviewResult != "" {
+		text += "\n\nReview result:\n" + out.ReviewResult
+	}
+	return text, nil
+}
@@ -0,0 +1,238 @@
+// Package server exposes git-crew use cases over a local HTTP/JSON API.
+package server
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+//go:embed openapi.yaml
+var openAPISpec []byte
+
+// shutdownTimeout bounds how long in-flight requests may run after the server is stopped.
+const shutdownTimeout = 5 * time.Second
+
+// errBadRequest marks request decoding and parameter errors.
+var errBadRequest = errors.New("bad request")
+
+// Server serves the HTTP/JSON API backed by the container's use cases.
+type Server struct {
+	container *app.Container
+}
+
+// New creates a new Server.
+func New(c *app.Container) *Server {
+	return &Server{container: c}
+}
+
+// Handler returns the HTTP handler with all API routes registered.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /openapi.yaml", s.handleOpenAPI)
+	mux.HandleFunc("GET /api/v1/tasks", s.handleListTasks)
+	mux.HandleFunc("POST /api/v1/tasks", s.handleNewTask)
+	mux.HandleFunc("GET /api/v1/tasks/{id}", s.handleShowTask)
+	mux.HandleFunc("PATCH /api/v1/tasks/{id}", s.handleEditTask)
+	mux.HandleFunc("POST /api/v1/tasks/{id}/start", s.handleStartTask)
+	mux.HandleFunc("POST /api/v1/tasks/{id}/stop", s.handleStopTask)
+	mux.HandleFunc("POST /api/v1/tasks/{id}/complete", s.handleCompleteTask)
+	mux.HandleFunc("POST /api/v1/tasks/{id}/merge", s.handleMergeTask)
+	mux.HandleFunc("POST /api/v1/tasks/{id}/comments", s.handleAddComment)
+	mux.HandleFunc("GET /api/v1/tasks/{id}/peek", s.handlePeekSession)
+	return requireJSONBody(mux)
+}
+
+// Serve accepts connections on l until ctx is canceled.
+// TCP listeners only accept requests addressed to a loopback host, which
+// protects the API against DNS rebinding from web pages.
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	handler := s.Handler()
+	if _, ok := l.(*net.UnixListener); !ok {
+		handler = requireLoopbackHost(handler)
+	}
+
+	srv := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(l)
+	}()
+
+	select {
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			return fmt.Errorf("shutdown server: %w", err)
+		}
+		return nil
+	}
+}
+
+// IsLoopbackHost reports whether host (without port) refers to the local machine.
+func IsLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// requireJSONBody rejects requests with a body that is not declared as JSON.
+// Browsers cannot send such requests cross-origin without a CORS preflight,
+// which this server never approves.
+func requireJSONBody(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+			mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			if err != nil || mediaType != "application/json" {
+				writeJSON(w, http.StatusUnsupportedMediaType, errorResponse{Error: "content type must be application/json"})
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requireLoopbackHost rejects requests whose Host header is not a loopback address.
+func requireLoopbackHost(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.Host)
+		if err != nil {
+			host = r.Host
+		}
+		if !IsLoopbackHost(host) {
+			writeJSON(w, http.StatusForbidden, errorResponse{Error: "host not allowed"})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/yaml")
+	_, _ = w.Write(openAPISpec)
+}
+
+// errorResponse is the body of every error response.
+type errorResponse struct {
+	Error           string `json:"error"`
+	ConflictMessage string `json:"conflictMessage,omitempty"`
+}
+
+// writeJSON writes v as a JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes err as a JSON error response.
+// conflictMessage is included for merge conflicts reported by complete and merge.
+func writeError(w http.ResponseWriter, err error, conflictMessage string) {
+	writeJSON(w, errorStatus(err), errorResponse{Error: err.Error(), ConflictMessage: conflictMessage})
+}
+
+// errorStatus maps domain errors to HTTP status codes.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, errBadRequest),
+		errors.Is(err, domain.ErrEmptyTitle),
+		errors.Is(err, domain.ErrEmptyMessage),
+		errors.Is(err, domain.ErrInvalidStatus),
+		errors.Is(err, domain.ErrInvalidMergeStrategy),
+		errors.Is(err, domain.ErrInvalidCommentType),
+		errors.Is(err, domain.ErrInvalidParentID),
+		errors.Is(err, domain.ErrNoFieldsToUpdate),
+		errors.Is(err, domain.ErrParentNotFound),
+		errors.Is(err, domain.ErrCannotStack),
+		errors.Is(err, domain.ErrCircularReference),
+		errors.Is(err, domain.ErrDependencyNotFound),
+		errors.Is(err, domain.ErrDependencyCycle),
+		errors.Is(err, domain.ErrNoAgent),
+		errors.Is(err, domain.ErrAgentNotFound),
+		errors.Is(err, domain.ErrAgentDisabled):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrTaskNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrInvalidTransition),
+		errors.Is(err, domain.ErrSessionRunning),
+		errors.Is(err, domain.ErrNoSession),
+		errors.Is(err, domain.ErrTaskBlocked),
+		errors.Is(err, domain.ErrUnfinishedDependencies),
+		errors.Is(err, domain.ErrUncommittedChanges),
+		errors.Is(err, domain.ErrMergeConflict),
+		errors.Is(err, domain.ErrGateFailed),
+		errors.Is(err, domain.ErrIssueAlreadyLinked),
+		errors.Is(err, domain.ErrNotOnBaseBranch):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// decodeBody decodes the JSON request body into v.
+// An empty body leaves v unchanged.
+func decodeBody(r *http.Request, v any) error {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: invalid JSON body: %w", errBadRequest, err)
+	}
+	return nil
+}
+
+// pathTaskID parses the {id} path parameter.
+func pathTaskID(r *http.Request) (int, error) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("%w: invalid task ID %q", errBadRequest, r.PathValue("id"))
+	}
+	return id, nil
+}
+
+// queryBool parses a boolean query parameter; a missing parameter is false.
+func queryBool(r *http.Request, name string) (bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%w: invalid %s: %q", errBadRequest, name, value)
+	}
+	return b, nil
+}
+
+// queryInt parses an integer query parameter; a missing parameter is 0.
+func queryInt(r *http.Request, name string) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid %s: %q", errBadRequest, name, value)
+	}
+	return n, nil
+}
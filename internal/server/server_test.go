@@ -0,0 +1,402 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/app"
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer creates a Server backed by mock dependencies.
+func newTestServer(repo *testutil.MockTaskRepository) (*Server, *testutil.MockSessionManager) {
+	container := app.NewWithDeps(
+		app.Config{},
+		repo,
+		&testutil.MockStoreInitializer{},
+		&testutil.MockClock{NowTime: time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC)},
+		testutil.NewMockLogger(),
+		testutil.NewMockCommandExecutor(),
+	)
+	sessions := testutil.NewMockSessionManager()
+	container.Git = &testutil.MockGit{}
+	container.Worktrees = testutil.NewMockWorktreeManager()
+	container.Sessions = sessions
+	return New(container), sessions
+}
+
+func doRequest(t *testing.T, s *Server, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var req *http.Request
+	if body == "" {
+		req = httptest.NewRequest(method, path, nil)
+	} else {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+	}
+	if method == http.MethodPost || method == http.MethodPatch {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeResponse(t *testing.T, rec *httptest.ResponseRecorder) map[string]any {
+	t.Helper()
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body), rec.Body.String())
+	return body
+}
+
+func TestServer_ListTasks(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Open", Status: domain.StatusTodo, Labels: []string{"bug"}}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Merged", Status: domain.StatusMerged}
+	s, _ := newTestServer(repo)
+
+	// Execute
+	rec := doRequest(t, s, http.MethodGet, "/api/v1/tasks", "")
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+	tasks, ok := decodeResponse(t, rec)["tasks"].([]any)
+	require.True(t, ok)
+	require.Len(t, tasks, 1)
+	task, ok := tasks[0].(map[string]any)
+	require.True(t, ok)
+	assert.InDelta(t, 1, task["id"], 0)
+	assert.Equal(t, "Open", task["title"])
+	assert.Equal(t, "To Do", task["statusDisplay"])
+	assert.Equal(t, []any{"bug"}, task["labels"])
+}
+
+func TestServer_ListTasks_IncludeTerminal(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Open", Status: domain.StatusTodo}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Merged", Status: domain.StatusMerged}
+	s, _ := newTestServer(repo)
+
+	// Execute
+	rec := doRequest(t, s, http.MethodGet, "/api/v1/tasks?all=true", "")
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+	tasks, ok := decodeResponse(t, rec)["tasks"].([]any)
+	require.True(t, ok)
+	assert.Len(t, tasks, 2)
+}
+
+func TestServer_ListTasks_InvalidQuery(t *testing.T) {
+	// Setup
+	s, _ := newTestServer(testutil.NewMockTaskRepository())
+
+	// Execute
+	rec := doRequest(t, s, http.MethodGet, "/api/v1/tasks?all=maybe", "")
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, decodeResponse(t, rec)["error"], "invalid all")
+}
+
+func TestServer_NewTask(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	s, _ := newTestServer(repo)
+
+	// Execute
+	rec := doRequest(t, s, http.MethodPost, "/api/v1/tasks", `{"title":"Add API","labels":["feature"]}`)
+
+	// Assert
+	require.Equal(t, http.StatusCreated, rec.Code)
+	body := decodeResponse(t, rec)
+	assert.InDelta(t, 1, body["id"], 0)
+	assert.Equal(t, "Add API", body["title"])
+	require.NotNil(t, repo.Tasks[1])
+	assert.Equal(t, []string{"feature"}, repo.Tasks[1].Labels)
+}
+
+func TestServer_NewTask_IssueAlreadyLinked(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+	}{
+		{name: "without force", body: `{"title":"Second take","issue":42}`, wantCode: http.StatusConflict},
+		{name: "with force", body: `{"title":"Second take","issue":42,"force":true}`, wantCode: http.StatusCreated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			repo := testutil.NewMockTaskRepository()
+			repo.Tasks[1] = &domain.Task{ID: 1, Title: "Existing", Issue: 42, Status: domain.StatusInProgress}
+			repo.NextIDN = 2
+			s, _ := newTestServer(repo)
+
+			// Execute
+			rec := doRequest(t, s, http.MethodPost, "/api/v1/tasks", tt.body)
+
+			// Assert
+			require.Equal(t, tt.wantCode, rec.Code, rec.Body.String())
+			if tt.wantCode == http.StatusCreated {
+				assert.Equal(t, 42, repo.Tasks[2].Issue)
+			} else {
+				assert.Len(t, repo.Tasks, 1)
+			}
+		})
+	}
+}
+
+func TestServer_NewTask_EmptyTitle(t *testing.T) {
+	// Setup
+	s, _ := newTestServer(testutil.NewMockTaskRepository())
+
+	// Execute
+	rec := doRequest(t, s, http.MethodPost, "/api/v1/tasks", `{"title":""}`)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestServer_NewTask_UnknownField(t *testing.T) {
+	// Setup
+	s, _ := newTestServer(testutil.NewMockTaskRepository())
+
+	// Execute
+	rec := doRequest(t, s, http.MethodPost, "/api/v1/tasks", `{"title":"x","bogus":1}`)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, decodeResponse(t, rec)["error"], "invalid JSON body")
+}
+
+func TestServer_NewTask_RequiresJSONContentType(t *testing.T) {
+	// Setup
+	s, _ := newTestServer(testutil.NewMockTaskRepository())
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks", strings.NewReader("title=x"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+
+	// Execute
+	s.Handler().ServeHTTP(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+}
+
+func TestServer_ShowTask(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	parentID := 1
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Parent", Status: domain.StatusTodo}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Child", Status: domain.StatusTodo, ParentID: &parentID}
+	repo.Comments[1] = []domain.Comment{{
+		Text:     "Looks good",
+		Author:   "reviewer",
+		Findings: []domain.ReviewFinding{{File: "api.go", Line: 10, EndLine: 12, Severity: domain.ReviewSeverityNit, Message: "Rename"}},
+	}}
+	s, _ := newTestServer(repo)
+
+	// Execute
+	rec := doRequest(t, s, http.MethodGet, "/api/v1/tasks/1", "")
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := decodeResponse(t, rec)
+	assert.Equal(t, "Parent", body["title"])
+	assert.Equal(t, "crew-1", body["branch"])
+	children, ok := body["children"].([]any)
+	require.True(t, ok)
+	assert.Len(t, children, 1)
+	comments, ok := body["comments"].([]any)
+	require.True(t, ok)
+	require.Len(t, comments, 1)
+	comment, ok := comments[0].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "Looks good", comment["text"])
+	assert.Equal(t, []any{map[string]any{
+		"file": "api.go", "line": float64(10), "end_line": float64(12), "severity": "nit", "message": "Rename",
+	}}, comment["findings"])
+}
+
+func TestServer_ShowTask_NotFound(t *testing.T) {
+	// Setup
+	s, _ := newTestServer(testutil.NewMockTaskRepository())
+
+	// Execute
+	rec := doRequest(t, s, http.MethodGet, "/api/v1/tasks/42", "")
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "task not found", decodeResponse(t, rec)["error"])
+}
+
+func TestServer_ShowTask_InvalidID(t *testing.T) {
+	// Setup
+	s, _ := newTestServer(testutil.NewMockTaskRepository())
+
+	// Execute
+	rec := doRequest(t, s, http.MethodGet, "/api/v1/tasks/abc", "")
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestServer_EditTask(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Old", Status: domain.StatusTodo, Labels: []string{"a"}}
+	s, _ := newTestServer(repo)
+
+	// Execute
+	rec := doRequest(t, s, http.MethodPatch, "/api/v1/tasks/1", `{"title":"New","labels":[]}`)
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "New", repo.Tasks[1].Title)
+	assert.Empty(t, repo.Tasks[1].Labels)
+}
+
+func TestServer_EditTask_DependsOn(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "First", Status: domain.StatusTodo}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Second", Status: domain.StatusTodo}
+	repo.Tasks[3] = &domain.Task{ID: 3, Title: "Third", Status: domain.StatusTodo, DependsOn: []int{1}}
+	s, _ := newTestServer(repo)
+
+	// Execute
+	rec := doRequest(t, s, http.MethodPatch, "/api/v1/tasks/3", `{"addDependsOn":[2],"removeDependsOn":[1]}`)
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, []int{2}, repo.Tasks[3].DependsOn)
+	assert.Equal(t, []any{float64(2)}, decodeResponse(t, rec)["dependsOn"])
+}
+
+func TestServer_EditTask_DependsOnUnknownTask(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "First", Status: domain.StatusTodo}
+	s, _ := newTestServer(repo)
+
+	// Execute
+	rec := doRequest(t, s, http.MethodPatch, "/api/v1/tasks/1", `{"addDependsOn":[9]}`)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Empty(t, repo.Tasks[1].DependsOn)
+}
+
+func TestServer_EditTask_NoFields(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Old", Status: domain.StatusTodo}
+	s, _ := newTestServer(repo)
+
+	// Execute
+	rec := doRequest(t, s, http.MethodPatch, "/api/v1/tasks/1", `{}`)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestServer_AddComment(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task", Status: domain.StatusTodo}
+	s, _ := newTestServer(repo)
+
+	// Execute
+	rec := doRequest(t, s, http.MethodPost, "/api/v1/tasks/1/comments", `{"message":"Please add tests","author":"manager"}`)
+
+	// Assert
+	require.Equal(t, http.StatusCreated, rec.Code)
+	body := decodeResponse(t, rec)
+	assert.Equal(t, "Please add tests", body["text"])
+	assert.Equal(t, false, body["sessionStarted"])
+	require.Len(t, repo.Comments[1], 1)
+	assert.Equal(t, "manager", repo.Comments[1][0].Author)
+}
+
+func TestServer_PeekSession(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task", Status: domain.StatusInProgress}
+	s, sessions := newTestServer(repo)
+	sessions.IsRunningVal = true
+	sessions.PeekOutput = "hello"
+
+	// Execute
+	rec := doRequest(t, s, http.MethodGet, "/api/v1/tasks/1/peek?lines=5", "")
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "hello", decodeResponse(t, rec)["output"])
+	assert.Equal(t, 5, sessions.PeekLines)
+}
+
+func TestServer_PeekSession_NoSession(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task", Status: domain.StatusTodo}
+	s, _ := newTestServer(repo)
+
+	// Execute
+	rec := doRequest(t, s, http.MethodGet, "/api/v1/tasks/1/peek", "")
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestServer_OpenAPI(t *testing.T) {
+	// Setup
+	s, _ := newTestServer(testutil.NewMockTaskRepository())
+
+	// Execute
+	rec := doRequest(t, s, http.MethodGet, "/openapi.yaml", "")
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "openapi: 3.1.0")
+	assert.Contains(t, rec.Body.String(), "/api/v1/tasks/{id}/merge:")
+}
+
+func TestRequireLoopbackHost(t *testing.T) {
+	handler := requireLoopbackHost(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		host string
+		want int
+	}{
+		{"127.0.0.1:7777", http.StatusOK},
+		{"localhost:7777", http.StatusOK},
+		{"[::1]:7777", http.StatusOK},
+		{"localhost", http.StatusOK},
+		{"evil.example.com:7777", http.StatusForbidden},
+		{"192.168.1.10", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks", nil)
+			req.Host = tt.host
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,521 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
+)
+
+// taskResponse is the JSON representation of a task.
+// Field names match `crew show --json`.
+type taskResponse struct {
+	Created           time.Time                `json:"created"`
+	Started           *time.Time               `json:"started,omitempty"`
+	LastReviewAt      *time.Time               `json:"lastReviewAt,omitempty"`
+	ParentID          *int                     `json:"parent_id"`
+	SkipReview        *bool                    `json:"skipReview,omitempty"`
+	LastReviewIsLGTM  *bool                    `json:"lastReviewIsLGTM,omitempty"`
+	Namespace         string                   `json:"namespace,omitempty"`
+	Branch            string                   `json:"branch"`
+	BaseBranch        string                   `json:"baseBranch"`
+	Agent             string                   `json:"agent"`
+	Session           string                   `json:"session,omitempty"`
+	Status            domain.Status            `json:"status"`
+	StatusDisplay     string                   `json:"statusDisplay"`
+	ExecutionSubstate domain.ExecutionSubstate `json:"execution_substate,omitempty"`
+	Stalled           domain.StallReason       `json:"stalled,omitempty"`
+	BlockReason       string                   `json:"blockReason,omitempty"`
+	Title             string                   `json:"title"`
+	Description       string                   `json:"description"`
+	Labels            []string                 `json:"labels"`
+	DependsOn         []int                    `json:"dependsOn,omitempty"`
+	ID                int                      `json:"id"`
+	Issue             int                      `json:"issue"`
+	PR                int                      `json:"pr,omitempty"`
+	ReviewCount       int                      `json:"reviewCount"`
+	RestartCount      int                      `json:"restartCount,omitempty"`
+}
+
+// commentResponse is the JSON representation of a task comment.
+type commentResponse struct {
+	Time     time.Time              `json:"time"`
+	Metadata map[string]string      `json:"metadata,omitempty"`
+	Text     string                 `json:"text"`
+	Author   string                 `json:"author,omitempty"`
+	Type     domain.CommentType     `json:"type,omitempty"`
+	Tags     []string               `json:"tags,omitempty"`
+	Findings []domain.ReviewFinding `json:"findings,omitempty"`
+}
+
+// listItemResponse is a task in the list response, with optional session info.
+type listItemResponse struct {
+	Running *bool `json:"running,omitempty"`
+	taskResponse
+}
+
+type listTasksResponse struct {
+	Tasks []listItemResponse `json:"tasks"`
+}
+
+type showTaskResponse struct {
+	Children []taskResponse    `json:"children"`
+	Comments []commentResponse `json:"comments"`
+	taskResponse
+}
+
+type newTaskRequest struct {
+	ParentID    *int     `json:"parent_id"`
+	SkipReview  *bool    `json:"skipReview"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	BaseBranch  string   `json:"baseBranch"`
+	Labels      []string `json:"labels"`
+	Issue       int      `json:"issue"`
+	Stack       bool     `json:"stack"`
+	Force       bool     `json:"force"`
+}
+
+type editTaskRequest struct {
+	Title           *string        `json:"title"`
+	Description     *string        `json:"description"`
+	Status          *domain.Status `json:"status"`
+	SkipReview      *bool          `json:"skipReview"`
+	ParentID        *int           `json:"parent_id"`
+	BlockReason     *string        `json:"blockReason"`
+	Labels          *[]string      `json:"labels"`
+	AddLabels       []string       `json:"addLabels"`
+	RemoveLabels    []string       `json:"removeLabels"`
+	AddDependsOn    []int          `json:"addDependsOn"`
+	RemoveDependsOn []int          `json:"removeDependsOn"`
+}
+
+type startTaskRequest struct {
+	SkipReview *bool    `json:"skipReview"`
+	Agent      string   `json:"agent"`
+	Model      string   `json:"model"`
+	Prompts    []string `json:"prompts"`
+	Continue   bool     `json:"continue"`
+}
+
+type startTaskResponse struct {
+	Session  string `json:"session"`
+	Worktree string `json:"worktree"`
+}
+
+type completeTaskRequest struct {
+	Comment     string `json:"comment"`
+	Reviewer    string `json:"reviewer"`
+	ForceReview bool   `json:"forceReview"`
+}
+
+type completeTaskResponse struct {
+	ReviewResult string `json:"reviewResult,omitempty"`
+	taskResponse
+}
+
+type mergeTaskRequest struct {
+	BaseBranch string `json:"baseBranch"`
+	Strategy   string `json:"strategy"`
+}
+
+type mergeTaskResponse struct {
+	Strategy       string `json:"strategy"`
+	SyncError      string `json:"syncError,omitempty"`
+	UnblockedTasks []int  `json:"unblockedTasks"`
+	taskResponse
+}
+
+type addCommentRequest struct {
+	Metadata       map[string]string  `json:"metadata"`
+	Message        string             `json:"message"`
+	Author         string             `json:"author"`
+	Type           domain.CommentType `json:"type"`
+	Tags           []string           `json:"tags"`
+	RequestChanges bool               `json:"requestChanges"`
+}
+
+type addCommentResponse struct {
+	commentResponse
+	SessionStarted bool `json:"sessionStarted"`
+}
+
+type peekSessionResponse struct {
+	Output string `json:"output"`
+}
+
+func (s *Server) handleListTasks(w http.ResponseWriter, r *http.Request) {
+	in := usecase.ListTasksInput{
+		Labels:        r.URL.Query()["label"],
+		AllNamespaces: true,
+	}
+	var err error
+	if in.IncludeTerminal, err = queryBool(r, "all"); err != nil {
+		writeError(w, err, "")
+		return
+	}
+	if in.IncludeSessions, err = queryBool(r, "sessions"); err != nil {
+		writeError(w, err, "")
+		return
+	}
+	if r.URL.Query().Has("parent") {
+		parentID, parseErr := queryInt(r, "parent")
+		if parseErr != nil {
+			writeError(w, parseErr, "")
+			return
+		}
+		in.ParentID = &parentID
+	}
+
+	out, err := s.container.ListTasksUseCase().Execute(r.Context(), in)
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+
+	resp := listTasksResponse{Tasks: make([]listItemResponse, 0, len(out.Tasks)+len(out.TasksWithInfo))}
+	for _, task := range out.Tasks {
+		resp.Tasks = append(resp.Tasks, listItemResponse{taskResponse: toTaskResponse(task)})
+	}
+	for _, info := range out.TasksWithInfo {
+		if info.Task == nil {
+			continue
+		}
+		running := info.IsRunning
+		resp.Tasks = append(resp.Tasks, listItemResponse{taskResponse: toTaskResponse(info.Task), Running: &running})
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) handleNewTask(w http.ResponseWriter, r *http.Request) {
+	var req newTaskRequest
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err, "")
+		return
+	}
+
+	out, err := s.container.NewTaskUseCase().Execute(r.Context(), usecase.NewTaskInput{
+		ParentID:    req.ParentID,
+		SkipReview:  req.SkipReview,
+		Title:       req.Title,
+		Description: req.Description,
+		BaseBranch:  req.BaseBranch,
+		Labels:      req.Labels,
+		Issue:       req.Issue,
+		Stack:       req.Stack,
+		Force:       req.Force,
+	})
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+
+	task, err := shared.GetTask(s.container.Tasks, out.TaskID)
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+	writeJSON(w, http.StatusCreated, toTaskResponse(task))
+}
+
+func (s *Server) handleShowTask(w http.ResponseWriter, r *http.Request) {
+	taskID, err := pathTaskID(r)
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+	lastReview, err := queryBool(r, "last_review")
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+
+	out, err := s.container.ShowTaskUseCase().Execute(r.Context(), usecase.ShowTaskInput{
+		TaskID:     taskID,
+		CommentsBy: r.URL.Query().Get("comments_by"),
+		LastReview: lastReview,
+	})
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+
+	resp := showTaskResponse{
+		taskResponse: toTaskResponse(out.Task),
+		Children:     make([]taskResponse, 0, len(out.Children)),
+		Comments:     make([]commentResponse, 0, len(out.Comments)),
+	}
+	for _, child := range out.Children {
+		resp.Children = append(resp.Children, toTaskResponse(child))
+	}
+	for _, comment := range out.Comments {
+		resp.Comments = append(resp.Comments, toCommentResponse(comment))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) handleEditTask(w http.ResponseWriter, r *http.Request) {
+	taskID, err := pathTaskID(r)
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+	var req editTaskRequest
+	if err = decodeBody(r, &req); err != nil {
+		writeError(w, err, "")
+		return
+	}
+
+	in := usecase.EditTaskInput{
+		TaskID:          taskID,
+		Title:           req.Title,
+		Description:     req.Description,
+		Status:          req.Status,
+		SkipReview:      req.SkipReview,
+		ParentID:        req.ParentID,
+		BlockReason:     req.BlockReason,
+		AddLabels:       req.AddLabels,
+		RemoveLabels:    req.RemoveLabels,
+		AddDependsOn:    req.AddDependsOn,
+		RemoveDependsOn: req.RemoveDependsOn,
+	}
+	if req.Labels != nil {
+		in.Labels = *req.Labels
+		in.LabelsSet = true
+	}
+
+	out, err := s.container.EditTaskUseCase().Execute(r.Context(), in)
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+	writeJSON(w, http.StatusOK, toTaskResponse(out.Task))
+}
+
+func (s *Server) handleStartTask(w http.ResponseWriter, r *http.Request) {
+	taskID, err := pathTaskID(r)
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+	var req startTaskRequest
+	if err = decodeBody(r, &req); err != nil {
+		writeError(w, err, "")
+		return
+	}
+
+	out, err := s.container.StartTaskUseCase().Execute(r.Context(), usecase.StartTaskInput{
+		TaskID:            taskID,
+		SkipReview:        req.SkipReview,
+		Agent:             req.Agent,
+		Model:             req.Model,
+		AdditionalPrompts: req.Prompts,
+		Continue:          req.Continue,
+	})
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+	writeJSON(w, http.StatusOK, startTaskResponse{Session: out.SessionName, Worktree: out.WorktreePath})
+}
+
+func (s *Server) handleStopTask(w http.ResponseWriter, r *http.Request) {
+	taskID, err := pathTaskID(r)
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+
+	out, err := s.container.StopTaskUseCase().Execute(r.Context(), usecase.StopTaskInput{TaskID: taskID})
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+	writeJSON(w, http.StatusOK, toTaskResponse(out.Task))
+}
+
+func (s *Server) handleCompleteTask(w http.ResponseWriter, r *http.Request) {
+	taskID, err := pathTaskID(r)
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+	var req completeTaskRequest
+	if err = decodeBody(r, &req); err != nil {
+		writeError(w, err, "")
+		return
+	}
+
+	uc := s.container.CompleteTaskUseCase(io.Discard, io.Discard)
+	out, err := uc.Execute(r.Context(), usecase.CompleteTaskInput{
+		TaskID:      taskID,
+		Comment:     req.Comment,
+		ReviewAgent: req.Reviewer,
+		ForceReview: req.ForceReview,
+	})
+	if err != nil {
+		conflictMessage := ""
+		if out != nil {
+			conflictMessage = out.ConflictMessage
+		}
+		writeError(w, err, conflictMessage)
+		return
+	}
+	writeJSON(w, http.StatusOK, completeTaskResponse{
+		taskResponse: toTaskResponse(out.Task),
+		ReviewResult: out.ReviewResult,
+	})
+}
+
+func (s *Server) handleMergeTask(w http.ResponseWriter, r *http.Request) {
+	taskID, err := pathTaskID(r)
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+	var req mergeTaskRequest
+	if err = decodeBody(r, &req); err != nil {
+		writeError(w, err, "")
+		return
+	}
+
+	out, err := s.container.MergeTaskUseCase(io.Discard).Execute(r.Context(), usecase.MergeTaskInput{
+		TaskID:     taskID,
+		BaseBranch: req.BaseBranch,
+		Strategy:   domain.MergeStrategy(req.Strategy),
+	})
+	if err != nil {
+		conflictMessage := ""
+		if out != nil {
+			conflictMessage = out.ConflictMessage
+		}
+		writeError(w, err, conflictMessage)
+		return
+	}
+
+	unblocked := out.UnblockedTasks
+	if unblocked == nil {
+		unblocked = []int{}
+	}
+	writeJSON(w, http.StatusOK, mergeTaskResponse{
+		taskResponse:   toTaskResponse(out.Task),
+		Strategy:       string(out.Strategy),
+		SyncError:      out.SyncError,
+		UnblockedTasks: unblocked,
+	})
+}
+
+func (s *Server) handleAddComment(w http.ResponseWriter, r *http.Request) {
+	taskID, err := pathTaskID(r)
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+	var req addCommentRequest
+	if err = decodeBody(r, &req); err != nil {
+		writeError(w, err, "")
+		return
+	}
+
+	out, err := s.container.AddCommentUseCase().Execute(r.Context(), usecase.AddCommentInput{
+		TaskID:         taskID,
+		Message:        req.Message,
+		Author:         req.Author,
+		Type:           req.Type,
+		Metadata:       req.Metadata,
+		Tags:           req.Tags,
+		RequestChanges: req.RequestChanges,
+	})
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+	writeJSON(w, http.StatusCreated, addCommentResponse{
+		commentResponse: toCommentResponse(out.Comment),
+		SessionStarted:  out.SessionStarted,
+	})
+}
+
+func (s *Server) handlePeekSession(w http.ResponseWriter, r *http.Request) {
+	taskID, err := pathTaskID(r)
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+	lines, err := queryInt(r, "lines")
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+	escape, err := queryBool(r, "escape")
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+
+	out, err := s.container.PeekSessionUseCase().Execute(r.Context(), usecase.PeekSessionInput{
+		TaskID: taskID,
+		Lines:  lines,
+		Escape: escape,
+	})
+	if err != nil {
+		writeError(w, err, "")
+		return
+	}
+	writeJSON(w, http.StatusOK, peekSessionResponse{Output: out.Output})
+}
+
+// toTaskResponse converts a domain task to its JSON representation.
+func toTaskResponse(task *domain.Task) taskResponse {
+	resp := taskResponse{
+		Created:           task.Created,
+		ParentID:          task.ParentID,
+		SkipReview:        task.SkipReview,
+		LastReviewIsLGTM:  task.LastReviewIsLGTM,
+		Namespace:         task.Namespace,
+		Branch:            domain.BranchName(task.ID, task.Issue),
+		BaseBranch:        task.BaseBranch,
+		Agent:             task.Agent,
+		Session:           task.Session,
+		Status:            task.Status,
+		StatusDisplay:     task.Status.Display(),
+		ExecutionSubstate: task.ExecutionSubstate,
+		Stalled:           task.Stalled,
+		BlockReason:       task.BlockReason,
+		Title:             task.Title,
+		Description:       task.Description,
+		Labels:            task.Labels,
+		DependsOn:         task.DependsOn,
+		ID:                task.ID,
+		Issue:             task.Issue,
+		PR:                task.PR,
+		ReviewCount:       task.ReviewCount,
+		RestartCount:      task.RestartCount,
+	}
+	if !task.Started.IsZero() {
+		resp.Started = &task.Started
+	}
+	if !task.LastReviewAt.IsZero() {
+		resp.LastReviewAt = &task.LastReviewAt
+	}
+	if resp.Labels == nil {
+		resp.Labels = []string{}
+	}
+	return resp
+}
+
+// toCommentResponse converts a domain comment to its JSON representation.
+func toCommentResponse(comment domain.Comment) commentResponse {
+	return commentResponse{
+		Time:     comment.Time,
+		Metadata: comment.Metadata,
+		Text:     comment.Text,
+		Author:   comment.Author,
+		Type:     comment.Type,
+		Tags:     comment.Tags,
+		Findings: comment.Findings,
+	}
+}
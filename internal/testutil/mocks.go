@@ -3,6 +3,7 @@ package testutil
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"time"
 
@@ -28,11 +29,18 @@ func StringPtr(s string) *string {
 // MockTaskRepository is a test double for domain.TaskRepository.
 // Fields are ordered to minimize memory padding.
 type MockTaskRepository struct {
-	Tasks    map[int]*domain.Task
-	Comments map[int][]domain.Comment
-	SaveErr  error
-	GetErr   error
-	NextIDN  int
+	Tasks             map[int]*domain.Task
+	Comments          map[int][]domain.Comment
+	SaveErr           error
+	GetErr            error
+	SnapshotErr       error
+	SyncErr           error
+	RestoredSnapshot  string
+	Snapshots         []domain.SnapshotInfo
+	FetchedNamespaces []string
+	RemoteNamespaces  []string
+	NextIDN           int
+	PushCalled        bool
 }
 
 // NewMockTaskRepository creates a new MockTaskRepository with initialized maps.
@@ -255,6 +263,9 @@ type MockGit struct {
 	GetDefaultBranchErr    *error
 	MergeConflictErr       error
 	BranchExistsErr        error
+	RevParseErr            error
+	UpdateBranchErr        error
+	BranchDiffErr          error
 	CurrentBranchName      *string
 	UserEmailValue         *string
 	DefaultBranchName      *string
@@ -262,8 +273,17 @@ type MockGit struct {
 	DeletedBranch          *string
 	MergeConflictFiles     *[]string
 	BranchExistsMap        map[string]bool
+	BranchDiffs            map[string]string // Keyed by "base...branch"
+	MergeOpts              domain.MergeOptions
+	RevParseSHA            string
+	UpdateBranchSHA        string // If set, UpdateBranch sets RevParseSHA to simulate a moved branch
+	UpdateBranchStrategy   domain.MergeStrategy
+	UpdateBranchConflicts  []string
+	UpdateBranchDirs       []string // Worktree directories passed to UpdateBranch, in call order
+	RebaseOntoCalls        []string // "dir onto upstream" per RebaseOnto call (shares UpdateBranchErr/UpdateBranchConflicts)
 	HasUncommittedChangesV bool
 	MergeNoFF              bool
+	DeleteBranchForce      bool
 	MergeCalled            bool
 	DeleteBranchCalled     bool
 	GetDefaultBranchCalled bool
@@ -347,18 +367,49 @@ func (m *MockGit) GetMergeConflictFiles(_, _ string) ([]string, error) {
 }
 
 // Merge records the call and returns configured error.
-func (m *MockGit) Merge(branch string, noFF bool) error {
+func (m *MockGit) Merge(branch string, opts domain.MergeOptions) error {
 	m.MergeCalled = true
 	m.MergeBranch = &branch
-	m.MergeNoFF = noFF
+	m.MergeOpts = opts
+	m.MergeNoFF = opts.Strategy == domain.MergeStrategyMerge
 	return m.MergeErr
 }
 
+// BranchDiff returns the configured diff for base...branch or error.
+func (m *MockGit) BranchDiff(base, branch string, _ []string) (string, error) {
+	if m.BranchDiffErr != nil {
+		return "", m.BranchDiffErr
+	}
+	return m.BranchDiffs[base+"..."+branch], nil
+}
+
+// UpdateBranch records the call and returns the configured conflicts and error.
+func (m *MockGit) UpdateBranch(dir, _ string, strategy domain.MergeStrategy) ([]string, error) {
+	m.UpdateBranchDirs = append(m.UpdateBranchDirs, dir)
+	m.UpdateBranchStrategy = strategy
+	if m.UpdateBranchErr != nil {
+		return m.UpdateBranchConflicts, m.UpdateBranchErr
+	}
+	if m.UpdateBranchSHA != "" {
+		m.RevParseSHA = m.UpdateBranchSHA
+	}
+	return nil, nil
+}
+
+// RebaseOnto records the call and returns the configured conflicts and error.
+func (m *MockGit) RebaseOnto(dir, onto, upstream string) ([]string, error) {
+	m.RebaseOntoCalls = append(m.RebaseOntoCalls, dir+" "+onto+" "+upstream)
+	if m.UpdateBranchErr != nil {
+		return m.UpdateBranchConflicts, m.UpdateBranchErr
+	}
+	return nil, nil
+}
+
 // DeleteBranch records the call and returns configured error.
 func (m *MockGit) DeleteBranch(branch string, force bool) error {
 	m.DeleteBranchCalled = true
 	m.DeletedBranch = &branch
-	// force is ignored in mock for now, or we could add a field to verify it
+	m.DeleteBranchForce = force
 	return m.DeleteBranchErr
 }
 
@@ -374,6 +425,17 @@ func (m *MockGit) GetDefaultBranch() (string, error) {
 	return "main", nil
 }
 
+// RevParse returns the configured SHA (or a fake SHA derived from rev) or error.
+func (m *MockGit) RevParse(rev string) (string, error) {
+	if m.RevParseErr != nil {
+		return "", m.RevParseErr
+	}
+	if m.RevParseSHA != "" {
+		return m.RevParseSHA, nil
+	}
+	return "sha-" + rev, nil
+}
+
 // MockSessionManager is a test double for domain.SessionManager.
 // Fields are ordered to minimize memory padding.
 type MockSessionManager struct {
@@ -705,21 +767,54 @@ func (m *MockConfigManager) InitOverrideConfig(_ *domain.Config) error {
 	return m.InitOverrideErr
 }
 
-// === Snapshot methods (no-op for mock) ===
+// === Snapshot methods ===
 
-// SaveSnapshot is a no-op.
+// SaveSnapshot records a snapshot for mainSHA with the next sequence number.
 func (m *MockTaskRepository) SaveSnapshot(mainSHA string) error {
+	if m.SnapshotErr != nil {
+		return m.SnapshotErr
+	}
+	seq := 1
+	for _, snap := range m.Snapshots {
+		if snap.MainSHA == mainSHA && snap.Seq >= seq {
+			seq = snap.Seq + 1
+		}
+	}
+	m.Snapshots = append(m.Snapshots, domain.SnapshotInfo{
+		Ref:     fmt.Sprintf("%s_%03d", mainSHA, seq),
+		MainSHA: mainSHA,
+		Seq:     seq,
+	})
 	return nil
 }
 
-// RestoreSnapshot is a no-op.
+// RestoreSnapshot records the restored ref.
+// Returns domain.ErrSnapshotNotFound if the ref is not in Snapshots.
 func (m *MockTaskRepository) RestoreSnapshot(snapshotRef string) error {
-	return nil
+	if m.SnapshotErr != nil {
+		return m.SnapshotErr
+	}
+	for _, snap := range m.Snapshots {
+		if snap.Ref == snapshotRef {
+			m.RestoredSnapshot = snapshotRef
+			return nil
+		}
+	}
+	return domain.ErrSnapshotNotFound
 }
 
-// ListSnapshots returns empty.
+// ListSnapshots returns the recorded snapshots, filtered by mainSHA if set.
 func (m *MockTaskRepository) ListSnapshots(mainSHA string) ([]domain.SnapshotInfo, error) {
-	return nil, nil
+	if m.SnapshotErr != nil {
+		return nil, m.SnapshotErr
+	}
+	var snapshots []domain.SnapshotInfo
+	for _, snap := range m.Snapshots {
+		if mainSHA == "" || snap.MainSHA == mainSHA {
+			snapshots = append(snapshots, snap)
+		}
+	}
+	return snapshots, nil
 }
 
 // SyncSnapshot is a no-op.
@@ -727,8 +822,24 @@ func (m *MockTaskRepository) SyncSnapshot() error {
 	return nil
 }
 
-// PruneSnapshots is a no-op.
+// PruneSnapshots keeps the last keepCount recorded snapshots per mainSHA.
 func (m *MockTaskRepository) PruneSnapshots(keepCount int) error {
+	if m.SnapshotErr != nil {
+		return m.SnapshotErr
+	}
+	counts := make(map[string]int)
+	for _, snap := range m.Snapshots {
+		counts[snap.MainSHA]++
+	}
+	kept := m.Snapshots[:0]
+	for _, snap := range m.Snapshots {
+		if counts[snap.MainSHA] > keepCount {
+			counts[snap.MainSHA]--
+			continue
+		}
+		kept = append(kept, snap)
+	}
+	m.Snapshots = kept
 	return nil
 }
 
@@ -759,11 +870,27 @@ func (m *MockTaskRepositoryWithAddCommentError) PruneSnapshots(keepCount int) er
 	return nil
 }
 
-// === Remote sync methods (no-op for mock) ===
+// === Remote sync methods ===
 
-func (m *MockTaskRepository) Push() error                       { return nil }
-func (m *MockTaskRepository) Fetch(_ string) error              { return nil }
-func (m *MockTaskRepository) ListNamespaces() ([]string, error) { return nil, nil }
+// Push records the call and returns SyncErr.
+func (m *MockTaskRepository) Push() error {
+	m.PushCalled = true
+	return m.SyncErr
+}
+
+// Fetch records the fetched namespace and returns SyncErr.
+func (m *MockTaskRepository) Fetch(namespace string) error {
+	if m.SyncErr != nil {
+		return m.SyncErr
+	}
+	m.FetchedNamespaces = append(m.FetchedNamespaces, namespace)
+	return nil
+}
+
+// ListNamespaces returns RemoteNamespaces or SyncErr.
+func (m *MockTaskRepository) ListNamespaces() ([]string, error) {
+	return m.RemoteNamespaces, m.SyncErr
+}
 
 func (m *MockTaskRepositoryWithAddCommentError) Push() error                       { return nil }
 func (m *MockTaskRepositoryWithAddCommentError) Fetch(_ string) error              { return nil }
@@ -865,6 +992,43 @@ func (m *MockScriptRunner) Run(dir, script string) error {
 	return m.RunErr
 }
 
+// MockEventLog is a test double for domain.EventLog.
+// ReadFrom treats the offset as an index into Events.
+// Fields are ordered to minimize memory padding.
+type MockEventLog struct {
+	AppendErr error
+	ReadErr   error
+	Events    []domain.Event
+}
+
+// NewMockEventLog creates a new MockEventLog.
+func NewMockEventLog() *MockEventLog {
+	return &MockEventLog{}
+}
+
+// Ensure MockEventLog implements domain.EventLog interface.
+var _ domain.EventLog = (*MockEventLog)(nil)
+
+// Append records the events or returns the configured error.
+func (m *MockEventLog) Append(events ...domain.Event) error {
+	if m.AppendErr != nil {
+		return m.AppendErr
+	}
+	m.Events = append(m.Events, events...)
+	return nil
+}
+
+// ReadFrom returns the events after offset or the configured error.
+func (m *MockEventLog) ReadFrom(offset int64) ([]domain.Event, int64, error) {
+	if m.ReadErr != nil {
+		return nil, offset, m.ReadErr
+	}
+	if offset > int64(len(m.Events)) {
+		offset = 0
+	}
+	return append([]domain.Event(nil), m.Events[offset:]...), int64(len(m.Events)), nil
+}
+
 // MockCommandExecutor is a test double for domain.CommandExecutor.
 // Fields are ordered to minimize memory padding.
 type MockCommandExecutor struct {
@@ -920,3 +1084,90 @@ func (m *MockCommandExecutor) ExecuteWithContext(_ context.Context, cmd *domain.
 	}
 	return m.ExecuteWithContextErr
 }
+
+// MockGitHub is a test double for domain.GitHub.
+// Fields are ordered to minimize memory padding.
+type MockGitHub struct {
+	Issues          map[int]*domain.Issue
+	CreatedPR       *domain.CreatePROptions
+	ListedIssues    *domain.ListIssuesOptions
+	UpdatedPR       *domain.UpdatePROptions
+	GetIssueErr     error
+	ListIssuesErr   error
+	CreatePRErr     error
+	UpdatePRErr     error
+	FindPRErr       error
+	PushErr         error
+	PushedBranch    string
+	IssueList       []*domain.Issue // Issues returned by ListIssues
+	CreatePRNumber  int             // Number returned by CreatePR
+	FoundPRNumber   int             // Number returned by FindPRByBranch
+	UpdatedPRNumber int
+	CreatePRCalled  bool
+	UpdatePRCalled  bool
+	PushCalled      bool
+}
+
+// NewMockGitHub creates a new MockGitHub.
+func NewMockGitHub() *MockGitHub {
+	return &MockGitHub{
+		Issues: make(map[int]*domain.Issue),
+	}
+}
+
+// Ensure MockGitHub implements domain.GitHub interface.
+var _ domain.GitHub = (*MockGitHub)(nil)
+
+// GetIssue returns the configured issue or error.
+func (m *MockGitHub) GetIssue(number int) (*domain.Issue, error) {
+	if m.GetIssueErr != nil {
+		return nil, m.GetIssueErr
+	}
+	issue, ok := m.Issues[number]
+	if !ok {
+		return nil, fmt.Errorf("issue #%d not found", number)
+	}
+	return issue, nil
+}
+
+// ListIssues records the options and returns the configured issues or error.
+func (m *MockGitHub) ListIssues(opts domain.ListIssuesOptions) ([]*domain.Issue, error) {
+	m.ListedIssues = &opts
+	if m.ListIssuesErr != nil {
+		return nil, m.ListIssuesErr
+	}
+	return m.IssueList, nil
+}
+
+// CreatePR records the call and returns the configured number or error.
+func (m *MockGitHub) CreatePR(opts domain.CreatePROptions) (int, error) {
+	m.CreatePRCalled = true
+	m.CreatedPR = &opts
+	if m.CreatePRErr != nil {
+		return 0, m.CreatePRErr
+	}
+	return m.CreatePRNumber, nil
+}
+
+// UpdatePR records the call and returns the configured error.
+func (m *MockGitHub) UpdatePR(number int, opts domain.UpdatePROptions) error {
+	m.UpdatePRCalled = true
+	m.UpdatedPRNumber = number
+	m.UpdatedPR = &opts
+	return m.UpdatePRErr
+}
+
+// FindPRByBranch returns the configured number or error.
+func (m *MockGitHub) FindPRByBranch(_ string) (int, error) {
+	if m.FindPRErr != nil {
+		return 0, m.FindPRErr
+	}
+	return m.FoundPRNumber, nil
+}
+
+// Push records the call and returns the configured error.
+func (m *MockGitHub) Push(branch string) error {
+	m.PushCalled = true
+	m.PushedBranch = branch
+	return m.PushErr
+}
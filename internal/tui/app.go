@@ -20,6 +20,7 @@ import (
 	"github.com/runoshun/git-crew/v2/internal/app"
 	"github.com/runoshun/git-crew/v2/internal/domain"
 	"github.com/runoshun/git-crew/v2/internal/usecase"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
 )
 
 // AutoRefreshInterval is the default refresh cadence for the TUI.
@@ -103,6 +104,7 @@ type Model struct {
 	// Panel content state (strings before smaller types)
 	diffContent string // Cached diff content
 	peekContent string // Cached peek content
+	startRule   string // Routing rule that picked the preselected agent in the agent picker
 
 	// Numeric state (smaller types last)
 	mode                    Mode
@@ -426,6 +428,14 @@ func (m *Model) hasSessionLog(taskID int, isReview bool) bool {
 	return true
 }
 
+func (m *Model) hasRecording(taskID int, isReview bool) bool {
+	if m.container == nil || m.container.Config.CrewDir == "" {
+		return false
+	}
+	_, ok := shared.LatestSessionRecording(m.container.Config.CrewDir, sessionNameForLog(taskID, isReview))
+	return ok
+}
+
 // updateTaskList updates the task list items from tasks.
 func (m *Model) updateTaskList() {
 	if m.filterInput.Value() != "" {
@@ -673,7 +683,7 @@ func (m *Model) closeTask(taskID int) tea.Cmd {
 // mergeTask returns a command that merges a task.
 func (m *Model) mergeTask(taskID int) tea.Cmd {
 	return func() tea.Msg {
-		_, err := m.container.MergeTaskUseCase().Execute(
+		_, err := m.container.MergeTaskUseCase(io.Discard).Execute(
 			context.Background(),
 			usecase.MergeTaskInput{TaskID: taskID},
 		)
@@ -768,7 +778,7 @@ func (m *Model) actionMenuItemsForTask(task *domain.Task) []actionMenuItem {
 			Desc:     "Start with agent",
 			Key:      "s",
 			Action: func() (tea.Model, tea.Cmd) {
-				m.mode = ModeStart
+				m.openAgentPicker(task)
 				return m, nil
 			},
 			IsAvailable: func() bool {
@@ -840,6 +850,28 @@ func (m *Model) actionMenuItemsForTask(task *domain.Task) []actionMenuItem {
 				return m.hasSessionLog(task.ID, true)
 			},
 		},
+		{
+			ActionID: "replay_worker",
+			Label:    "Replay Worker Session",
+			Desc:     "Play back worker session recording",
+			Action: func() (tea.Model, tea.Cmd) {
+				return m, m.replaySession(task.ID, false)
+			},
+			IsAvailable: func() bool {
+				return m.hasRecording(task.ID, false)
+			},
+		},
+		{
+			ActionID: "replay_review",
+			Label:    "Replay Review Session",
+			Desc:     "Play back review session recording",
+			Action: func() (tea.Model, tea.Cmd) {
+				return m, m.replaySession(task.ID, true)
+			},
+			IsAvailable: func() bool {
+				return m.hasRecording(task.ID, true)
+			},
+		},
 		{
 			ActionID: "review_result",
 			Label:    "Review Result",
@@ -1059,7 +1091,7 @@ func (m *Model) updateAgents() {
 			m.builtinAgents = append(m.builtinAgents, name)
 		case domain.RoleManager:
 			m.managerAgents = append(m.managerAgents, name)
-		case domain.RoleReviewer:
+		case domain.RoleReviewer, domain.RoleConflictResolver:
 			continue
 		default:
 			continue
@@ -1098,6 +1130,27 @@ func (m *Model) updateAgents() {
 	}
 }
 
+// openAgentPicker opens the agent picker for a task. If a routing rule matches
+// the task, the cursor is moved to the agent it picks.
+func (m *Model) openAgentPicker(task *domain.Task) {
+	m.mode = ModeStart
+	m.startRule = ""
+	if m.config == nil {
+		return
+	}
+	decision := m.config.RouteWorker(task)
+	if decision.Rule == "" {
+		return
+	}
+	for i, a := range m.allAgents() {
+		if a == decision.Agent {
+			m.agentCursor = i
+			m.startRule = decision.Rule
+			break
+		}
+	}
+}
+
 // allAgents returns all agents (built-in + custom).
 func (m *Model) allAgents() []string {
 	result := make([]string, 0, len(m.builtinAgents)+len(m.customAgents))
@@ -1161,13 +1214,10 @@ func (c *domainExecCmd) SetStdin(r io.Reader)  { c.stdin = r }
 func (c *domainExecCmd) SetStdout(w io.Writer) { c.stdout = w }
 func (c *domainExecCmd) SetStderr(w io.Writer) { c.stderr = w }
 
-// attachToSession returns a tea.Cmd that attaches to a tmux session.
+// attachToSession returns a tea.Cmd that attaches to a task session.
 // After the attach completes (user detaches), it triggers a task reload.
 func (m *Model) attachToSession(taskID int) tea.Cmd {
-	socketPath := m.container.Config.SocketPath
-	sessionName := domain.SessionName(taskID)
-
-	cmd := domain.NewCommand("tmux", []string{"-S", socketPath, "attach", "-t", sessionName}, "")
+	cmd := m.container.AttachCommand(domain.SessionName(taskID))
 	return tea.Exec(&domainExecCmd{cmd: cmd}, func(err error) tea.Msg {
 		// Reload tasks after detaching from the session
 		return MsgReloadTasks{}
@@ -1239,7 +1289,15 @@ func (m *Model) showLogInPager(taskID int, isReview bool) tea.Cmd {
 	}
 }
 
-// execLogMsg is an internal message to trigger log pager execution.
+// replaySession returns a tea.Cmd that plays back a session recording.
+// After playback ends, it returns to the TUI.
+func (m *Model) replaySession(taskID int, isReview bool) tea.Cmd {
+	return func() tea.Msg {
+		return execLogMsg{cmd: m.container.ReplayCommand(taskID, isReview)}
+	}
+}
+
+// execLogMsg is an internal message to trigger log pager or session replay execution.
 type execLogMsg struct {
 	cmd *domain.ExecCommand
 }
@@ -1598,9 +1656,7 @@ func (m *Model) isManagerSessionRunning() (bool, error) {
 
 // attachToManagerSession returns a tea.Cmd that attaches to the manager session.
 func (m *Model) attachToManagerSession() tea.Cmd {
-	socketPath := m.container.Config.SocketPath
-	sessionName := domain.ManagerSessionName()
-	cmd := domain.NewCommand("tmux", []string{"-S", socketPath, "attach", "-t", sessionName}, "")
+	cmd := m.container.AttachCommand(domain.ManagerSessionName())
 	return tea.Exec(&domainExecCmd{cmd: cmd}, func(err error) tea.Msg {
 		return MsgReloadTasks{}
 	})
@@ -1673,15 +1729,15 @@ func (m *Model) loadDiffContent(taskID int) tea.Cmd {
 		cmd.Dir = execCmd.Dir
 
 		output, err := cmd.Output()
-		if err != nil {
-			// diff can return non-zero when there are differences, check if output exists
-			if len(output) > 0 {
-				return MsgDiffLoaded{TaskID: taskID, Content: string(output)}
-			}
+		// diff can return non-zero when there are differences, check if output exists
+		if err != nil && len(output) == 0 {
 			return MsgDiffLoaded{TaskID: taskID, Content: fmt.Sprintf("Error: %v", err)}
 		}
 
 		content := string(output)
+		if findings, findingsErr := uc.ReviewFindings(taskID); findingsErr == nil {
+			content = domain.AnnotateDiff(content, findings)
+		}
 		if content == "" {
 			content = "No changes"
 		}
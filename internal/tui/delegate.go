@@ -138,10 +138,12 @@ func (d taskDelegate) Render(w io.Writer, m list.Model, index int, item list.Ite
 	grayStyle := lipgloss.NewStyle().Foreground(Colors.DescNormal) // Gray for metadata
 	greenStyle := lipgloss.NewStyle().Foreground(Colors.Success)   // Green for play icon
 	blueStyle := lipgloss.NewStyle().Foreground(Colors.Primary)    // Blue for GitHub
+	yellowStyle := lipgloss.NewStyle().Foreground(Colors.Warning)  // Yellow for stalls and restarts
 	if blocked {
 		grayStyle = blockedStyle
 		greenStyle = blockedStyle
 		blueStyle = blockedStyle
+		yellowStyle = blockedStyle
 	}
 
 	// 1. Base branch (always shown)
@@ -184,7 +186,7 @@ func (d taskDelegate) Render(w io.Writer, m list.Model, index int, item list.Ite
 		})
 	}
 
-	// 5. Session + elapsed time (if running)
+	// 5. Session + elapsed time (if running), flagged when stalled
 	if task.Session != "" {
 		elapsed := time.Since(task.Started)
 		elapsedFmt := formatElapsedTime(elapsed)
@@ -192,6 +194,13 @@ func (d taskDelegate) Render(w io.Writer, m list.Model, index int, item list.Ite
 			plain:  "▶ " + elapsedFmt,
 			styled: greenStyle.Render("▶") + " " + grayStyle.Render(elapsedFmt),
 		})
+		if task.Stalled != "" {
+			stalledStr := "⚠ " + task.Stalled.Display()
+			metaParts = append(metaParts, metaPart{
+				plain:  stalledStr,
+				styled: yellowStyle.Render(stalledStr),
+			})
+		}
 	}
 
 	// 6. Comments (if any)
@@ -206,6 +215,15 @@ func (d taskDelegate) Render(w io.Writer, m list.Model, index int, item list.Ite
 		})
 	}
 
+	// 7. Restarts (if restarted after errors)
+	if task.RestartCount > 0 {
+		restartStr := fmt.Sprintf("%d", task.RestartCount)
+		metaParts = append(metaParts, metaPart{
+			plain:  "↻ " + restartStr,
+			styled: yellowStyle.Render("↻") + " " + grayStyle.Render(restartStr),
+		})
+	}
+
 	// 8. GitHub (if linked)
 	ghParts := []string{}
 	if task.Issue > 0 {
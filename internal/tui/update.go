@@ -340,7 +340,7 @@ func (m *Model) handleNormalMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if task == nil {
 			return m, nil
 		}
-		m.mode = ModeStart
+		m.openAgentPicker(task)
 		return m, nil
 
 	case key.Matches(msg, m.keys.Stop):
@@ -634,7 +634,7 @@ func (m *Model) performDefaultAction(task *domain.Task) (tea.Model, tea.Cmd) {
 	switch task.Status {
 	case domain.StatusTodo, domain.StatusError:
 		// Start the task
-		m.mode = ModeStart
+		m.openAgentPicker(task)
 		return m, nil
 
 	case domain.StatusInProgress:
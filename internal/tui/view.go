@@ -591,6 +591,9 @@ func (m *Model) viewAgentPicker() string {
 	title := ds.renderLine(ds.label.Render(fmt.Sprintf("Start Task #%d", task.ID)))
 	taskTitle := ds.renderLine(ds.muted.Render(task.Title))
 	selectLabel := ds.renderLine(ds.label.Render("Select agent"))
+	if m.startRule != "" {
+		selectLabel = ds.renderLine(ds.label.Render("Select agent") + ds.muted.Render(fmt.Sprintf(" (routing rule %q)", m.startRule)))
+	}
 
 	// Build agent rows
 	agentRows := make([]string, 0, len(m.builtinAgents)+len(m.customAgents)+1)
@@ -1092,6 +1095,12 @@ func (m *Model) detailPanelContent(contentWidth int) string {
 	statusLine := labelStyle.Render("Status") + m.styles.StatusStyle(task.Status).Render(statusIcon+" "+statusText)
 	lines = append(lines, statusLine)
 
+	// Stalled (if crew watch flagged the session)
+	if task.Stalled != "" {
+		stalledStyle := lipgloss.NewStyle().Foreground(Colors.Warning)
+		lines = append(lines, labelStyle.Render("Stalled")+stalledStyle.Render("⚠ "+string(task.Stalled)))
+	}
+
 	// Labels (if present)
 	if len(task.Labels) > 0 {
 		var labelsBuilder strings.Builder
@@ -1118,6 +1127,16 @@ func (m *Model) detailPanelContent(contentWidth int) string {
 		lines = append(lines, startedLine)
 	}
 
+	// Restarts (if restarted after errors)
+	if task.RestartCount > 0 {
+		lines = append(lines, labelStyle.Render("Restarts")+valueStyle.Render(fmt.Sprintf("%d", task.RestartCount)))
+	}
+
+	// Completion gates (latest result of each)
+	if gates := domain.LatestGateResults(m.comments); len(gates) > 0 {
+		lines = append(lines, labelStyle.Render("Gates")+m.renderGateResults(gates))
+	}
+
 	// Description
 	if task.Description != "" {
 		descLabelStyle := lipgloss.NewStyle().
@@ -1164,6 +1183,54 @@ func (m *Model) detailPanelContent(contentWidth int) string {
 	return strings.Join(lines, "\n")
 }
 
+// renderGateResults renders one line per gate, indented to line up with the detail values.
+func (m *Model) renderGateResults(gates []domain.GateResult) string {
+	passStyle := lipgloss.NewStyle().Foreground(Colors.Success)
+	failStyle := lipgloss.NewStyle().Foreground(Colors.Error)
+	optionalStyle := lipgloss.NewStyle().Foreground(Colors.Warning)
+	mutedStyle := lipgloss.NewStyle().Foreground(Colors.Muted)
+
+	rows := make([]string, 0, len(gates))
+	for _, gate := range gates {
+		var icon string
+		switch {
+		case gate.Passed():
+			icon = passStyle.Render("✓")
+		case gate.Required:
+			icon = failStyle.Render("✗")
+		default:
+			icon = optionalStyle.Render("✗")
+		}
+		detail := gate.Status.Display() + " · " + gate.Duration.String()
+		if !gate.Required {
+			detail += " · optional"
+		}
+		rows = append(rows, icon+" "+lipgloss.NewStyle().Foreground(Colors.TitleNormal).Render(gate.Name)+" "+mutedStyle.Render(detail))
+	}
+	return strings.Join(rows, "\n"+strings.Repeat(" ", 10))
+}
+
+// highlightReviewFindings colors the review finding lines interleaved with a diff.
+func highlightReviewFindings(diff string) string {
+	if !strings.Contains(diff, domain.ReviewAnnotationPrefix) {
+		return diff
+	}
+	blockingStyle := lipgloss.NewStyle().Foreground(Colors.Error)
+	findingStyle := lipgloss.NewStyle().Foreground(Colors.Warning)
+	lines := strings.Split(diff, "\n")
+	for i, line := range lines {
+		if !strings.HasPrefix(line, domain.ReviewAnnotationPrefix) {
+			continue
+		}
+		if strings.HasPrefix(line, domain.ReviewAnnotationPrefix+"[BLOCKING]") {
+			lines[i] = blockingStyle.Render(line)
+		} else {
+			lines[i] = findingStyle.Render(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 // viewPanelTabs renders the tab indicators for the panel.
 func (m *Model) viewPanelTabs(_ int) string {
 	tabStyle := lipgloss.NewStyle().Foreground(Colors.Muted)
@@ -1206,7 +1273,7 @@ func (m *Model) panelContentString(contentWidth int) string {
 		if m.diffContent == "" {
 			return "No diff available"
 		}
-		return m.diffContent
+		return highlightReviewFindings(m.diffContent)
 	case PanelContentPeek:
 		if m.panelContentLoading {
 			return "Loading..."
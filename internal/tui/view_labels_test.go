@@ -41,3 +41,38 @@ func TestViewDetailPanel_ShowsLabels(t *testing.T) {
 	assert.Contains(t, result, "[ui]")
 	assert.Contains(t, result, "Labels")
 }
+
+func TestViewDetailPanel_ShowsGateResults(t *testing.T) {
+	task := &domain.Task{
+		ID:      1,
+		Title:   "Task with gates",
+		Status:  domain.StatusInProgress,
+		Created: time.Now(),
+	}
+
+	styles := DefaultStyles()
+	delegate := newTaskDelegate(styles)
+	taskList := list.New([]list.Item{}, delegate, 0, 0)
+	taskList.SetItems([]list.Item{taskItem{task: task}})
+
+	m := &Model{
+		width:    120,
+		height:   20,
+		tasks:    []*domain.Task{task},
+		styles:   styles,
+		taskList: taskList,
+		comments: []domain.Comment{
+			domain.GateResult{Name: "lint", Status: domain.GateStatusPassed, Duration: time.Second, Required: true}.Comment(),
+			domain.GateResult{Name: "test", Status: domain.GateStatusFailed, Duration: 2 * time.Second, Required: true}.Comment(),
+		},
+	}
+	m.updateDetailPanelViewport()
+
+	result := m.viewDetailPanel()
+
+	assert.Contains(t, result, "Gates")
+	assert.Contains(t, result, "lint")
+	assert.Contains(t, result, "passed · 1s")
+	assert.Contains(t, result, "test")
+	assert.Contains(t, result, "failed · 2s")
+}
@@ -407,3 +407,17 @@ func TestViewFooter_MinimalWidth(t *testing.T) {
 	// When space is very limited, the content should be truncated (possibly to just "...")
 	assert.Contains(t, result, "...", "Footer should contain ellipsis when truncated")
 }
+
+func TestHighlightReviewFindings(t *testing.T) {
+	diff := "+x := 1\n>> [BLOCKING] Check the error\n>> [NIT] Rename x\n y := 2"
+
+	result := highlightReviewFindings(diff)
+
+	lines := strings.Split(result, "\n")
+	assert.Len(t, lines, 4)
+	assert.Equal(t, "+x := 1", lines[0])
+	assert.Contains(t, lines[1], "[BLOCKING] Check the error")
+	assert.Contains(t, lines[2], "[NIT] Rename x")
+	assert.Equal(t, " y := 2", lines[3])
+	assert.Equal(t, "no findings", highlightReviewFindings("no findings"))
+}
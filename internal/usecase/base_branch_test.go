@@ -43,10 +43,22 @@ func (m *MockGitForBaseBranch) GetMergeConflictFiles(_, _ string) ([]string, err
 	return nil, errors.New("not implemented")
 }
 
-func (m *MockGitForBaseBranch) Merge(_ string, _ bool) error {
+func (m *MockGitForBaseBranch) Merge(_ string, _ domain.MergeOptions) error {
 	return errors.New("not implemented")
 }
 
+func (m *MockGitForBaseBranch) UpdateBranch(_, _ string, _ domain.MergeStrategy) ([]string, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockGitForBaseBranch) BranchDiff(_, _ string, _ []string) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (m *MockGitForBaseBranch) RebaseOnto(_, _, _ string) ([]string, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *MockGitForBaseBranch) DeleteBranch(_ string, _ bool) error {
 	return errors.New("not implemented")
 }
@@ -55,6 +67,10 @@ func (m *MockGitForBaseBranch) ListBranches() ([]string, error) {
 	return nil, errors.New("not implemented")
 }
 
+func (m *MockGitForBaseBranch) RevParse(_ string) (string, error) {
+	return "", errors.New("not implemented")
+}
+
 func TestResolveBaseBranch_Private(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -148,10 +164,22 @@ func (m *MockGitForNewTaskBaseBranch) GetMergeConflictFiles(_, _ string) ([]stri
 	return nil, errors.New("not implemented")
 }
 
-func (m *MockGitForNewTaskBaseBranch) Merge(_ string, _ bool) error {
+func (m *MockGitForNewTaskBaseBranch) Merge(_ string, _ domain.MergeOptions) error {
 	return errors.New("not implemented")
 }
 
+func (m *MockGitForNewTaskBaseBranch) UpdateBranch(_, _ string, _ domain.MergeStrategy) ([]string, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockGitForNewTaskBaseBranch) BranchDiff(_, _ string, _ []string) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (m *MockGitForNewTaskBaseBranch) RebaseOnto(_, _, _ string) ([]string, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *MockGitForNewTaskBaseBranch) DeleteBranch(_ string, _ bool) error {
 	return errors.New("not implemented")
 }
@@ -160,6 +188,10 @@ func (m *MockGitForNewTaskBaseBranch) ListBranches() ([]string, error) {
 	return nil, errors.New("not implemented")
 }
 
+func (m *MockGitForNewTaskBaseBranch) RevParse(_ string) (string, error) {
+	return "", errors.New("not implemented")
+}
+
 func TestResolveNewTaskBaseBranch(t *testing.T) {
 	tests := []struct {
 		currentBranchErr error
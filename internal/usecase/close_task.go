@@ -81,6 +81,7 @@ func (uc *CloseTask) Execute(_ context.Context, in CloseTaskInput) (*CloseTaskOu
 	// Clear agent info
 	task.Agent = ""
 	task.Session = ""
+	task.Stalled = ""
 
 	// Save task
 	if err := uc.tasks.Save(task); err != nil {
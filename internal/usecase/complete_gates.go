@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// gateDurationPrecision is the precision of recorded gate durations.
+const gateDurationPrecision = 10 * time.Millisecond
+
+// runGates runs the [[complete.gates]] stage by stage in dir and records
+// each result as a gate comment on the task.
+// Gates in a stage run concurrently; later stages are skipped once a
+// required gate fails. Returns ErrGateFailed listing the failed required gates.
+func (uc *CompleteTask) runGates(ctx context.Context, task *domain.Task, gates []domain.CompleteGate, dir string) error {
+	var blocking []domain.GateResult
+	for _, stage := range domain.GateStages(gates) {
+		results, err := uc.runGateStage(ctx, stage, dir)
+		if err != nil {
+			return err
+		}
+		for _, result := range results {
+			if err := uc.tasks.AddComment(task.ID, result.Comment()); err != nil {
+				return fmt.Errorf("add gate comment: %w", err)
+			}
+			uc.writeReviewMessage("Gate " + result.Summary())
+			if uc.logger != nil {
+				if result.Passed() {
+					uc.logger.Info(task.ID, "gate", result.Summary())
+				} else {
+					uc.logger.Warn(task.ID, "gate", result.Summary())
+				}
+			}
+			if result.Blocking() {
+				blocking = append(blocking, result)
+			}
+		}
+		if len(blocking) > 0 {
+			return gateFailedError(blocking)
+		}
+	}
+	return nil
+}
+
+// runGateStage runs the gates of one stage concurrently and returns their results in order.
+func (uc *CompleteTask) runGateStage(ctx context.Context, stage []domain.CompleteGate, dir string) ([]domain.GateResult, error) {
+	names := make([]string, len(stage))
+	for i, gate := range stage {
+		names[i] = gate.Name
+	}
+	uc.writeReviewMessage("Running gate " + strings.Join(names, ", ") + "...")
+
+	results := make([]domain.GateResult, len(stage))
+	var wg sync.WaitGroup
+	for i, gate := range stage {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = uc.runGate(ctx, gate, dir)
+		}()
+	}
+	wg.Wait()
+
+	// A cancelled completion leaves no gate results behind.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (uc *CompleteTask) runGate(ctx context.Context, gate domain.CompleteGate, dir string) domain.GateResult {
+	gateCtx, cancel := context.WithTimeout(ctx, gate.TimeoutDuration())
+	defer cancel()
+
+	var output bytes.Buffer
+	started := uc.clock.Now()
+	err := uc.executor.ExecuteWithContext(gateCtx, domain.NewShellCommand(gate.Command, dir), &output, &output)
+	finished := uc.clock.Now()
+
+	status := domain.GateStatusPassed
+	switch {
+	case errors.Is(gateCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil:
+		status = domain.GateStatusTimedOut
+	case err != nil:
+		status = domain.GateStatusFailed
+		output.WriteString("\n" + err.Error())
+	}
+
+	return domain.GateResult{
+		Time:     finished,
+		Name:     gate.Name,
+		Status:   status,
+		Output:   output.String(),
+		Duration: finished.Sub(started).Round(gateDurationPrecision),
+		Required: gate.Required,
+	}
+}
+
+// gateFailedError describes the failed required gates and their output.
+func gateFailedError(results []domain.GateResult) error {
+	summaries := make([]string, len(results))
+	var details strings.Builder
+	for i, result := range results {
+		summaries[i] = result.Summary()
+		if output := result.OutputTail(); output != "" {
+			fmt.Fprintf(&details, "\n\n[%s]\n%s", result.Name, output)
+		}
+	}
+	return fmt.Errorf("%w: %s%s", domain.ErrGateFailed, strings.Join(summaries, ", "), details.String())
+}
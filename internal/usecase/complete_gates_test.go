@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gateResponse is the scripted outcome of a gate command.
+type gateResponse struct {
+	err    error
+	output string
+	hang   bool // Block until the context is done
+}
+
+// gateExecutor is a concurrency-safe CommandExecutor that answers by shell command.
+type gateExecutor struct {
+	testutil.MockCommandExecutor
+	responses map[string]gateResponse
+	ran       []string
+	mu        sync.Mutex
+}
+
+func (e *gateExecutor) ExecuteWithContext(ctx context.Context, cmd *domain.ExecCommand, stdout, _ io.Writer) error {
+	command := cmd.Args[len(cmd.Args)-1]
+	e.mu.Lock()
+	e.ran = append(e.ran, command)
+	resp := e.responses[command]
+	e.mu.Unlock()
+
+	_, _ = io.WriteString(stdout, resp.output)
+	if resp.hang {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return resp.err
+}
+
+func newGateTest(t *testing.T, gates []domain.CompleteGate, responses map[string]gateResponse) (*testutil.MockTaskRepository, *gateExecutor, *CompleteTask) {
+	t.Helper()
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:         1,
+		Title:      "Task to complete",
+		Status:     domain.StatusInProgress,
+		SkipReview: boolPtr(true),
+	}
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.ResolvePath = "/tmp/worktree"
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config = &domain.Config{
+		Complete: domain.CompleteConfig{Gates: gates},
+	}
+	executor := &gateExecutor{responses: responses}
+	uc := newTestCompleteTask(t, repo, testutil.NewMockSessionManager(), worktrees, &testutil.MockGit{}, configLoader, &testutil.MockClock{}, testutil.NewMockCommandExecutor())
+	uc.executor = executor
+	return repo, executor, uc
+}
+
+func TestCompleteTask_Execute_GatesPass(t *testing.T) {
+	// Setup
+	gates := []domain.CompleteGate{
+		{Name: "lint", Command: "make lint", Parallel: "checks", Required: true},
+		{Name: "test", Command: "make test", Parallel: "checks", Required: true},
+		{Name: "build", Command: "make build", Required: true},
+	}
+	repo, executor, uc := newGateTest(t, gates, map[string]gateResponse{})
+
+	// Execute
+	out, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusDone, out.Task.Status)
+	assert.ElementsMatch(t, []string{"make lint", "make test", "make build"}, executor.ran)
+	assert.Equal(t, "make build", executor.ran[2], "build runs after the parallel checks stage")
+
+	results := domain.LatestGateResults(repo.Comments[1])
+	require.Len(t, results, 3)
+	for i, name := range []string{"lint", "test", "build"} {
+		assert.Equal(t, name, results[i].Name)
+		assert.Equal(t, domain.GateStatusPassed, results[i].Status)
+	}
+}
+
+func TestCompleteTask_Execute_RequiredGateFails(t *testing.T) {
+	// Setup
+	gates := []domain.CompleteGate{
+		{Name: "lint", Command: "make lint", Parallel: "checks", Required: true},
+		{Name: "test", Command: "make test", Parallel: "checks", Required: true},
+		{Name: "build", Command: "make build", Required: true},
+	}
+	repo, executor, uc := newGateTest(t, gates, map[string]gateResponse{
+		"make test": {output: "--- FAIL: TestFoo", err: errors.New("exit status 1")},
+	})
+
+	// Execute
+	_, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1})
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrGateFailed)
+	assert.Contains(t, err.Error(), "test failed")
+	assert.Contains(t, err.Error(), "[test]\n--- FAIL: TestFoo")
+	assert.NotContains(t, err.Error(), "lint")
+	assert.NotContains(t, executor.ran, "make build", "later stages are skipped")
+	assert.Equal(t, domain.StatusInProgress, repo.Tasks[1].Status)
+
+	results := domain.LatestGateResults(repo.Comments[1])
+	require.Len(t, results, 2)
+	assert.Equal(t, domain.GateStatusPassed, results[0].Status)
+	assert.Equal(t, domain.GateStatusFailed, results[1].Status)
+	assert.Contains(t, repo.Comments[1][1].Text, "--- FAIL: TestFoo")
+}
+
+func TestCompleteTask_Execute_OptionalGateFails(t *testing.T) {
+	// Setup
+	gates := []domain.CompleteGate{
+		{Name: "bench", Command: "make bench", Required: false},
+		{Name: "build", Command: "make build", Required: true},
+	}
+	repo, executor, uc := newGateTest(t, gates, map[string]gateResponse{
+		"make bench": {err: errors.New("exit status 2")},
+	})
+
+	// Execute
+	out, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusDone, out.Task.Status)
+	assert.Equal(t, []string{"make bench", "make build"}, executor.ran)
+	results := domain.LatestGateResults(repo.Comments[1])
+	require.Len(t, results, 2)
+	assert.Equal(t, domain.GateStatusFailed, results[0].Status)
+	assert.False(t, results[0].Required)
+}
+
+func TestCompleteTask_Execute_GateTimesOut(t *testing.T) {
+	// Setup
+	gates := []domain.CompleteGate{
+		{Name: "e2e", Command: "make e2e", Timeout: 1, Required: true},
+	}
+	repo, _, uc := newGateTest(t, gates, map[string]gateResponse{
+		"make e2e": {hang: true},
+	})
+
+	// Execute
+	_, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1})
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrGateFailed)
+	assert.Contains(t, err.Error(), "e2e timed out")
+	results := domain.LatestGateResults(repo.Comments[1])
+	require.Len(t, results, 1)
+	assert.Equal(t, domain.GateStatusTimedOut, results[0].Status)
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
+)
+
+// reviewerVote is the outcome of one reviewer in a multi-reviewer review.
+// Fields are ordered to minimize memory padding.
+type reviewerVote struct {
+	err      error                  // Set when the reviewer failed to produce a result
+	agent    string                 // Reviewer agent name
+	result   string                 // Recorded review text
+	findings []domain.ReviewFinding // Findings of the recorded review
+	passed   bool                   // Whether the result matched review_success_regex
+}
+
+// reviewConsensus is the combined outcome of a multi-reviewer review.
+type reviewConsensus struct {
+	votes     []reviewerVote
+	passed    int
+	satisfied bool
+}
+
+// feedback joins the reviews, optionally only those that did not pass.
+func (c *reviewConsensus) feedback(onlyFailed bool) *domain.ReviewFeedback {
+	feedback := &domain.ReviewFeedback{}
+	var parts []string
+	for _, vote := range c.votes {
+		if onlyFailed && vote.passed {
+			continue
+		}
+		if vote.err != nil {
+			parts = append(parts, fmt.Sprintf("Review by %s failed: %v", vote.agent, vote.err))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("Review by %s:\n%s", vote.agent, vote.result))
+		feedback.Findings = append(feedback.Findings, vote.findings...)
+	}
+	feedback.Text = strings.Join(parts, "\n\n")
+	return feedback
+}
+
+// runReviewers runs several reviewer agents in parallel sessions and records each
+// verdict as a separate review comment. The first reviewer uses the regular review
+// session; the others use sessions named after their agent.
+// A reviewer that fails to produce a result counts as not passing.
+func (uc *CompleteTask) runReviewers(ctx context.Context, task *domain.Task, verbose bool, reviewers []string, policy domain.ReviewPolicy, matcher *regexp.Regexp, cfg *domain.Config, attempt int, previousReview *domain.ReviewFeedback) (*reviewConsensus, error) {
+	runs := make([]*reviewRun, 0, len(reviewers))
+	defer func() {
+		for _, run := range runs {
+			run.cleanup()
+		}
+	}()
+	for i, agent := range reviewers {
+		sessionName := domain.ReviewerSessionName(task.ID, agent)
+		if i == 0 {
+			sessionName = domain.ReviewSessionName(task.ID)
+		}
+		run, err := uc.startReview(ctx, task, sessionName, agent, agent, cfg, attempt, previousReview)
+		if err != nil {
+			for _, started := range runs {
+				if started.started {
+					_ = uc.sessions.Stop(started.sessionName)
+				}
+			}
+			return nil, fmt.Errorf("reviewer %s: %w", agent, err)
+		}
+		runs = append(runs, run)
+	}
+
+	// Only the first reviewer is streamed; interleaved output would be unreadable
+	waitErrs := make([]error, len(runs))
+	var wg sync.WaitGroup
+	for i, run := range runs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			waitErrs[i] = uc.waitForReview(ctx, run.sessionName, verbose && i == 0, run.startedAt)
+		}()
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for _, run := range runs {
+		uc.recordReviewUsage(task, run)
+	}
+
+	consensus := &reviewConsensus{votes: make([]reviewerVote, len(runs))}
+	failures := 0
+	for i, run := range runs {
+		vote := &consensus.votes[i]
+		vote.agent = reviewers[i]
+		if waitErrs[i] != nil {
+			vote.err = waitErrs[i]
+		} else if review, result, ok := uc.readReviewResult(task.ID, run.sessionName, run.logOffset); !ok {
+			vote.err = uc.noReviewCommentError(run.sessionName)
+		} else {
+			comment := domain.Comment{
+				Author:   domain.ReviewCommentAuthor,
+				Text:     result,
+				Time:     uc.clock.Now(),
+				Metadata: map[string]string{"reviewer": vote.agent},
+				Findings: review.Findings,
+			}
+			if review.Verdict != "" {
+				comment.Metadata["verdict"] = string(review.Verdict)
+			}
+			if err := uc.tasks.AddComment(task.ID, comment); err != nil {
+				return nil, fmt.Errorf("add review comment: %w", err)
+			}
+			vote.result = result
+			vote.findings = review.Findings
+			vote.passed = matcher.MatchString(result)
+		}
+
+		switch {
+		case vote.err != nil:
+			failures++
+			uc.writeReviewMessage(fmt.Sprintf("Reviewer %s failed: %v", vote.agent, vote.err))
+			if uc.logger != nil {
+				uc.logger.Warn(task.ID, "review", fmt.Sprintf("reviewer %s failed: %v", vote.agent, vote.err))
+			}
+		case vote.passed:
+			consensus.passed++
+			uc.writeReviewMessage(fmt.Sprintf("Reviewer %s passed.", vote.agent))
+		default:
+			uc.writeReviewMessage(fmt.Sprintf("Reviewer %s did not pass.", vote.agent))
+		}
+	}
+	if failures == len(runs) {
+		return nil, consensus.votes[0].err
+	}
+
+	consensus.satisfied = policy.Satisfied(consensus.passed, len(runs))
+	uc.writeReviewMessage(fmt.Sprintf("Review finished for task #%d: %d of %d reviewers passed (policy: %s).",
+		task.ID, consensus.passed, len(runs), reviewPolicyName(policy)))
+
+	shared.RecordReviewOutcome(uc.clock, task, consensus.satisfied)
+	if err := uc.tasks.Save(task); err != nil {
+		return nil, fmt.Errorf("save review metadata: %w", err)
+	}
+
+	return consensus, nil
+}
+
+// reviewPolicyName returns the policy name, defaulting to "all".
+func reviewPolicyName(policy domain.ReviewPolicy) domain.ReviewPolicy {
+	if policy == "" {
+		return domain.ReviewPolicyAll
+	}
+	return policy
+}
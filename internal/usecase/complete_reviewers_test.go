@@ -0,0 +1,251 @@
+package usecase
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reviewerSessions simulates reviewer sessions that may be waited on concurrently.
+// verdicts maps a session name to the JSON verdict written on each attempt;
+// logs maps a session name to the output the reviewer agent prints.
+type reviewerSessions struct {
+	testutil.MockSessionManager
+	crewDir  string
+	verdicts map[string][]string
+	scripts  map[string][]string
+	logs     map[string]string
+	mu       sync.Mutex
+}
+
+func (s *reviewerSessions) Start(_ context.Context, opts domain.StartSessionOptions) error {
+	content, err := os.ReadFile(opts.Command)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scripts[opts.Name] = append(s.scripts[opts.Name], string(content))
+	return nil
+}
+
+func (s *reviewerSessions) Wait(_ context.Context, sessionName string) error {
+	s.mu.Lock()
+	attempt := len(s.scripts[sessionName]) - 1
+	verdicts := s.verdicts[sessionName]
+	log := s.logs[sessionName]
+	s.mu.Unlock()
+
+	if log != "" {
+		if err := os.WriteFile(domain.SessionLogPath(s.crewDir, sessionName), []byte(log), 0o644); err != nil {
+			return err
+		}
+	}
+
+	if attempt < 0 || attempt >= len(verdicts) || verdicts[attempt] == "" {
+		return nil // The reviewer produced no result
+	}
+	return os.WriteFile(domain.ReviewResultPath(s.crewDir, sessionName), []byte(verdicts[attempt]), 0o644)
+}
+
+func newReviewersTest(t *testing.T, reviewers []string, policy domain.ReviewPolicy, verdicts map[string][]string) (*testutil.MockTaskRepository, *reviewerSessions, *CompleteTask) {
+	t.Helper()
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:     1,
+		Title:  "Task to review",
+		Status: domain.StatusInProgress,
+	}
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.ResolvePath = "/tmp/worktree"
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config.Complete.Reviewers = reviewers
+	configLoader.Config.Complete.ReviewPolicy = policy
+	configLoader.Config.Complete.MaxReviews = 1
+	sessions := &reviewerSessions{
+		verdicts: verdicts,
+		scripts:  make(map[string][]string),
+	}
+	sessions.crewDir = t.TempDir()
+	uc := NewCompleteTask(repo, sessions, worktrees, &testutil.MockGit{}, configLoader, &testutil.MockClock{}, nil, testutil.NewMockCommandExecutor(), nil, sessions.crewDir, t.TempDir())
+	return repo, sessions, uc
+}
+
+const (
+	lgtmVerdict         = `{"verdict": "lgtm", "summary": "Looks good."}`
+	needsChangesVerdict = `{"verdict": "needs_changes", "summary": "Missing tests.", "findings": [{"file": "a.go", "line": 7, "severity": "blocking", "message": "Add a test"}]}`
+)
+
+func TestCompleteTask_Execute_ReviewersMajority(t *testing.T) {
+	// Setup
+	primary := domain.ReviewSessionName(1)
+	codex := domain.ReviewerSessionName(1, "codex-reviewer")
+	opencode := domain.ReviewerSessionName(1, "opencode-reviewer")
+	repo, sessions, uc := newReviewersTest(t,
+		[]string{"claude-reviewer", "codex-reviewer", "opencode-reviewer"},
+		domain.ReviewPolicyMajority,
+		map[string][]string{
+			primary:  {lgtmVerdict},
+			codex:    {needsChangesVerdict},
+			opencode: {lgtmVerdict},
+		})
+
+	// Execute
+	out, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusDone, out.Task.Status)
+	assert.Len(t, sessions.scripts, 3, "each reviewer runs in its own session")
+	assert.Contains(t, sessions.scripts[codex][0], "Write your verdict as a JSON object to "+domain.ReviewResultPath(uc.crewDir, codex))
+
+	comments := repo.Comments[1]
+	require.Len(t, comments, 3)
+	assert.Equal(t, map[string]string{"reviewer": "claude-reviewer", "verdict": "lgtm"}, comments[0].Metadata)
+	assert.Equal(t, map[string]string{"reviewer": "codex-reviewer", "verdict": "needs_changes"}, comments[1].Metadata)
+	assert.Equal(t, map[string]string{"reviewer": "opencode-reviewer", "verdict": "lgtm"}, comments[2].Metadata)
+	for _, comment := range comments {
+		assert.Equal(t, "reviewer", comment.Author)
+	}
+
+	assert.Equal(t, 1, out.Task.ReviewCount, "one attempt counts once")
+	require.NotNil(t, out.Task.LastReviewIsLGTM)
+	assert.True(t, *out.Task.LastReviewIsLGTM)
+	assert.Contains(t, out.ReviewResult, "Review by codex-reviewer:\n❌ Needs changes")
+}
+
+func TestCompleteTask_Execute_ReviewersAllPolicyFails(t *testing.T) {
+	// Setup
+	repo, _, uc := newReviewersTest(t,
+		[]string{"claude-reviewer", "codex-reviewer"},
+		"",
+		map[string][]string{
+			domain.ReviewSessionName(1):                     {lgtmVerdict},
+			domain.ReviewerSessionName(1, "codex-reviewer"): {needsChangesVerdict},
+		})
+
+	// Execute
+	_, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "review required: 1 of 2 reviewers matched")
+	assert.Contains(t, err.Error(), "(policy: all)")
+	assert.Len(t, repo.Comments[1], 2)
+	assert.Equal(t, domain.StatusInProgress, repo.Tasks[1].Status)
+	require.NotNil(t, repo.Tasks[1].LastReviewIsLGTM)
+	assert.False(t, *repo.Tasks[1].LastReviewIsLGTM)
+}
+
+func TestCompleteTask_Execute_ReviewersFollowUpGetsFailingReviews(t *testing.T) {
+	// Setup
+	codex := domain.ReviewerSessionName(1, "codex-reviewer")
+	_, sessions, uc := newReviewersTest(t,
+		[]string{"claude-reviewer", "codex-reviewer"},
+		domain.ReviewPolicyAll,
+		map[string][]string{
+			domain.ReviewSessionName(1): {lgtmVerdict, lgtmVerdict},
+			codex:                       {needsChangesVerdict, lgtmVerdict},
+		})
+	uc.config.(*testutil.MockConfigLoader).Config.Complete.MaxReviews = 2
+
+	// Execute
+	out, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, out.Task.ReviewCount)
+	require.Len(t, sessions.scripts[codex], 2)
+	followUp := sessions.scripts[codex][1]
+	assert.Contains(t, followUp, "Review by codex-reviewer:\n❌ Needs changes")
+	assert.NotContains(t, followUp, "Review by claude-reviewer", "passing reviews are not fed back")
+	assert.Contains(t, followUp, "- [BLOCKING] `a.go:7` Add a test")
+}
+
+func TestCompleteTask_Execute_ReviewersMissingResultCountsAsFailure(t *testing.T) {
+	// Setup
+	repo, _, uc := newReviewersTest(t,
+		[]string{"claude-reviewer", "codex-reviewer"},
+		domain.ReviewPolicyAny,
+		map[string][]string{
+			domain.ReviewSessionName(1): {lgtmVerdict},
+		})
+
+	// Execute
+	out, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusDone, out.Task.Status)
+	assert.Len(t, repo.Comments[1], 1)
+	assert.Contains(t, out.ReviewResult, "Review by codex-reviewer failed: reviewer did not output a review result")
+}
+
+func TestCompleteTask_Execute_ReviewersAllMissingResults(t *testing.T) {
+	// Setup
+	_, _, uc := newReviewersTest(t,
+		[]string{"claude-reviewer", "codex-reviewer"},
+		domain.ReviewPolicyAny,
+		map[string][]string{})
+
+	// Execute
+	_, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNoReviewComment)
+}
+
+func TestCompleteTask_Execute_ReviewerFlagOverridesReviewers(t *testing.T) {
+	// Setup
+	repo, sessions, uc := newReviewersTest(t,
+		[]string{"claude-reviewer", "codex-reviewer"},
+		domain.ReviewPolicyAll,
+		map[string][]string{
+			domain.ReviewSessionName(1): {lgtmVerdict},
+		})
+
+	// Execute
+	out, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1, ReviewAgent: "opencode-reviewer"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusDone, out.Task.Status)
+	assert.Len(t, sessions.scripts, 1)
+	require.Len(t, repo.Comments[1], 1)
+	assert.Equal(t, map[string]string{"verdict": "lgtm"}, repo.Comments[1][0].Metadata)
+}
+
+func TestCompleteTask_Execute_ReviewersRecordUsage(t *testing.T) {
+	// Setup
+	codex := domain.ReviewerSessionName(1, "codex-reviewer")
+	repo, sessions, uc := newReviewersTest(t,
+		[]string{"claude-reviewer", "codex-reviewer"},
+		domain.ReviewPolicyAll,
+		map[string][]string{
+			domain.ReviewSessionName(1): {lgtmVerdict},
+			codex:                       {lgtmVerdict},
+		})
+	sessions.logs = map[string]string{
+		codex: "model: gpt-5-codex\nDone.\ntokens used\n12,345\n",
+	}
+
+	// Execute
+	_, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	comments := repo.Comments[1]
+	require.Len(t, comments, 3)
+	record, ok := domain.UsageRecordFromComment(comments[0])
+	require.True(t, ok, "usage is recorded before the review comments")
+	assert.Equal(t, "codex-reviewer", record.Agent)
+	assert.Equal(t, codex, record.Session)
+	assert.Equal(t, domain.Usage{Model: "gpt-5-codex", InputTokens: 12345}, record.Usage)
+	assert.Len(t, domain.LatestReviewComments(comments), 2)
+}
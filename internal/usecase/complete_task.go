@@ -63,6 +63,8 @@ type CompleteTask struct {
 	logger    domain.Logger
 	executor  domain.CommandExecutor
 	stderr    io.Writer
+	hooks     *shared.HookRunner
+	resolver  *shared.ConflictResolver
 	crewDir   string
 	repoRoot  string
 }
@@ -96,15 +98,31 @@ func NewCompleteTask(
 	}
 }
 
+// WithHooks sets the runner for the on_complete hook.
+func (uc *CompleteTask) WithHooks(hooks *shared.HookRunner) *CompleteTask {
+	uc.hooks = hooks
+	return uc
+}
+
+// WithConflictResolver sets the conflict resolver run when the branch conflicts with its base branch.
+func (uc *CompleteTask) WithConflictResolver(resolver *shared.ConflictResolver) *CompleteTask {
+	uc.resolver = resolver
+	return uc
+}
+
 // Execute marks a task as complete.
 // Preconditions:
 //   - Status is in_progress
 //   - No uncommitted changes in worktree
 //
 // Processing:
+//   - Run the on_complete hook if configured
 //   - Validate review requirement (skip_review/max_reviews or forced review)
-//   - Check for merge conflicts with base branch
+//     (with [complete].reviewers, run every reviewer in parallel and apply review_policy)
+//   - Check for merge conflicts with base branch (running the conflict resolver if configured)
 //   - Run [complete].command if configured (abort on failure)
+//   - Run [[complete.gates]] if configured, recording each result as a gate comment
+//     (abort if a required gate fails)
 //   - Set status to done and save
 func (uc *CompleteTask) Execute(ctx context.Context, in CompleteTaskInput) (*CompleteTaskOutput, error) {
 	// Get the task
@@ -125,6 +143,12 @@ func (uc *CompleteTask) Execute(ctx context.Context, in CompleteTaskInput) (*Com
 		return nil, fmt.Errorf("resolve worktree: %w", err)
 	}
 
+	// Run the on_complete hook first so changes it makes (e.g., formatting)
+	// are caught by the uncommitted changes check
+	if uc.hooks != nil {
+		uc.hooks.Run(domain.HookOnComplete, task, worktreePath)
+	}
+
 	// Check for uncommitted changes
 	hasUncommitted, err := uc.git.HasUncommittedChanges(worktreePath)
 	if err != nil {
@@ -179,6 +203,11 @@ func (uc *CompleteTask) Execute(ctx context.Context, in CompleteTaskInput) (*Com
 			return nil, fmt.Errorf("[complete].command failed: %s: %w", string(output), execErr)
 		}
 	}
+	if cfg != nil && len(cfg.Complete.Gates) > 0 {
+		if gateErr := uc.runGates(ctx, task, cfg.Complete.Gates, worktreePath); gateErr != nil {
+			return nil, gateErr
+		}
+	}
 
 	// Resolve base branch for conflict check
 	baseBranch, err := resolveBaseBranch(task, uc.git)
@@ -187,8 +216,8 @@ func (uc *CompleteTask) Execute(ctx context.Context, in CompleteTaskInput) (*Com
 	}
 
 	// Check for merge conflicts with base branch
-	conflictHandler := shared.NewConflictHandler(uc.tasks, uc.sessions, uc.git, uc.clock)
-	conflictOut, conflictErr := conflictHandler.CheckAndHandle(shared.ConflictCheckInput{
+	conflictHandler := shared.NewConflictHandler(uc.tasks, uc.sessions, uc.git, uc.clock).WithResolver(uc.resolver)
+	conflictOut, conflictErr := conflictHandler.CheckAndHandle(ctx, shared.ConflictCheckInput{
 		TaskID:     task.ID,
 		Branch:     branch,
 		BaseBranch: baseBranch,
@@ -207,23 +236,54 @@ func (uc *CompleteTask) Execute(ctx context.Context, in CompleteTaskInput) (*Com
 			return nil, fmt.Errorf("invalid review success regex: %w", err)
 		}
 
+		// An explicit --reviewer overrides [complete].reviewers
+		reviewAgent := in.ReviewAgent
+		var reviewers []string
+		var policy domain.ReviewPolicy
+		if reviewAgent == "" && cfg != nil {
+			reviewers = cfg.Complete.Reviewers
+			policy = cfg.Complete.ReviewPolicy
+			if len(reviewers) == 1 {
+				reviewAgent = reviewers[0]
+				reviewers = nil
+			}
+		}
+
 		reviewSucceeded := false
+		var consensus *reviewConsensus
+		var previousReview *domain.ReviewFeedback
 		for attempt := 1; attempt <= maxReviews; attempt++ {
-			previousReview := ""
-			if attempt > 1 {
-				previousReview = lastReviewResult
+			if len(reviewers) > 1 {
+				var reviewErr error
+				consensus, reviewErr = uc.runReviewers(ctx, task, in.Verbose, reviewers, policy, reviewMatcher, cfg, attempt, previousReview)
+				if reviewErr != nil {
+					return nil, reviewErr
+				}
+				lastReviewResult = consensus.feedback(false).Text
+				previousReview = consensus.feedback(true)
+				if consensus.satisfied {
+					reviewSucceeded = true
+					break
+				}
+				continue
 			}
-			reviewResult, reviewErr := uc.runReview(ctx, task, in.Verbose, in.ReviewAgent, cfg, attempt, previousReview)
+
+			reviewFeedback, reviewErr := uc.runReview(ctx, task, in.Verbose, reviewAgent, cfg, attempt, previousReview)
 			if reviewErr != nil {
 				return nil, reviewErr
 			}
-			lastReviewResult = reviewResult
-			if reviewMatcher.MatchString(reviewResult) {
+			lastReviewResult = reviewFeedback.Text
+			previousReview = reviewFeedback
+			if reviewMatcher.MatchString(reviewFeedback.Text) {
 				reviewSucceeded = true
 				break
 			}
 		}
 		if requireReviewSuccess && !reviewSucceeded {
+			if consensus != nil {
+				return nil, fmt.Errorf("review required: %d of %d reviewers matched %q (policy: %s) after %d attempt(s)",
+					consensus.passed, len(consensus.votes), reviewSuccessRegex, reviewPolicyName(policy), maxReviews)
+			}
 			return nil, fmt.Errorf("review required: no review comment matched %q after %d attempt(s)", reviewSuccessRegex, maxReviews)
 		}
 	}
@@ -261,84 +321,126 @@ func (uc *CompleteTask) Execute(ctx context.Context, in CompleteTaskInput) (*Com
 	}, nil
 }
 
-func (uc *CompleteTask) runReview(ctx context.Context, task *domain.Task, verbose bool, agent string, cfg *domain.Config, attempt int, previousReview string) (string, error) {
-	reviewSessionName := domain.ReviewSessionName(task.ID)
-	running, err := uc.sessions.IsRunning(reviewSessionName)
+func (uc *CompleteTask) runReview(ctx context.Context, task *domain.Task, verbose bool, agent string, cfg *domain.Config, attempt int, previousReview *domain.ReviewFeedback) (*domain.ReviewFeedback, error) {
+	run, err := uc.startReview(ctx, task, domain.ReviewSessionName(task.ID), agent, "", cfg, attempt, previousReview)
+	if err != nil {
+		return nil, err
+	}
+	defer run.cleanup()
+
+	waitErr := uc.waitForReview(ctx, run.sessionName, verbose, run.startedAt)
+	if waitErr != nil {
+		return nil, waitErr
+	}
+	uc.writeReviewMessage(fmt.Sprintf("Review finished for task #%d.", task.ID))
+	uc.recordReviewUsage(task, run)
+
+	return uc.updateReviewMetadata(task, run.sessionName, run.logOffset)
+}
+
+// recordReviewUsage attaches the usage the reviewer agent reported to the task.
+// Failures are logged only; usage accounting never blocks a review.
+func (uc *CompleteTask) recordReviewUsage(task *domain.Task, run *reviewRun) {
+	if _, err := shared.RecordSessionUsage(uc.tasks, uc.clock, uc.crewDir, task.ID, run.agent, run.sessionName, run.logOffset); err != nil && uc.logger != nil {
+		uc.logger.Warn(task.ID, "review", fmt.Sprintf("record reviewer usage: %v", err))
+	}
+}
+
+// reviewRun is a review session started or attached to by startReview.
+// Fields are ordered to minimize memory padding.
+type reviewRun struct {
+	startedAt   time.Time
+	sessionName string
+	agent       string // Reviewer agent name (may be empty when attached to a running session)
+	scriptPath  string
+	logOffset   int64
+	started     bool // false when an already running session was attached to
+}
+
+// cleanup removes the review script written for the run.
+func (r *reviewRun) cleanup() {
+	if r.scriptPath != "" {
+		_ = os.Remove(r.scriptPath)
+	}
+}
+
+// startReview starts the review session, or attaches to it if it is already running.
+// label names the reviewer in progress messages (empty for the default reviewer).
+func (uc *CompleteTask) startReview(ctx context.Context, task *domain.Task, sessionName, agent, label string, cfg *domain.Config, attempt int, previousReview *domain.ReviewFeedback) (*reviewRun, error) {
+	running, err := uc.sessions.IsRunning(sessionName)
 	if err != nil {
-		return "", fmt.Errorf("check review session: %w", err)
+		return nil, fmt.Errorf("check review session: %w", err)
 	}
 
-	startedAt := uc.clock.Now()
-	logOffset := int64(0)
+	run := &reviewRun{sessionName: sessionName, agent: agent, startedAt: uc.clock.Now()}
 	if running {
 		// If the session is already running, avoid accidentally parsing an older review
 		// result by only considering log content written after the last review run start.
-		logPath := domain.SessionLogPath(uc.crewDir, reviewSessionName)
+		logPath := domain.SessionLogPath(uc.crewDir, sessionName)
 		if offset, t, ok := findLastReviewRunStart(logPath, int64(reviewLogReadMaxBytes)); ok {
-			logOffset = offset
+			run.logOffset = offset
 			if !t.IsZero() {
-				startedAt = t
+				run.startedAt = t
 			}
 		}
 	}
 
-	var reviewScriptPath string
+	by := ""
+	if label != "" {
+		by = " by " + label
+	}
+	resultPath := domain.ReviewResultPath(uc.crewDir, sessionName)
 	if running {
-		uc.writeReviewMessage(fmt.Sprintf("Review session already running for task #%d. Waiting...", task.ID))
-		uc.writeReviewMessage(fmt.Sprintf("Note: review may take a while. If it takes too long, re-run 'crew complete %d'.", task.ID))
-	} else {
-		uc.writeReviewMessage(fmt.Sprintf("Starting review for task #%d...", task.ID))
+		uc.writeReviewMessage(fmt.Sprintf("Review session%s already running for task #%d. Waiting...", by, task.ID))
 		uc.writeReviewMessage(fmt.Sprintf("Note: review may take a while. If it takes too long, re-run 'crew complete %d'.", task.ID))
-		reviewCmd, prepareErr := shared.PrepareReviewCommand(shared.ReviewCommandDeps{
-			ConfigLoader: uc.config,
-			Config:       cfg,
-			Worktrees:    uc.worktrees,
-			RepoRoot:     uc.repoRoot,
-		}, shared.ReviewCommandInput{
-			Task:           task,
-			Agent:          agent,
-			ReviewAttempt:  attempt,
-			PreviousReview: previousReview,
-		})
-		if prepareErr != nil {
-			return "", prepareErr
-		}
-
-		var scriptErr error
-		reviewScriptPath, scriptErr = uc.writeReviewScript(reviewSessionName, task.ID, reviewCmd, startedAt)
-		if scriptErr != nil {
-			return "", scriptErr
-		}
-		defer func() {
-			_ = os.Remove(reviewScriptPath)
-		}()
-
-		startErr := uc.sessions.Start(ctx, domain.StartSessionOptions{
-			Name:      reviewSessionName,
-			Dir:       reviewCmd.WorktreePath,
-			Command:   reviewScriptPath,
-			TaskID:    task.ID,
-			TaskTitle: task.Title,
-			TaskAgent: reviewCmd.AgentName,
-			Type:      domain.SessionTypeReviewer,
-		})
-		if startErr != nil {
-			return "", fmt.Errorf("start review session: %w", startErr)
-		}
+		return run, nil
+	}
+
+	uc.writeReviewMessage(fmt.Sprintf("Starting review%s for task #%d...", by, task.ID))
+	uc.writeReviewMessage(fmt.Sprintf("Note: review may take a while. If it takes too long, re-run 'crew complete %d'.", task.ID))
+	// Never pick up a verdict left behind by an earlier review run
+	if err := os.Remove(resultPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("remove stale review result: %w", err)
+	}
+	reviewCmd, prepareErr := shared.PrepareReviewCommand(shared.ReviewCommandDeps{
+		ConfigLoader: uc.config,
+		Config:       cfg,
+		Worktrees:    uc.worktrees,
+		RepoRoot:     uc.repoRoot,
+	}, shared.ReviewCommandInput{
+		Task:           task,
+		Agent:          agent,
+		ReviewAttempt:  attempt,
+		PreviousReview: previousReview,
+		ResultFile:     resultPath,
+	})
+	if prepareErr != nil {
+		return nil, prepareErr
 	}
 
-	waitErr := uc.waitForReview(ctx, reviewSessionName, verbose, startedAt)
-	if waitErr != nil {
-		return "", waitErr
+	scriptPath, scriptErr := uc.writeReviewScript(sessionName, task.ID, reviewCmd, run.startedAt)
+	if scriptErr != nil {
+		return nil, scriptErr
 	}
-	uc.writeReviewMessage(fmt.Sprintf("Review finished for task #%d.", task.ID))
+	run.scriptPath = scriptPath
+	run.agent = reviewCmd.AgentName
 
-	result, err := uc.updateReviewMetadata(task, reviewSessionName, logOffset)
-	if err != nil {
-		return "", err
+	startErr := uc.sessions.Start(ctx, domain.StartSessionOptions{
+		Name:      sessionName,
+		Dir:       reviewCmd.WorktreePath,
+		Command:   scriptPath,
+		TaskID:    task.ID,
+		TaskTitle: task.Title,
+		TaskAgent: reviewCmd.AgentName,
+		Type:      domain.SessionTypeReviewer,
+	})
+	if startErr != nil {
+		run.cleanup()
+		return nil, fmt.Errorf("start review session: %w", startErr)
 	}
+	run.started = true
 
-	return result, nil
+	return run, nil
 }
 
 func (uc *CompleteTask) writeReviewScript(sessionName string, taskID int, reviewCmd *shared.ReviewCommandOutput, startedAt time.Time) (string, error) {
@@ -518,33 +620,64 @@ func (uc *CompleteTask) streamSessionOutput(sessionName string, lastOutput *stri
 	return nil
 }
 
-func (uc *CompleteTask) updateReviewMetadata(task *domain.Task, sessionName string, logOffset int64) (string, error) {
-	logPath := domain.SessionLogPath(uc.crewDir, sessionName)
-	logTail := readFileTailBytes(logPath, logOffset, reviewLogReadMaxBytes)
-	result, ok := extractReviewResult(logTail)
+func (uc *CompleteTask) updateReviewMetadata(task *domain.Task, sessionName string, logOffset int64) (*domain.ReviewFeedback, error) {
+	review, result, ok := uc.readReviewResult(task.ID, sessionName, logOffset)
 	if !ok {
-		return "", uc.noReviewCommentError(sessionName)
-	}
-	result = strings.TrimSpace(result)
-	if result == "" {
-		return "", uc.noReviewCommentError(sessionName)
+		return nil, uc.noReviewCommentError(sessionName)
 	}
 
 	comment := domain.Comment{
-		Author: "reviewer",
-		Text:   result,
-		Time:   uc.clock.Now(),
+		Author:   domain.ReviewCommentAuthor,
+		Text:     result,
+		Time:     uc.clock.Now(),
+		Findings: review.Findings,
+	}
+	if review.Verdict != "" {
+		comment.Metadata = map[string]string{"verdict": string(review.Verdict)}
 	}
 	if err := uc.tasks.AddComment(task.ID, comment); err != nil {
-		return "", fmt.Errorf("add review comment: %w", err)
+		return nil, fmt.Errorf("add review comment: %w", err)
 	}
 
 	shared.UpdateReviewMetadata(uc.clock, task, result)
 	if err := uc.tasks.Save(task); err != nil {
-		return "", fmt.Errorf("save review metadata: %w", err)
+		return nil, fmt.Errorf("save review metadata: %w", err)
 	}
 
-	return result, nil
+	return &domain.ReviewFeedback{Text: result, Findings: review.Findings}, nil
+}
+
+// readReviewResult returns the typed review and the text to record for it.
+// The JSON verdict file written by the reviewer takes precedence; otherwise the
+// log after the last result marker is read as a JSON verdict, falling back to
+// the legacy free-form text matched by review_success_regex.
+func (uc *CompleteTask) readReviewResult(taskID int, sessionName string, logOffset int64) (*domain.Review, string, bool) {
+	resultPath := domain.ReviewResultPath(uc.crewDir, sessionName)
+	if data, err := os.ReadFile(resultPath); err == nil {
+		_ = os.Remove(resultPath)
+		review, parseErr := domain.ParseReviewJSON(string(data))
+		if parseErr == nil {
+			return review, review.Text(), true
+		}
+		if uc.logger != nil {
+			uc.logger.Warn(taskID, "review", fmt.Sprintf("ignoring review result file %s: %v", resultPath, parseErr))
+		}
+	}
+
+	logPath := domain.SessionLogPath(uc.crewDir, sessionName)
+	logTail := readFileTailBytes(logPath, logOffset, reviewLogReadMaxBytes)
+	result, ok := extractReviewResult(logTail)
+	if !ok {
+		return nil, "", false
+	}
+	result = strings.TrimSpace(result)
+	if result == "" {
+		return nil, "", false
+	}
+	if review, err := domain.ParseReviewJSON(result); err == nil {
+		return review, review.Text(), true
+	}
+	return domain.ParseReviewText(result), result, true
 }
 
 func (uc *CompleteTask) writeReviewMessage(message string) {
@@ -643,7 +776,7 @@ func extractReviewResult(logText string) (string, bool) {
 	lines := strings.Split(logText, "\n")
 	markerIdx := -1
 	for i := len(lines) - 1; i >= 0; i-- {
-		if strings.TrimSpace(lines[i]) == domain.ReviewResultMarker {
+		if isReviewResultMarker(lines[i]) {
 			markerIdx = i
 			break
 		}
@@ -662,6 +795,12 @@ func extractReviewResult(logText string) (string, bool) {
 	return result, true
 }
 
+// isReviewResultMarker reports whether line is the review result marker,
+// tolerating Markdown decoration such as "**---REVIEW_RESULT---**" or a code span.
+func isReviewResultMarker(line string) bool {
+	return strings.Trim(strings.TrimSpace(line), "`*_#> ") == domain.ReviewResultMarker
+}
+
 func tailFileLines(path string, maxLines int) string {
 	if maxLines <= 0 {
 		return ""
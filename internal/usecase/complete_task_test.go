@@ -10,6 +10,7 @@ import (
 
 	"github.com/runoshun/git-crew/v2/internal/domain"
 	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -136,6 +137,47 @@ func TestCompleteTask_Execute_WithCompleteCommand(t *testing.T) {
 	assert.Equal(t, domain.StatusDone, out.Task.Status) // skip_review=true
 }
 
+func TestCompleteTask_Execute_OnCompleteHook(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:         1,
+		Title:      "Task to complete",
+		Status:     domain.StatusInProgress,
+		SkipReview: boolPtr(true),
+	}
+
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.ResolvePath = "/tmp/worktree"
+
+	git := &testutil.MockGit{
+		HasUncommittedChangesV: false,
+	}
+
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config = &domain.Config{
+		Hooks: domain.HooksConfig{
+			OnComplete: "gofmt -w . # {{.TaskID}}",
+		},
+	}
+	clock := &testutil.MockClock{}
+	executor := testutil.NewMockCommandExecutor()
+
+	uc := newTestCompleteTask(t, repo, testutil.NewMockSessionManager(), worktrees, git, configLoader, clock, executor).
+		WithHooks(shared.NewHookRunner(configLoader, worktrees, executor, nil, "/repo", "/repo/.git"))
+
+	// Execute
+	_, err := uc.Execute(context.Background(), CompleteTaskInput{
+		TaskID: 1,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, executor.ExecuteWithContextCalled, "hooks.on_complete should be executed")
+	assert.Equal(t, []string{"-c", "gofmt -w . # 1"}, executor.ExecutedCmd.Args)
+	assert.Equal(t, "/tmp/worktree", executor.ExecutedCmd.Dir)
+}
+
 func TestCompleteTask_Execute_CompleteCommandFails(t *testing.T) {
 	// Setup
 	repo := testutil.NewMockTaskRepository()
@@ -1093,3 +1135,129 @@ func TestFindLastReviewRunStart_BaseOffsetBoundary(t *testing.T) {
 	assert.Equal(t, boundaryOffset, offset)
 	assert.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), startedAt)
 }
+
+// newReviewVerdictTest creates a CompleteTask that requires review, with the
+// reviewer session simulated by review.
+func newReviewVerdictTest(t *testing.T, review func(uc *CompleteTask, sessionName string) error) (*testutil.MockTaskRepository, *testutil.MockSessionManager, *CompleteTask) {
+	t.Helper()
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:     1,
+		Title:  "Task to review",
+		Status: domain.StatusInProgress,
+	}
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.ResolvePath = "/tmp/worktree"
+	sessions := testutil.NewMockSessionManager()
+	uc := newTestCompleteTask(t, repo, sessions, worktrees, &testutil.MockGit{}, testutil.NewMockConfigLoader(), &testutil.MockClock{}, testutil.NewMockCommandExecutor())
+	sessions.WaitFunc = func(_ context.Context, sessionName string) error {
+		logPath := domain.SessionLogPath(uc.crewDir, sessionName)
+		if err := os.MkdirAll(filepath.Dir(logPath), 0o750); err != nil {
+			return err
+		}
+		return review(uc, sessionName)
+	}
+	return repo, sessions, uc
+}
+
+func TestCompleteTask_Execute_ReviewVerdictFile(t *testing.T) {
+	// Setup
+	var script string
+	repo, sessions, uc := newReviewVerdictTest(t, func(uc *CompleteTask, sessionName string) error {
+		content, err := os.ReadFile(uc.sessions.(*testutil.MockSessionManager).StartOpts.Command)
+		if err != nil {
+			return err
+		}
+		script = string(content)
+		// The log has no marker at all; the verdict file is enough
+		if err := os.WriteFile(domain.SessionLogPath(uc.crewDir, sessionName), []byte("reviewing...\n"), 0o644); err != nil {
+			return err
+		}
+		verdict := `{"verdict": "lgtm", "summary": "Clean change.", "findings": [{"file": "main.go", "line": 3, "severity": "nit", "message": "Typo in comment"}]}`
+		return os.WriteFile(domain.ReviewResultPath(uc.crewDir, sessionName), []byte(verdict), 0o644)
+	})
+
+	// Execute
+	out, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusDone, out.Task.Status)
+	resultPath := domain.ReviewResultPath(uc.crewDir, domain.ReviewSessionName(1))
+	assert.Contains(t, script, "Write your verdict as a JSON object to "+resultPath)
+	assert.NoFileExists(t, resultPath, "the verdict file is consumed")
+	assert.True(t, sessions.WaitCalled)
+
+	comments := repo.Comments[1]
+	require.Len(t, comments, 1)
+	assert.Equal(t, "reviewer", comments[0].Author)
+	assert.Equal(t, "✅ LGTM\n\n## Summary\nClean change.\n\n## Findings\n- [NIT] `main.go:3` Typo in comment", comments[0].Text)
+	assert.Equal(t, map[string]string{"verdict": "lgtm"}, comments[0].Metadata)
+	assert.Equal(t, []domain.ReviewFinding{{File: "main.go", Line: 3, Severity: domain.ReviewSeverityNit, Message: "Typo in comment"}}, comments[0].Findings)
+	require.NotNil(t, out.Task.LastReviewIsLGTM)
+	assert.True(t, *out.Task.LastReviewIsLGTM)
+}
+
+func TestCompleteTask_Execute_ReviewVerdictAfterDecoratedMarker(t *testing.T) {
+	// Setup
+	repo, _, uc := newReviewVerdictTest(t, func(uc *CompleteTask, sessionName string) error {
+		content := "thinking...\n**" + domain.ReviewResultMarker + "**\n```json\n" +
+			`{"verdict": "needs_changes", "summary": "Missing error check.", "findings": [{"file": "app.go", "line": 10, "severity": "blocking", "message": "Handle the error"}]}` +
+			"\n```\n"
+		return os.WriteFile(domain.SessionLogPath(uc.crewDir, sessionName), []byte(content), 0o644)
+	})
+
+	// Execute
+	_, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "review required")
+	require.Len(t, repo.Comments[1], 1)
+	assert.True(t, strings.HasPrefix(repo.Comments[1][0].Text, "❌ Needs changes"))
+	assert.Contains(t, repo.Comments[1][0].Text, "- [BLOCKING] `app.go:10` Handle the error")
+	assert.Equal(t, "needs_changes", repo.Comments[1][0].Metadata["verdict"])
+}
+
+func TestCompleteTask_Execute_ReviewVerdictIgnoresStaleFile(t *testing.T) {
+	// Setup
+	var staleRemoved bool
+	_, _, uc := newReviewVerdictTest(t, func(uc *CompleteTask, sessionName string) error {
+		_, statErr := os.Stat(domain.ReviewResultPath(uc.crewDir, sessionName))
+		staleRemoved = os.IsNotExist(statErr)
+		content := domain.ReviewResultMarker + "\n" + domain.ReviewLGTMPrefix + " Looks good\n"
+		return os.WriteFile(domain.SessionLogPath(uc.crewDir, sessionName), []byte(content), 0o644)
+	})
+	stalePath := domain.ReviewResultPath(uc.crewDir, domain.ReviewSessionName(1))
+	require.NoError(t, os.MkdirAll(filepath.Dir(stalePath), 0o750))
+	require.NoError(t, os.WriteFile(stalePath, []byte(`{"verdict": "needs_changes"}`), 0o644))
+
+	// Execute
+	out, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, staleRemoved)
+	assert.Equal(t, domain.StatusDone, out.Task.Status)
+}
+
+func TestCompleteTask_Execute_ReviewVerdictInvalidFileFallsBackToLog(t *testing.T) {
+	// Setup
+	repo, _, uc := newReviewVerdictTest(t, func(uc *CompleteTask, sessionName string) error {
+		if err := os.WriteFile(domain.ReviewResultPath(uc.crewDir, sessionName), []byte(`{"verdict": "ship it"}`), 0o644); err != nil {
+			return err
+		}
+		content := domain.ReviewResultMarker + "\n" + domain.ReviewLGTMPrefix + " Looks good\n"
+		return os.WriteFile(domain.SessionLogPath(uc.crewDir, sessionName), []byte(content), 0o644)
+	})
+
+	// Execute
+	out, err := uc.Execute(context.Background(), CompleteTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusDone, out.Task.Status)
+	require.Len(t, repo.Comments[1], 1)
+	assert.Equal(t, domain.ReviewLGTMPrefix+" Looks good", repo.Comments[1][0].Text)
+	assert.Equal(t, map[string]string{"verdict": "lgtm"}, repo.Comments[1][0].Metadata)
+}
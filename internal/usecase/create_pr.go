@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
+)
+
+// CreatePRInput contains the parameters for creating a pull request.
+type CreatePRInput struct {
+	BaseBranch string // Target branch for the PR (defaults to task.BaseBranch or GetDefaultBranch())
+	TaskID     int    // Task ID to create the PR for
+}
+
+// CreatePROutput contains the result of creating a pull request.
+type CreatePROutput struct {
+	Task    *domain.Task // The task with PR number set
+	Created bool         // True if a new PR was created, false if an existing PR was updated
+}
+
+// CreatePR is the use case for pushing a task branch and opening or updating its pull request.
+type CreatePR struct {
+	tasks  domain.TaskRepository
+	git    domain.Git
+	github domain.GitHub
+}
+
+// NewCreatePR creates a new CreatePR use case.
+func NewCreatePR(tasks domain.TaskRepository, git domain.Git, github domain.GitHub) *CreatePR {
+	return &CreatePR{
+		tasks:  tasks,
+		git:    git,
+		github: github,
+	}
+}
+
+// Execute pushes the task branch and creates or updates its pull request.
+// Processing:
+// 1. Push the task branch to the remote
+// 2. Find the existing PR (task.PR, or an open PR for the branch)
+// 3. Update the existing PR or create a new one with title/description from the task
+// 4. Store the PR number on the task
+func (uc *CreatePR) Execute(_ context.Context, in CreatePRInput) (*CreatePROutput, error) {
+	task, err := shared.GetTask(uc.tasks, in.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.Status.IsTerminal() {
+		return nil, fmt.Errorf("cannot create PR for task in %s status: %w", task.Status.Display(), domain.ErrInvalidTransition)
+	}
+
+	branch := domain.BranchName(task.ID, task.Issue)
+	exists, err := uc.git.BranchExists(branch)
+	if err != nil {
+		return nil, fmt.Errorf("check branch exists: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", domain.ErrBranchNotFound, branch)
+	}
+
+	baseBranch := in.BaseBranch
+	if baseBranch == "" {
+		baseBranch, err = resolveBaseBranch(task, uc.git)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.github.Push(branch); err != nil {
+		return nil, err
+	}
+
+	number := task.PR
+	if number == 0 {
+		number, err = uc.github.FindPRByBranch(branch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	title := task.Title
+	body := prBody(task)
+	created := false
+	if number > 0 {
+		if err := uc.github.UpdatePR(number, domain.UpdatePROptions{
+			Title: title,
+			Body:  body,
+		}); err != nil {
+			return nil, err
+		}
+	} else {
+		number, err = uc.github.CreatePR(domain.CreatePROptions{
+			Title:  title,
+			Body:   body,
+			Branch: branch,
+			Base:   baseBranch,
+		})
+		if err != nil {
+			return nil, err
+		}
+		created = true
+	}
+
+	if task.PR != number {
+		task.PR = number
+		if err := uc.tasks.Save(task); err != nil {
+			return nil, fmt.Errorf("save task: %w", err)
+		}
+	}
+
+	return &CreatePROutput{Task: task, Created: created}, nil
+}
+
+// prBody builds the PR description from the task.
+// A linked issue is referenced so that merging the PR closes it.
+func prBody(task *domain.Task) string {
+	var parts []string
+	if desc := strings.TrimSpace(task.Description); desc != "" {
+		parts = append(parts, desc)
+	}
+	if task.Issue > 0 {
+		parts = append(parts, fmt.Sprintf("Closes #%d", task.Issue))
+	}
+	return strings.Join(parts, "\n\n")
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePR_Execute_CreatesPR(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:          1,
+		Title:       "Add feature",
+		Description: "Implements the feature.",
+		Status:      domain.StatusDone,
+		BaseBranch:  "develop",
+	}
+	git := &testutil.MockGit{}
+	gh := testutil.NewMockGitHub()
+	gh.CreatePRNumber = 42
+
+	uc := NewCreatePR(repo, git, gh)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), CreatePRInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, out.Created)
+	assert.Equal(t, 42, out.Task.PR)
+	assert.Equal(t, 42, repo.Tasks[1].PR)
+	assert.True(t, gh.PushCalled)
+	assert.Equal(t, "crew-1", gh.PushedBranch)
+	require.NotNil(t, gh.CreatedPR)
+	assert.Equal(t, domain.CreatePROptions{
+		Title:  "Add feature",
+		Body:   "Implements the feature.",
+		Branch: "crew-1",
+		Base:   "develop",
+	}, *gh.CreatedPR)
+	assert.False(t, gh.UpdatePRCalled)
+}
+
+func TestCreatePR_Execute_UpdatesStoredPR(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:     1,
+		Title:  "Renamed task",
+		Status: domain.StatusInProgress,
+		PR:     7,
+	}
+	gh := testutil.NewMockGitHub()
+
+	uc := NewCreatePR(repo, &testutil.MockGit{}, gh)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), CreatePRInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, out.Created)
+	assert.True(t, gh.UpdatePRCalled)
+	assert.Equal(t, 7, gh.UpdatedPRNumber)
+	assert.Equal(t, "Renamed task", gh.UpdatedPR.Title)
+	assert.False(t, gh.CreatePRCalled)
+}
+
+func TestCreatePR_Execute_AdoptsExistingPR(t *testing.T) {
+	// Setup - PR was opened outside crew for the branch
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task", Status: domain.StatusDone}
+	gh := testutil.NewMockGitHub()
+	gh.FoundPRNumber = 15
+
+	uc := NewCreatePR(repo, &testutil.MockGit{}, gh)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), CreatePRInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, out.Created)
+	assert.Equal(t, 15, gh.UpdatedPRNumber)
+	assert.Equal(t, 15, repo.Tasks[1].PR)
+}
+
+func TestCreatePR_Execute_IssueReference(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[3] = &domain.Task{ID: 3, Title: "Fix bug", Description: "Details", Status: domain.StatusDone, Issue: 12}
+	gh := testutil.NewMockGitHub()
+	gh.CreatePRNumber = 20
+
+	uc := NewCreatePR(repo, &testutil.MockGit{}, gh)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), CreatePRInput{TaskID: 3, BaseBranch: "release"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "crew-3-gh-12", gh.CreatedPR.Branch)
+	assert.Equal(t, "release", gh.CreatedPR.Base)
+	assert.Equal(t, "Details\n\nCloses #12", gh.CreatedPR.Body)
+}
+
+func TestCreatePR_Execute_BranchNotFound(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task", Status: domain.StatusTodo}
+	git := &testutil.MockGit{BranchExistsMap: map[string]bool{}}
+	gh := testutil.NewMockGitHub()
+
+	uc := NewCreatePR(repo, git, gh)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), CreatePRInput{TaskID: 1})
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrBranchNotFound)
+	assert.False(t, gh.PushCalled)
+}
+
+func TestCreatePR_Execute_TerminalStatus(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task", Status: domain.StatusMerged}
+
+	uc := NewCreatePR(repo, &testutil.MockGit{}, testutil.NewMockGitHub())
+
+	// Execute
+	_, err := uc.Execute(context.Background(), CreatePRInput{TaskID: 1})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrInvalidTransition)
+}
+
+func TestCreatePR_Execute_PushError(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task", Status: domain.StatusDone}
+	gh := testutil.NewMockGitHub()
+	gh.PushErr = assert.AnError
+
+	uc := NewCreatePR(repo, &testutil.MockGit{}, gh)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), CreatePRInput{TaskID: 1})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.False(t, gh.CreatePRCalled)
+	assert.Equal(t, 0, repo.Tasks[1].PR)
+}
+
+func TestCreatePR_Execute_TaskNotFound(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+
+	uc := NewCreatePR(repo, &testutil.MockGit{}, testutil.NewMockGitHub())
+
+	_, err := uc.Execute(context.Background(), CreatePRInput{TaskID: 99})
+
+	assert.ErrorIs(t, err, domain.ErrTaskNotFound)
+}
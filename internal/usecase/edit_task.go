@@ -13,21 +13,23 @@ import (
 // EditTaskInput contains the parameters for editing a task.
 // All fields except TaskID are optional. Only non-nil/non-empty fields will be updated.
 type EditTaskInput struct {
-	Title        *string         // New title (nil = no change)
-	Description  *string         // New description (nil = no change)
-	Status       *domain.Status  // New status (nil = no change)
-	SkipReview   *bool           // New skip_review setting (nil = no change)
-	ParentID     *int            // New parent ID (nil = no change, 0 = remove parent)
-	BlockReason  *string         // New block reason (nil = no change, "" = unblock)
-	EditorText   string          // Markdown text from editor (only used when EditorEdit is true)
-	Labels       []string        // Labels to set (replaces all existing labels, nil = no change)
-	AddLabels    []string        // Labels to add
-	RemoveLabels []string        // Labels to remove
-	IfStatus     []domain.Status // Conditional status update: only update if current status is in this list
-	TaskID       int             // Task ID to edit (required)
-	LabelsSet    bool            // True if Labels was explicitly set (to distinguish nil from empty)
-	EditorEdit   bool            // True if editing via editor (title/description from markdown)
-	RemoveParent bool            // True to remove parent (set ParentID to nil)
+	Title           *string         // New title (nil = no change)
+	Description     *string         // New description (nil = no change)
+	Status          *domain.Status  // New status (nil = no change)
+	SkipReview      *bool           // New skip_review setting (nil = no change)
+	ParentID        *int            // New parent ID (nil = no change, 0 = remove parent)
+	BlockReason     *string         // New block reason (nil = no change, "" = unblock)
+	EditorText      string          // Markdown text from editor (only used when EditorEdit is true)
+	Labels          []string        // Labels to set (replaces all existing labels, nil = no change)
+	AddLabels       []string        // Labels to add
+	RemoveLabels    []string        // Labels to remove
+	AddDependsOn    []int           // Task IDs to add as dependencies
+	RemoveDependsOn []int           // Task IDs to remove from dependencies
+	IfStatus        []domain.Status // Conditional status update: only update if current status is in this list
+	TaskID          int             // Task ID to edit (required)
+	LabelsSet       bool            // True if Labels was explicitly set (to distinguish nil from empty)
+	EditorEdit      bool            // True if editing via editor (title/description from markdown)
+	RemoveParent    bool            // True to remove parent (set ParentID to nil)
 }
 
 // EditTaskOutput contains the result of editing a task.
@@ -55,10 +57,17 @@ func (uc *EditTask) Execute(_ context.Context, in EditTaskInput) (*EditTaskOutpu
 	}
 
 	// Validate that at least one field is being updated
-	if in.Title == nil && in.Description == nil && in.Status == nil && in.SkipReview == nil && in.ParentID == nil && in.BlockReason == nil && !in.RemoveParent && !in.LabelsSet && len(in.AddLabels) == 0 && len(in.RemoveLabels) == 0 {
+	if in.Title == nil && in.Description == nil && in.Status == nil && in.SkipReview == nil && in.ParentID == nil && in.BlockReason == nil && !in.RemoveParent && !in.LabelsSet && len(in.AddLabels) == 0 && len(in.RemoveLabels) == 0 && len(in.AddDependsOn) == 0 && len(in.RemoveDependsOn) == 0 {
 		return nil, domain.ErrNoFieldsToUpdate
 	}
 
+	// Validate dependency IDs are positive
+	for _, id := range slices.Concat(in.AddDependsOn, in.RemoveDependsOn) {
+		if id <= 0 {
+			return nil, fmt.Errorf("invalid dependency ID %d: must be a positive number", id)
+		}
+	}
+
 	// Validate title is not empty if provided
 	if in.Title != nil && *in.Title == "" {
 		return nil, domain.ErrEmptyTitle
@@ -82,6 +91,8 @@ func (uc *EditTask) Execute(_ context.Context, in EditTaskInput) (*EditTaskOutpu
 		return nil, err
 	}
 
+	previousStatus := task.Status
+
 	// Update fields
 	if in.Title != nil {
 		task.Title = *in.Title
@@ -174,11 +185,30 @@ func (uc *EditTask) Execute(_ context.Context, in EditTaskInput) (*EditTaskOutpu
 		task.Labels = updateLabels(task.Labels, in.AddLabels, in.RemoveLabels)
 	}
 
+	// Handle dependencies (block reason follows unfinished dependencies)
+	if len(in.AddDependsOn) > 0 || len(in.RemoveDependsOn) > 0 {
+		dependsOn := updateDependencies(task.DependsOn, in.AddDependsOn, in.RemoveDependsOn)
+		if err := shared.ValidateDependencies(uc.tasks, task.ID, dependsOn); err != nil {
+			return nil, err
+		}
+		task.DependsOn = dependsOn
+		if _, err := shared.SyncDependencyBlock(uc.tasks, task); err != nil {
+			return nil, err
+		}
+	}
+
 	// Save updated task
 	if err := uc.tasks.Save(task); err != nil {
 		return nil, fmt.Errorf("save task: %w", err)
 	}
 
+	// Manually marking a task as merged unblocks tasks that depend on it
+	if previousStatus != domain.StatusMerged && task.Status == domain.StatusMerged {
+		if _, err := shared.SyncDependents(uc.tasks, task.ID); err != nil {
+			return nil, fmt.Errorf("sync dependent tasks: %w", err)
+		}
+	}
+
 	return &EditTaskOutput{Task: task}, nil
 }
 
@@ -344,6 +374,18 @@ func updateLabels(current, add, remove []string) []string {
 	return result
 }
 
+// updateDependencies adds and removes dependency IDs from the current set.
+// Returns a sorted slice with duplicates removed, or nil if empty.
+func updateDependencies(current, add, remove []int) []int {
+	result := make([]int, 0, len(current)+len(add))
+	for _, id := range slices.Concat(current, add) {
+		if !slices.Contains(remove, id) {
+			result = append(result, id)
+		}
+	}
+	return domain.NormalizeDependencies(result)
+}
+
 // checkCircularReference checks if setting newParentID as parent of taskID would create a cycle.
 // Returns ErrCircularReference if a cycle would be created.
 func (uc *EditTask) checkCircularReference(taskID, newParentID int) error {
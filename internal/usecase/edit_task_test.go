@@ -1632,3 +1632,141 @@ func TestEditTask_Execute_BlockWithOtherFields(t *testing.T) {
 	assert.Equal(t, "設計レビュー待ち", out.Task.BlockReason)
 	assert.True(t, out.Task.IsBlocked())
 }
+
+func TestEditTask_Execute_AddDependsOn(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Dependency A", Status: domain.StatusInProgress}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Dependency B", Status: domain.StatusMerged}
+	repo.Tasks[3] = &domain.Task{ID: 3, Title: "Dependent", Status: domain.StatusTodo}
+	uc := NewEditTask(repo)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), EditTaskInput{
+		TaskID:       3,
+		AddDependsOn: []int{2, 1, 1},
+	})
+
+	// Assert: dependencies are normalized and task is blocked on the unmerged one
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, out.Task.DependsOn)
+	assert.Equal(t, "Depends on #1", out.Task.BlockReason)
+	assert.True(t, out.Task.IsBlocked())
+}
+
+func TestEditTask_Execute_RemoveDependsOnUnblocks(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Dependency", Status: domain.StatusTodo}
+	repo.Tasks[2] = &domain.Task{
+		ID:          2,
+		Title:       "Dependent",
+		Status:      domain.StatusTodo,
+		DependsOn:   []int{1},
+		BlockReason: "Depends on #1",
+	}
+	uc := NewEditTask(repo)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), EditTaskInput{
+		TaskID:          2,
+		RemoveDependsOn: []int{1},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, out.Task.DependsOn)
+	assert.False(t, out.Task.IsBlocked())
+}
+
+func TestEditTask_Execute_DependsOnKeepsManualBlock(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Dependency", Status: domain.StatusTodo}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Dependent", Status: domain.StatusTodo, BlockReason: "Waiting for design"}
+	uc := NewEditTask(repo)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), EditTaskInput{
+		TaskID:       2,
+		AddDependsOn: []int{1},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, out.Task.DependsOn)
+	assert.Equal(t, "Waiting for design", out.Task.BlockReason)
+}
+
+func TestEditTask_Execute_DependsOnCycle(t *testing.T) {
+	// Setup: 2 depends on 1
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task 1", Status: domain.StatusTodo}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Task 2", Status: domain.StatusTodo, DependsOn: []int{1}}
+	uc := NewEditTask(repo)
+
+	// Execute: 1 depends on 2 would create a cycle
+	_, err := uc.Execute(context.Background(), EditTaskInput{
+		TaskID:       1,
+		AddDependsOn: []int{2},
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrDependencyCycle)
+	assert.Nil(t, repo.Tasks[1].DependsOn)
+}
+
+func TestEditTask_Execute_DependsOnNotFound(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task 1", Status: domain.StatusTodo}
+	uc := NewEditTask(repo)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), EditTaskInput{
+		TaskID:       1,
+		AddDependsOn: []int{42},
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrDependencyNotFound)
+}
+
+func TestEditTask_Execute_DependsOnInvalidID(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task 1", Status: domain.StatusTodo}
+	uc := NewEditTask(repo)
+
+	_, err := uc.Execute(context.Background(), EditTaskInput{
+		TaskID:       1,
+		AddDependsOn: []int{0},
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid dependency ID")
+}
+
+func TestEditTask_Execute_StatusMergedUnblocksDependents(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Dependency", Status: domain.StatusDone}
+	repo.Tasks[2] = &domain.Task{
+		ID:          2,
+		Title:       "Dependent",
+		Status:      domain.StatusTodo,
+		DependsOn:   []int{1},
+		BlockReason: "Depends on #1",
+	}
+	uc := NewEditTask(repo)
+
+	// Execute
+	merged := domain.StatusMerged
+	_, err := uc.Execute(context.Background(), EditTaskInput{
+		TaskID: 1,
+		Status: &merged,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, repo.Tasks[2].IsBlocked())
+}
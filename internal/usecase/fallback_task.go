@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
+)
+
+// sessionEndWaitTimeout bounds how long FallbackTask and RestartSession wait
+// for the failed session to go away.
+const sessionEndWaitTimeout = time.Minute
+
+// FallbackTaskInput contains the parameters for restarting a task with a fallback agent.
+// Fields are ordered to minimize memory padding.
+type FallbackTaskInput struct {
+	Agent    string // Agent whose session ended in error
+	TaskID   int    // Task ID
+	ExitCode int    // Exit code of the failed session
+}
+
+// FallbackTaskOutput contains the result of restarting a task with a fallback agent.
+type FallbackTaskOutput struct {
+	Agent    string                   // Agent the task was restarted with (empty if none)
+	Attempts []domain.FallbackAttempt // Recorded attempts, in order
+}
+
+// FallbackTask is the use case for restarting a task whose worker session ended
+// in error with the next agent of the failed agent's fallback chain.
+// It runs detached from the failed session (see SessionEnded), so it first
+// waits for that session to go away.
+type FallbackTask struct {
+	tasks        domain.TaskRepository
+	sessions     domain.SessionManager
+	starter      TaskStarter
+	configLoader domain.ConfigLoader
+	clock        domain.Clock
+	logger       domain.Logger
+}
+
+// NewFallbackTask creates a new FallbackTask use case.
+func NewFallbackTask(
+	tasks domain.TaskRepository,
+	sessions domain.SessionManager,
+	starter TaskStarter,
+	configLoader domain.ConfigLoader,
+	clock domain.Clock,
+	logger domain.Logger,
+) *FallbackTask {
+	return &FallbackTask{
+		tasks:        tasks,
+		sessions:     sessions,
+		starter:      starter,
+		configLoader: configLoader,
+		clock:        clock,
+		logger:       logger,
+	}
+}
+
+// Execute restarts the task with the next fallback agent that starts successfully.
+// Each attempt is recorded as a fallback comment. Nothing is done if the task
+// was restarted or changed status in the meantime.
+func (uc *FallbackTask) Execute(ctx context.Context, in FallbackTaskInput) (*FallbackTaskOutput, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, sessionEndWaitTimeout)
+	defer cancel()
+	if err := uc.sessions.Wait(waitCtx, domain.SessionName(in.TaskID)); err != nil {
+		return nil, fmt.Errorf("wait for session to end: %w", err)
+	}
+
+	task, err := shared.GetTask(uc.tasks, in.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	out := &FallbackTaskOutput{}
+	if !awaitingRecovery(task) {
+		return out, nil
+	}
+
+	cfg, err := uc.configLoader.Load()
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+	comments, err := uc.tasks.GetComments(task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get comments: %w", err)
+	}
+	prompt := domain.FallbackPrompt(in.Agent, in.ExitCode, comments)
+
+	for skip := 0; ; skip++ {
+		step, ok := domain.NextFallback(cfg, task, comments, in.Agent, skip)
+		attempt := domain.FallbackAttempt{
+			Time:     uc.clock.Now(),
+			Origin:   step.Origin,
+			From:     in.Agent,
+			Attempt:  step.Attempt,
+			ExitCode: in.ExitCode,
+		}
+		if !ok {
+			// Only record exhaustion caused by failed restarts here;
+			// SessionEnded records a chain that was already exhausted
+			if len(out.Attempts) > 0 {
+				if err := uc.record(task.ID, attempt); err != nil {
+					return nil, err
+				}
+				out.Attempts = append(out.Attempts, attempt)
+			}
+			return out, nil
+		}
+
+		attempt.Agent = step.Agent
+		_, startErr := uc.starter.Execute(ctx, StartTaskInput{
+			TaskID:            task.ID,
+			Agent:             step.Agent,
+			AdditionalPrompts: []string{prompt},
+		})
+		attempt.Time = uc.clock.Now()
+		if startErr != nil {
+			attempt.Error = startErr.Error()
+		}
+		if err := uc.record(task.ID, attempt); err != nil {
+			return nil, err
+		}
+		out.Attempts = append(out.Attempts, attempt)
+		if startErr == nil {
+			out.Agent = step.Agent
+			return out, nil
+		}
+	}
+}
+
+// record adds the attempt to the task's comments and the log.
+func (uc *FallbackTask) record(taskID int, attempt domain.FallbackAttempt) error {
+	comment := attempt.Comment()
+	if err := uc.tasks.AddComment(taskID, comment); err != nil {
+		return fmt.Errorf("add comment: %w", err)
+	}
+	if uc.logger != nil {
+		if attempt.Succeeded() {
+			uc.logger.Info(taskID, "fallback", comment.Text)
+		} else {
+			uc.logger.Warn(taskID, "fallback", comment.Text)
+		}
+	}
+	return nil
+}
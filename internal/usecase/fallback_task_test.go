@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fallbackStarter fails to start the agents in errs.
+type fallbackStarter struct {
+	repo   *testutil.MockTaskRepository
+	errs   map[string]error
+	inputs []StartTaskInput
+}
+
+func (f *fallbackStarter) Execute(_ context.Context, in StartTaskInput) (*StartTaskOutput, error) {
+	f.inputs = append(f.inputs, in)
+	if err := f.errs[in.Agent]; err != nil {
+		return nil, err
+	}
+	task := f.repo.Tasks[in.TaskID]
+	task.Status = domain.StatusInProgress
+	task.Agent = in.Agent
+	task.Session = domain.SessionName(in.TaskID)
+	return &StartTaskOutput{SessionName: task.Session, Agent: in.Agent}, nil
+}
+
+func newFallbackTestRepo() *testutil.MockTaskRepository {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:      1,
+		Title:   "Test task",
+		Status:  domain.StatusError,
+		Started: time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC),
+	}
+	repo.Comments[1] = []domain.Comment{{Text: "Implemented the parser, tests pending", Author: "worker"}}
+	return repo
+}
+
+func newFallbackTestConfig() *testutil.MockConfigLoader {
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config.Agents["claude-dev"] = domain.Agent{Fallback: []string{"codex-dev", "opencode-dev"}}
+	return configLoader
+}
+
+func TestFallbackTask_Execute_RestartsWithNextAgent(t *testing.T) {
+	// Setup
+	repo := newFallbackTestRepo()
+	sessions := testutil.NewMockSessionManager()
+	starter := &fallbackStarter{repo: repo}
+	now := time.Date(2026, 1, 2, 4, 0, 0, 0, time.UTC)
+	uc := NewFallbackTask(repo, sessions, starter, newFallbackTestConfig(), &testutil.MockClock{NowTime: now}, nil)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), FallbackTaskInput{TaskID: 1, Agent: "claude-dev", ExitCode: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, sessions.WaitCalled)
+	assert.Equal(t, "codex-dev", out.Agent)
+	require.Len(t, starter.inputs, 1)
+	assert.Equal(t, "codex-dev", starter.inputs[0].Agent)
+	assert.False(t, starter.inputs[0].Continue)
+	require.Len(t, starter.inputs[0].AdditionalPrompts, 1)
+	prompt := starter.inputs[0].AdditionalPrompts[0]
+	assert.Contains(t, prompt, `"claude-dev", whose session ended with exit code 1`)
+	assert.Contains(t, prompt, "- [worker] Implemented the parser, tests pending")
+
+	require.Len(t, repo.Comments[1], 2)
+	attempt, ok := domain.FallbackAttemptFromComment(repo.Comments[1][1])
+	require.True(t, ok)
+	assert.Equal(t, domain.FallbackAttempt{Time: now, Origin: "claude-dev", From: "claude-dev", Agent: "codex-dev", Attempt: 1, ExitCode: 1}, attempt)
+}
+
+func TestFallbackTask_Execute_ContinuesChainOfOriginalAgent(t *testing.T) {
+	// Setup
+	repo := newFallbackTestRepo()
+	// codex-dev was started as claude-dev's first fallback and failed too
+	repo.Comments[1] = append(repo.Comments[1], domain.FallbackAttempt{
+		Time: repo.Tasks[1].Started, Origin: "claude-dev", From: "claude-dev", Agent: "codex-dev", Attempt: 1, ExitCode: 1,
+	}.Comment())
+	starter := &fallbackStarter{repo: repo}
+	uc := NewFallbackTask(repo, testutil.NewMockSessionManager(), starter, newFallbackTestConfig(), &testutil.MockClock{NowTime: time.Now()}, nil)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), FallbackTaskInput{TaskID: 1, Agent: "codex-dev", ExitCode: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "opencode-dev", out.Agent)
+	require.Len(t, out.Attempts, 1)
+	assert.Equal(t, "claude-dev", out.Attempts[0].Origin)
+	assert.Equal(t, 2, out.Attempts[0].Attempt)
+}
+
+func TestFallbackTask_Execute_SkipsAgentsThatFailToStart(t *testing.T) {
+	// Setup
+	repo := newFallbackTestRepo()
+	starter := &fallbackStarter{repo: repo, errs: map[string]error{"codex-dev": domain.ErrAgentNotFound}}
+	uc := NewFallbackTask(repo, testutil.NewMockSessionManager(), starter, newFallbackTestConfig(), &testutil.MockClock{NowTime: time.Now()}, nil)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), FallbackTaskInput{TaskID: 1, Agent: "claude-dev", ExitCode: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "opencode-dev", out.Agent)
+	require.Len(t, out.Attempts, 2)
+	assert.Equal(t, "codex-dev", out.Attempts[0].Agent)
+	assert.Equal(t, domain.ErrAgentNotFound.Error(), out.Attempts[0].Error)
+	assert.True(t, out.Attempts[1].Succeeded())
+	assert.Len(t, repo.Comments[1], 3)
+}
+
+func TestFallbackTask_Execute_AllAgentsFailToStart(t *testing.T) {
+	// Setup
+	repo := newFallbackTestRepo()
+	startErr := errors.New("boom")
+	starter := &fallbackStarter{repo: repo, errs: map[string]error{"codex-dev": startErr, "opencode-dev": startErr}}
+	uc := NewFallbackTask(repo, testutil.NewMockSessionManager(), starter, newFallbackTestConfig(), &testutil.MockClock{NowTime: time.Now()}, nil)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), FallbackTaskInput{TaskID: 1, Agent: "claude-dev", ExitCode: 1})
+
+	// Assert - both attempts and the exhausted chain are recorded
+	require.NoError(t, err)
+	assert.Empty(t, out.Agent)
+	require.Len(t, out.Attempts, 3)
+	assert.Empty(t, out.Attempts[2].Agent)
+	assert.Contains(t, repo.Comments[1][3].Text, "fallback chain of claude-dev exhausted")
+	assert.Equal(t, domain.StatusError, repo.Tasks[1].Status)
+}
+
+func TestFallbackTask_Execute_TaskRestartedMeanwhile(t *testing.T) {
+	// Setup
+	repo := newFallbackTestRepo()
+	repo.Tasks[1].Status = domain.StatusInProgress
+	repo.Tasks[1].Session = "crew-1"
+	starter := &fallbackStarter{repo: repo}
+	uc := NewFallbackTask(repo, testutil.NewMockSessionManager(), starter, newFallbackTestConfig(), &testutil.MockClock{NowTime: time.Now()}, nil)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), FallbackTaskInput{TaskID: 1, Agent: "claude-dev", ExitCode: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, out.Attempts)
+	assert.Empty(t, starter.inputs)
+}
+
+func TestFallbackTask_Execute_WaitError(t *testing.T) {
+	// Setup
+	repo := newFallbackTestRepo()
+	sessions := testutil.NewMockSessionManager()
+	sessions.WaitErr = context.DeadlineExceeded
+	starter := &fallbackStarter{repo: repo}
+	uc := NewFallbackTask(repo, sessions, starter, newFallbackTestConfig(), &testutil.MockClock{NowTime: time.Now()}, nil)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), FallbackTaskInput{TaskID: 1, Agent: "claude-dev", ExitCode: 1})
+
+	// Assert
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Empty(t, starter.inputs)
+}
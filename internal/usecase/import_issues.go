@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
+)
+
+// ImportIssuesInput contains the parameters for importing GitHub issues as tasks.
+// Fields are ordered to minimize memory padding.
+type ImportIssuesInput struct {
+	BaseBranch string   // Base branch for created tasks (optional, empty = use default)
+	State      string   // Issue state to import: "open" (default), "closed", or "all"
+	Labels     []string // Only import issues with all of these labels
+	Limit      int      // Maximum number of issues to fetch (0 = gh default)
+	DryRun     bool     // If true, report what would be imported without creating tasks
+}
+
+// ImportedIssue describes the result of importing a single issue.
+type ImportedIssue struct {
+	Title  string // Issue title
+	Issue  int    // Issue number
+	TaskID int    // Created task ID, or the existing task ID for skipped issues (0 in dry-run)
+}
+
+// ImportIssuesOutput contains the result of importing issues.
+type ImportIssuesOutput struct {
+	Created []ImportedIssue // Issues that were imported (or would be in dry-run mode)
+	Skipped []ImportedIssue // Issues already linked to a task
+}
+
+// ImportIssues is the use case for bulk-creating tasks from GitHub issues.
+type ImportIssues struct {
+	tasks        domain.TaskRepository
+	git          domain.Git
+	github       domain.GitHub
+	configLoader domain.ConfigLoader
+	clock        domain.Clock
+	logger       domain.Logger
+}
+
+// NewImportIssues creates a new ImportIssues use case.
+func NewImportIssues(
+	tasks domain.TaskRepository,
+	git domain.Git,
+	github domain.GitHub,
+	configLoader domain.ConfigLoader,
+	clock domain.Clock,
+	logger domain.Logger,
+) *ImportIssues {
+	return &ImportIssues{
+		tasks:        tasks,
+		git:          git,
+		github:       github,
+		configLoader: configLoader,
+		clock:        clock,
+		logger:       logger,
+	}
+}
+
+// Execute fetches matching issues and creates a task for each issue
+// that is not yet linked to a task. Issues are imported in ascending number order.
+func (uc *ImportIssues) Execute(_ context.Context, in ImportIssuesInput) (*ImportIssuesOutput, error) {
+	issues, err := uc.github.ListIssues(domain.ListIssuesOptions{
+		Labels: in.Labels,
+		State:  in.State,
+		Limit:  in.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		return issues[i].Number < issues[j].Number
+	})
+
+	index, err := shared.IssueTaskIndex(uc.tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &ImportIssuesOutput{}
+	var pending []*domain.Issue
+	for _, issue := range issues {
+		if id, ok := index[issue.Number]; ok {
+			out.Skipped = append(out.Skipped, ImportedIssue{Issue: issue.Number, Title: issue.Title, TaskID: id})
+			continue
+		}
+		pending = append(pending, issue)
+	}
+
+	if in.DryRun {
+		for _, issue := range pending {
+			out.Created = append(out.Created, ImportedIssue{Issue: issue.Number, Title: issue.Title})
+		}
+		return out, nil
+	}
+	if len(pending) == 0 {
+		return out, nil
+	}
+
+	// Load config for base branch resolution
+	var config *domain.Config
+	if uc.configLoader != nil {
+		config, err = uc.configLoader.Load()
+		if err != nil {
+			return nil, fmt.Errorf("load config: %w", err)
+		}
+	}
+	baseBranch, err := resolveNewTaskBaseBranch(in.BaseBranch, uc.git, config)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	for _, issue := range pending {
+		id, err := uc.tasks.NextID()
+		if err != nil {
+			return out, fmt.Errorf("issue #%d: generate task ID: %w", issue.Number, err)
+		}
+
+		task := &domain.Task{
+			ID:          id,
+			Title:       issue.Title,
+			Description: issue.Body,
+			Status:      domain.StatusTodo,
+			Created:     now,
+			Issue:       issue.Number,
+			Labels:      updateLabels(nil, issue.Labels, nil),
+			BaseBranch:  baseBranch,
+		}
+		if err := uc.tasks.Save(task); err != nil {
+			return out, fmt.Errorf("issue #%d: save task: %w", issue.Number, err)
+		}
+
+		if uc.logger != nil {
+			uc.logger.Info(id, "task", fmt.Sprintf("created from issue #%d: %q", issue.Number, issue.Title))
+		}
+
+		out.Created = append(out.Created, ImportedIssue{Issue: issue.Number, Title: issue.Title, TaskID: id})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newImportIssuesTest(repo *testutil.MockTaskRepository, gh *testutil.MockGitHub) *ImportIssues {
+	mockGit := &testutil.MockGit{CurrentBranchName: testutil.StringPtr("main")}
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	return NewImportIssues(repo, mockGit, gh, testutil.NewMockConfigLoader(), clock, testutil.NewMockLogger())
+}
+
+func TestImportIssues_Execute_CreatesTasks(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	gh := testutil.NewMockGitHub()
+	gh.IssueList = []*domain.Issue{
+		{Number: 7, Title: "Second", Body: "Body 7", Labels: []string{"crew"}},
+		{Number: 3, Title: "First", Body: "Body 3", Labels: []string{"crew", "bug"}},
+	}
+	uc := newImportIssuesTest(repo, gh)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), ImportIssuesInput{
+		Labels: []string{"crew"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, gh.ListedIssues)
+	assert.Equal(t, []string{"crew"}, gh.ListedIssues.Labels)
+	assert.Equal(t, []ImportedIssue{
+		{Issue: 3, Title: "First", TaskID: 1},
+		{Issue: 7, Title: "Second", TaskID: 2},
+	}, out.Created)
+	assert.Empty(t, out.Skipped)
+
+	task := repo.Tasks[1]
+	require.NotNil(t, task)
+	assert.Equal(t, "First", task.Title)
+	assert.Equal(t, "Body 3", task.Description)
+	assert.Equal(t, 3, task.Issue)
+	assert.Equal(t, []string{"bug", "crew"}, task.Labels)
+	assert.Equal(t, domain.StatusTodo, task.Status)
+	assert.Equal(t, "main", task.BaseBranch)
+}
+
+func TestImportIssues_Execute_SkipsLinkedIssues(t *testing.T) {
+	// Setup - issue #3 was imported earlier (even if the task is closed)
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "First", Issue: 3, Status: domain.StatusClosed}
+	repo.NextIDN = 2
+	gh := testutil.NewMockGitHub()
+	gh.IssueList = []*domain.Issue{
+		{Number: 3, Title: "First"},
+		{Number: 4, Title: "New"},
+	}
+	uc := newImportIssuesTest(repo, gh)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), ImportIssuesInput{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []ImportedIssue{{Issue: 4, Title: "New", TaskID: 2}}, out.Created)
+	assert.Equal(t, []ImportedIssue{{Issue: 3, Title: "First", TaskID: 1}}, out.Skipped)
+	assert.Len(t, repo.Tasks, 2)
+}
+
+func TestImportIssues_Execute_DryRun(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	gh := testutil.NewMockGitHub()
+	gh.IssueList = []*domain.Issue{{Number: 5, Title: "Issue"}}
+	uc := newImportIssuesTest(repo, gh)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), ImportIssuesInput{DryRun: true})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []ImportedIssue{{Issue: 5, Title: "Issue"}}, out.Created)
+	assert.Empty(t, repo.Tasks)
+}
+
+func TestImportIssues_Execute_PassesOptions(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	gh := testutil.NewMockGitHub()
+	uc := newImportIssuesTest(repo, gh)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), ImportIssuesInput{
+		Labels: []string{"crew", "ready"},
+		State:  "all",
+		Limit:  10,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, out.Created)
+	assert.Equal(t, &domain.ListIssuesOptions{
+		Labels: []string{"crew", "ready"},
+		State:  "all",
+		Limit:  10,
+	}, gh.ListedIssues)
+}
+
+func TestImportIssues_Execute_ListError(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	gh := testutil.NewMockGitHub()
+	gh.ListIssuesErr = assert.AnError
+	uc := newImportIssuesTest(repo, gh)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), ImportIssuesInput{})
+
+	// Assert
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestImportIssues_Execute_SaveError(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.SaveErr = assert.AnError
+	gh := testutil.NewMockGitHub()
+	gh.IssueList = []*domain.Issue{{Number: 5, Title: "Issue"}}
+	uc := newImportIssuesTest(repo, gh)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), ImportIssuesInput{})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), "issue #5")
+}
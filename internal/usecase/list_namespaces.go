@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// ListNamespacesInput contains the parameters for listing remote namespaces.
+type ListNamespacesInput struct{}
+
+// ListNamespacesOutput contains the result of listing remote namespaces.
+type ListNamespacesOutput struct {
+	Namespaces []string // Namespaces available on the remote
+}
+
+// ListNamespaces is the use case for listing task namespaces on the remote.
+type ListNamespaces struct {
+	tasks domain.TaskRepository
+}
+
+// NewListNamespaces creates a new ListNamespaces use case.
+func NewListNamespaces(tasks domain.TaskRepository) *ListNamespaces {
+	return &ListNamespaces{tasks: tasks}
+}
+
+// Execute lists the namespaces available on the remote.
+func (uc *ListNamespaces) Execute(_ context.Context, _ ListNamespacesInput) (*ListNamespacesOutput, error) {
+	namespaces, err := uc.tasks.ListNamespaces()
+	if err != nil {
+		return nil, fmt.Errorf("list namespaces: %w", err)
+	}
+	return &ListNamespacesOutput{Namespaces: namespaces}, nil
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListNamespaces_Execute(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.RemoteNamespaces = []string{"alice", "bob"}
+	uc := NewListNamespaces(repo)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), ListNamespacesInput{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice", "bob"}, out.Namespaces)
+}
+
+func TestListNamespaces_Execute_Error(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.SyncErr = assert.AnError
+	uc := NewListNamespaces(repo)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), ListNamespacesInput{})
+
+	// Assert
+	assert.ErrorIs(t, err, assert.AnError)
+}
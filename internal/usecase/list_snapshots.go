@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// ListSnapshotsInput contains the parameters for listing task snapshots.
+type ListSnapshotsInput struct {
+	MainSHA string // Only list snapshots for this commit SHA (optional, empty = all)
+}
+
+// ListSnapshotsOutput contains the result of listing task snapshots.
+type ListSnapshotsOutput struct {
+	Snapshots []domain.SnapshotInfo // Snapshots, newest first
+}
+
+// ListSnapshots is the use case for listing task snapshots.
+type ListSnapshots struct {
+	tasks domain.TaskRepository
+}
+
+// NewListSnapshots creates a new ListSnapshots use case.
+func NewListSnapshots(tasks domain.TaskRepository) *ListSnapshots {
+	return &ListSnapshots{tasks: tasks}
+}
+
+// Execute lists snapshots ordered from newest to oldest.
+func (uc *ListSnapshots) Execute(_ context.Context, in ListSnapshotsInput) (*ListSnapshotsOutput, error) {
+	snapshots, err := uc.tasks.ListSnapshots(in.MainSHA)
+	if err != nil {
+		return nil, fmt.Errorf("list snapshots: %w", err)
+	}
+
+	slices.SortStableFunc(snapshots, func(a, b domain.SnapshotInfo) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return b.Seq - a.Seq
+	})
+
+	return &ListSnapshotsOutput{Snapshots: snapshots}, nil
+}
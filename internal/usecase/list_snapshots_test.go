@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSnapshots_Execute_NewestFirst(t *testing.T) {
+	// Setup
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := testutil.NewMockTaskRepository()
+	repo.Snapshots = []domain.SnapshotInfo{
+		{Ref: "aaa_001", MainSHA: "aaa", Seq: 1, CreatedAt: base},
+		{Ref: "aaa_002", MainSHA: "aaa", Seq: 2, CreatedAt: base.Add(2 * time.Hour)},
+		{Ref: "bbb_001", MainSHA: "bbb", Seq: 1, CreatedAt: base.Add(time.Hour)},
+	}
+	uc := NewListSnapshots(repo)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), ListSnapshotsInput{})
+
+	// Assert
+	require.NoError(t, err)
+	refs := make([]string, 0, len(out.Snapshots))
+	for _, snap := range out.Snapshots {
+		refs = append(refs, snap.Ref)
+	}
+	assert.Equal(t, []string{"aaa_002", "bbb_001", "aaa_001"}, refs)
+}
+
+func TestListSnapshots_Execute_FilterBySHA(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Snapshots = []domain.SnapshotInfo{
+		{Ref: "aaa_001", MainSHA: "aaa", Seq: 1},
+		{Ref: "bbb_001", MainSHA: "bbb", Seq: 1},
+	}
+	uc := NewListSnapshots(repo)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), ListSnapshotsInput{MainSHA: "bbb"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, out.Snapshots, 1)
+	assert.Equal(t, "bbb_001", out.Snapshots[0].Ref)
+}
+
+func TestListSnapshots_Execute_Error(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.SnapshotErr = assert.AnError
+	uc := NewListSnapshots(repo)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), ListSnapshotsInput{})
+
+	// Assert
+	assert.ErrorIs(t, err, assert.AnError)
+}
@@ -2,8 +2,11 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/runoshun/git-crew/v2/internal/domain"
 	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
@@ -11,14 +14,19 @@ import (
 
 // MergeTaskInput contains the parameters for merging a task.
 type MergeTaskInput struct {
-	BaseBranch string // Target branch to merge into (defaults to task.BaseBranch or GetDefaultBranch())
-	TaskID     int    // Task ID to merge
+	BaseBranch string               // Target branch to merge into (defaults to task.BaseBranch or GetDefaultBranch())
+	Strategy   domain.MergeStrategy // Merge strategy (defaults to [merge] strategy, then "merge")
+	TaskID     int                  // Task ID to merge
 }
 
 // MergeTaskOutput contains the result of merging a task.
 type MergeTaskOutput struct {
-	Task            *domain.Task // The merged task
-	ConflictMessage string       // Conflict message to display (only set when ErrMergeConflict is returned)
+	Task            *domain.Task         // The merged task
+	ConflictMessage string               // Conflict message to display (only set when ErrMergeConflict is returned)
+	Strategy        domain.MergeStrategy // Strategy used for the merge
+	SyncError       string               // Why dependent tasks could not be updated after the merge (empty on success)
+	UnblockedTasks  []int                // Dependent tasks that became startable after this merge
+	Retargeted      []RebasedTask        // Tasks stacked on the merged branch, moved onto the base branch
 }
 
 // MergeTask is the use case for merging a task branch into main.
@@ -28,6 +36,9 @@ type MergeTask struct {
 	worktrees domain.WorktreeManager
 	git       domain.Git
 	clock     domain.Clock
+	config    domain.ConfigLoader      // Optional: [merge] settings
+	resolver  *shared.ConflictResolver // Optional: resolves conflicts before merging
+	logger    domain.Logger            // Optional: warnings after the merge
 	crewDir   string
 }
 
@@ -50,6 +61,25 @@ func NewMergeTask(
 	}
 }
 
+// WithConfig sets the config loader used for [merge] settings.
+// Without it, tasks are merged with the merge strategy and git's default message.
+func (uc *MergeTask) WithConfig(config domain.ConfigLoader) *MergeTask {
+	uc.config = config
+	return uc
+}
+
+// WithConflictResolver sets the conflict resolver run when the branch conflicts with the base branch.
+func (uc *MergeTask) WithConflictResolver(resolver *shared.ConflictResolver) *MergeTask {
+	uc.resolver = resolver
+	return uc
+}
+
+// WithLogger sets the logger for warnings about follow-up work that failed after the merge.
+func (uc *MergeTask) WithLogger(logger domain.Logger) *MergeTask {
+	uc.logger = logger
+	return uc
+}
+
 // Execute merges a task branch into the base branch.
 // Preconditions:
 // - Current branch is the base branch
@@ -61,12 +91,14 @@ func NewMergeTask(
 // - If both are empty, uses GetDefaultBranch()
 //
 // Processing:
-// 1. Execute git merge --no-ff
+// 1. Integrate the branch with the merge strategy (default: git merge --no-ff)
 // 2. If session is running, stop it
 // 3. Delete worktree
 // 4. Delete branch
 // 5. Update status to merged (with CloseReasonMerged)
-func (uc *MergeTask) Execute(_ context.Context, in MergeTaskInput) (*MergeTaskOutput, error) {
+// 6. Unblock tasks whose dependencies are now all merged
+// 7. Retarget tasks stacked on the branch onto the base branch
+func (uc *MergeTask) Execute(ctx context.Context, in MergeTaskInput) (*MergeTaskOutput, error) {
 	// Get the task
 	task, err := shared.GetTask(uc.tasks, in.TaskID)
 	if err != nil {
@@ -78,6 +110,19 @@ func (uc *MergeTask) Execute(_ context.Context, in MergeTaskInput) (*MergeTaskOu
 		return nil, fmt.Errorf("cannot merge task in %s status (must be done): %w", task.Status.Display(), domain.ErrInvalidTransition)
 	}
 
+	// Resolve strategy: input > [merge] strategy > merge
+	mergeConfig := uc.loadMergeConfig()
+	strategy := in.Strategy
+	if strategy == "" {
+		strategy = mergeConfig.Strategy
+	}
+	if strategy == "" {
+		strategy = domain.MergeStrategyMerge
+	}
+	if !strategy.IsValid() {
+		return nil, fmt.Errorf("%w: %q (expected merge, squash, rebase, or ff-only)", domain.ErrInvalidMergeStrategy, strategy)
+	}
+
 	// Determine target base branch
 	// Priority: in.BaseBranch > task.BaseBranch > GetDefaultBranch()
 	targetBaseBranch := in.BaseBranch
@@ -116,19 +161,62 @@ func (uc *MergeTask) Execute(_ context.Context, in MergeTaskInput) (*MergeTaskOu
 	branch := domain.BranchName(task.ID, task.Issue)
 
 	// Check for merge conflicts before attempting merge
-	conflictHandler := shared.NewConflictHandler(uc.tasks, uc.sessions, uc.git, uc.clock)
-	conflictOut, conflictErr := conflictHandler.CheckAndHandle(shared.ConflictCheckInput{
+	conflictHandler := shared.NewConflictHandler(uc.tasks, uc.sessions, uc.git, uc.clock).WithResolver(uc.resolver)
+	conflictOut, conflictErr := conflictHandler.CheckAndHandle(ctx, shared.ConflictCheckInput{
 		TaskID:     task.ID,
 		Branch:     branch,
 		BaseBranch: targetBaseBranch,
+		Rebase:     strategy == domain.MergeStrategyRebase,
 	})
 	if conflictErr != nil {
 		return &MergeTaskOutput{ConflictMessage: conflictOut.Message}, conflictErr
 	}
 
-	// Execute git merge --no-ff first (before deleting worktree)
+	// Build merge options
+	opts := domain.MergeOptions{Strategy: strategy}
+	if strategy.CreatesCommit() {
+		message := mergeConfig.Message
+		if message == "" && strategy == domain.MergeStrategySquash {
+			message = domain.DefaultMergeMessage
+		}
+		if message != "" {
+			rendered, renderErr := domain.RenderMergeMessage(message, domain.MergeMessageData{
+				Title:       task.Title,
+				Description: task.Description,
+				Branch:      branch,
+				BaseBranch:  targetBaseBranch,
+				Labels:      task.Labels,
+				TaskID:      task.ID,
+				Issue:       task.Issue,
+			})
+			if renderErr != nil {
+				return nil, fmt.Errorf("render merge message: %w", renderErr)
+			}
+			opts.Message = rendered
+		}
+	}
+	if strategy == domain.MergeStrategyRebase {
+		// The branch is checked out in its worktree, so rebase it there
+		if path, resolveErr := uc.worktrees.Resolve(branch); resolveErr == nil {
+			opts.WorktreeDir = path
+		}
+	}
+
+	// Record where stacked tasks branched off before the merge rewrites or deletes the branch
+	stacked, err := uc.stackedTasks(branch)
+	if err != nil {
+		return nil, err
+	}
+	var branchTip string
+	if len(stacked) > 0 {
+		if branchTip, err = uc.git.RevParse(branch); err != nil {
+			return nil, fmt.Errorf("resolve branch: %w", err)
+		}
+	}
+
+	// Merge first (before deleting worktree)
 	// This way, if merge fails due to conflict, worktree is preserved for resolution
-	if mergeErr := uc.git.Merge(branch, true); mergeErr != nil {
+	if mergeErr := uc.git.Merge(branch, opts); mergeErr != nil {
 		return nil, fmt.Errorf("merge branch: %w", mergeErr)
 	}
 
@@ -154,7 +242,8 @@ func (uc *MergeTask) Execute(_ context.Context, in MergeTaskInput) (*MergeTaskOu
 	}
 
 	// Delete the branch after merge
-	if err := uc.git.DeleteBranch(branch, false); err != nil {
+	// Squashed commits are not ancestors of the base branch, so git branch -d would refuse
+	if err := uc.git.DeleteBranch(branch, strategy == domain.MergeStrategySquash); err != nil {
 		return nil, fmt.Errorf("delete branch: %w", err)
 	}
 
@@ -163,12 +252,133 @@ func (uc *MergeTask) Execute(_ context.Context, in MergeTaskInput) (*MergeTaskOu
 	task.CloseReason = domain.CloseReasonMerged
 	task.Agent = ""
 	task.Session = ""
+	task.Stalled = ""
 
 	if err := uc.tasks.Save(task); err != nil {
 		return nil, fmt.Errorf("save task: %w", err)
 	}
 
-	return &MergeTaskOutput{Task: task}, nil
+	// The merge is already done, so failing to update dependents is reported, not returned
+	out := &MergeTaskOutput{Task: task, Strategy: strategy}
+	unblocked, err := shared.SyncDependents(uc.tasks, task.ID)
+	out.UnblockedTasks = unblocked
+	if err != nil {
+		out.SyncError = err.Error()
+		if uc.logger != nil {
+			uc.logger.Warn(task.ID, "merge", fmt.Sprintf("sync dependent tasks: %v", err))
+		}
+	}
+
+	out.Retargeted = make([]RebasedTask, 0, len(stacked))
+	for _, child := range stacked {
+		out.Retargeted = append(out.Retargeted, uc.retarget(child, task.ID, targetBaseBranch, branchTip))
+	}
+
+	return out, nil
+}
+
+// stackedTasks returns the active tasks whose base branch is branch, oldest first.
+func (uc *MergeTask) stackedTasks(branch string) ([]*domain.Task, error) {
+	tasks, err := uc.tasks.List(domain.TaskFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("list tasks: %w", err)
+	}
+	var stacked []*domain.Task
+	for _, t := range tasks {
+		if t.BaseBranch == branch && !t.Status.IsTerminal() {
+			stacked = append(stacked, t)
+		}
+	}
+	sort.Slice(stacked, func(i, j int) bool {
+		return stacked[i].ID < stacked[j].ID
+	})
+	return stacked, nil
+}
+
+// retarget moves a task stacked on the merged task onto baseBranch.
+// The new base is saved first; the worktree (if any) is then rebased with
+// 'git rebase --onto baseBranch oldTip' so only the task's own commits are replayed.
+// Problems are reported in the result rather than failing the merge, which
+// has already happened.
+func (uc *MergeTask) retarget(child *domain.Task, mergedID int, baseBranch, oldTip string) RebasedTask {
+	result := RebasedTask{TaskID: child.ID, BaseBranch: baseBranch}
+
+	child.BaseBranch = baseBranch
+	if err := uc.tasks.Save(child); err != nil {
+		result.Outcome = RebaseFailed
+		result.Reason = fmt.Sprintf("save task: %v", err)
+		return result
+	}
+
+	childBranch := domain.BranchName(child.ID, child.Issue)
+	wtPath, err := uc.worktrees.Resolve(childBranch)
+	if err != nil {
+		result.Outcome = RebaseSkipped
+		result.Reason = "not started"
+		return result
+	}
+
+	command := fmt.Sprintf("git rebase --onto %s %s", baseBranch, oldTip)
+	skip := func(reason string) RebasedTask {
+		result.Outcome = RebaseSkipped
+		result.Reason = reason
+		message := fmt.Sprintf(stackedMergedNotificationTemplate, mergedID, baseBranch, command)
+		result.Notified = uc.notify(child.ID, message)
+		return result
+	}
+	if running, _ := uc.sessions.IsRunning(domain.ReviewSessionName(child.ID)); running {
+		return skip("review in progress")
+	}
+	dirty, err := uc.git.HasUncommittedChanges(wtPath)
+	if err != nil {
+		result.Outcome = RebaseFailed
+		result.Reason = fmt.Sprintf("check uncommitted changes: %v", err)
+		return result
+	}
+	if dirty {
+		return skip("uncommitted changes")
+	}
+
+	conflicts, err := uc.git.RebaseOnto(wtPath, baseBranch, oldTip)
+	switch {
+	case errors.Is(err, domain.ErrMergeConflict):
+		result.Outcome = RebaseConflict
+		result.Conflicts = conflicts
+		message := fmt.Sprintf(stackedConflictNotificationTemplate, mergedID, baseBranch, strings.Join(conflicts, ", "), command)
+		result.Notified = uc.notify(child.ID, message)
+	case err != nil:
+		result.Outcome = RebaseFailed
+		result.Reason = err.Error()
+	default:
+		result.Outcome = RebaseUpdated
+	}
+	return result
+}
+
+// notify sends message to the task's running session. Returns true if it was delivered.
+func (uc *MergeTask) notify(taskID int, message string) bool {
+	if running, _ := uc.sessions.IsRunning(domain.SessionName(taskID)); !running {
+		return false
+	}
+	return shared.SendSessionNotification(uc.sessions, taskID, message) == nil
+}
+
+// Notifications sent to the session of a task whose stacked-on task was merged.
+const (
+	stackedMergedNotificationTemplate   = "Task #%d, which this task is stacked on, was merged into %s. Please commit your work and run '%s'."
+	stackedConflictNotificationTemplate = "Task #%d, which this task is stacked on, was merged into %s, and rebasing onto it conflicts in: %s. Please run '%s', resolve the conflicts, and continue."
+)
+
+// loadMergeConfig returns the [merge] settings, or zero values without configuration.
+func (uc *MergeTask) loadMergeConfig() domain.MergeConfig {
+	if uc.config == nil {
+		return domain.MergeConfig{}
+	}
+	cfg, err := uc.config.Load()
+	if err != nil || cfg == nil {
+		return domain.MergeConfig{}
+	}
+	return cfg.Merge
 }
 
 // cleanupScriptFiles removes the generated script files.
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/runoshun/git-crew/v2/internal/domain"
@@ -729,3 +730,326 @@ func TestMergeTask_Execute_NoMergeConflict(t *testing.T) {
 	// Merge should have been called
 	assert.True(t, git.MergeCalled)
 }
+
+func TestMergeTask_Execute_UnblocksDependents(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:         1,
+		Title:      "Task to merge",
+		Status:     domain.StatusDone,
+		BaseBranch: "main",
+	}
+	repo.Tasks[2] = &domain.Task{
+		ID:          2,
+		Title:       "Dependent task",
+		Status:      domain.StatusTodo,
+		DependsOn:   []int{1},
+		BlockReason: "Depends on #1",
+	}
+	repo.Tasks[3] = &domain.Task{ID: 3, Title: "Other dependency", Status: domain.StatusTodo}
+	repo.Tasks[4] = &domain.Task{
+		ID:          4,
+		Title:       "Task with two dependencies",
+		Status:      domain.StatusTodo,
+		DependsOn:   []int{1, 3},
+		BlockReason: "Depends on #1, #3",
+	}
+	sessions := testutil.NewMockSessionManager()
+	worktrees := testutil.NewMockWorktreeManager()
+	git := &testutil.MockGit{
+		CurrentBranchName: testutil.StringPtr("main"),
+	}
+
+	uc := NewMergeTask(repo, sessions, worktrees, git, &testutil.MockClock{}, t.TempDir())
+
+	// Execute
+	out, err := uc.Execute(context.Background(), MergeTaskInput{
+		TaskID: 1,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, out.UnblockedTasks)
+	assert.False(t, repo.Tasks[2].IsBlocked())
+	assert.Equal(t, "Depends on #3", repo.Tasks[4].BlockReason)
+}
+
+func newStackedMergeTest(t *testing.T) (*testutil.MockTaskRepository, *testutil.MockSessionManager, *testutil.MockWorktreeManager, *testutil.MockGit) {
+	t.Helper()
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Parent", Status: domain.StatusDone, BaseBranch: "main"}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Stacked child", Status: domain.StatusInProgress, BaseBranch: "crew-1"}
+	repo.Tasks[3] = &domain.Task{ID: 3, Title: "Closed child", Status: domain.StatusClosed, BaseBranch: "crew-1"}
+	repo.Tasks[4] = &domain.Task{ID: 4, Title: "Unrelated", Status: domain.StatusInProgress, BaseBranch: "main"}
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.ResolvePath = "/tmp/worktrees/crew-2"
+	git := &testutil.MockGit{
+		CurrentBranchName: testutil.StringPtr("main"),
+		RevParseSHA:       "parent-tip",
+	}
+	return repo, testutil.NewMockSessionManager(), worktrees, git
+}
+
+func TestMergeTask_Execute_RetargetsStackedTasks(t *testing.T) {
+	// Setup
+	repo, sessions, worktrees, git := newStackedMergeTest(t)
+	uc := NewMergeTask(repo, sessions, worktrees, git, &testutil.MockClock{}, t.TempDir())
+
+	// Execute
+	out, err := uc.Execute(context.Background(), MergeTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, out.Retargeted, 1)
+	assert.Equal(t, RebasedTask{TaskID: 2, BaseBranch: "main", Outcome: RebaseUpdated}, out.Retargeted[0])
+	assert.Equal(t, []string{"/tmp/worktrees/crew-2 main parent-tip"}, git.RebaseOntoCalls)
+	assert.Equal(t, "main", repo.Tasks[2].BaseBranch)
+	// Terminal tasks keep their base branch
+	assert.Equal(t, "crew-1", repo.Tasks[3].BaseBranch)
+}
+
+func TestMergeTask_Execute_RetargetConflictNotifiesSession(t *testing.T) {
+	// Setup
+	repo, sessions, worktrees, git := newStackedMergeTest(t)
+	git.UpdateBranchErr = domain.ErrMergeConflict
+	git.UpdateBranchConflicts = []string{"api.go"}
+	sessions.IsRunningFunc = func(name string) (bool, error) {
+		return name == domain.SessionName(2), nil
+	}
+	var sent []string
+	sessions.SendFunc = func(_, keys string) error {
+		sent = append(sent, keys)
+		return nil
+	}
+	uc := NewMergeTask(repo, sessions, worktrees, git, &testutil.MockClock{}, t.TempDir())
+
+	// Execute
+	out, err := uc.Execute(context.Background(), MergeTaskInput{TaskID: 1})
+
+	// Assert: the parent merge still succeeds
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusMerged, repo.Tasks[1].Status)
+	result := out.Retargeted[0]
+	assert.Equal(t, RebaseConflict, result.Outcome)
+	assert.Equal(t, []string{"api.go"}, result.Conflicts)
+	assert.True(t, result.Notified)
+	require.NotEmpty(t, sent)
+	assert.Contains(t, sent[0], "'git rebase --onto main parent-tip'")
+	assert.Equal(t, "main", repo.Tasks[2].BaseBranch)
+}
+
+func TestMergeTask_Execute_RetargetNotStarted(t *testing.T) {
+	// Setup
+	repo, sessions, worktrees, git := newStackedMergeTest(t)
+	worktrees.ResolveErr = domain.ErrWorktreeNotFound
+	uc := NewMergeTask(repo, sessions, worktrees, git, &testutil.MockClock{}, t.TempDir())
+
+	// Execute
+	out, err := uc.Execute(context.Background(), MergeTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, RebaseSkipped, out.Retargeted[0].Outcome)
+	assert.Equal(t, "not started", out.Retargeted[0].Reason)
+	assert.Empty(t, git.RebaseOntoCalls)
+	assert.Equal(t, "main", repo.Tasks[2].BaseBranch)
+}
+
+// saveFailingRepository fails to save one task.
+type saveFailingRepository struct {
+	*testutil.MockTaskRepository
+	failID int
+}
+
+func (r *saveFailingRepository) Save(task *domain.Task) error {
+	if task.ID == r.failID {
+		return errors.New("disk full")
+	}
+	return r.MockTaskRepository.Save(task)
+}
+
+func TestMergeTask_Execute_RetargetSaveFailureContinues(t *testing.T) {
+	// Setup: two stacked children, the first cannot be saved
+	repo, sessions, worktrees, git := newStackedMergeTest(t)
+	repo.Tasks[5] = &domain.Task{ID: 5, Title: "Second stacked child", Status: domain.StatusTodo, BaseBranch: "crew-1"}
+	uc := NewMergeTask(&saveFailingRepository{MockTaskRepository: repo, failID: 2}, sessions, worktrees, git, &testutil.MockClock{}, t.TempDir())
+
+	// Execute
+	out, err := uc.Execute(context.Background(), MergeTaskInput{TaskID: 1})
+
+	// Assert: the merge is reported and the other child is still retargeted
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusMerged, out.Task.Status)
+	require.Len(t, out.Retargeted, 2)
+	assert.Equal(t, 2, out.Retargeted[0].TaskID)
+	assert.Equal(t, RebaseFailed, out.Retargeted[0].Outcome)
+	assert.Equal(t, "save task: disk full", out.Retargeted[0].Reason)
+	assert.Equal(t, 5, out.Retargeted[1].TaskID)
+	assert.Equal(t, RebaseUpdated, out.Retargeted[1].Outcome)
+	assert.Equal(t, "main", repo.Tasks[5].BaseBranch)
+}
+
+func newMergeStrategyTest(t *testing.T) (*testutil.MockTaskRepository, *testutil.MockWorktreeManager, *testutil.MockGit, *testutil.MockConfigLoader) {
+	t.Helper()
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:          1,
+		Title:       "Add login",
+		Description: "Implements the login form",
+		Status:      domain.StatusDone,
+		Issue:       42,
+		Labels:      []string{"feature", "ui"},
+		BaseBranch:  "main",
+	}
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.ExistsVal = true
+	git := &testutil.MockGit{
+		CurrentBranchName: testutil.StringPtr("main"),
+	}
+	return repo, worktrees, git, testutil.NewMockConfigLoader()
+}
+
+func TestMergeTask_Execute_StrategyFromConfig(t *testing.T) {
+	// Setup
+	repo, worktrees, git, config := newMergeStrategyTest(t)
+	config.Config.Merge.Strategy = domain.MergeStrategyFFOnly
+	uc := NewMergeTask(repo, testutil.NewMockSessionManager(), worktrees, git, &testutil.MockClock{}, t.TempDir()).
+		WithConfig(config)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), MergeTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.MergeStrategyFFOnly, out.Strategy)
+	assert.Equal(t, domain.MergeStrategyFFOnly, git.MergeOpts.Strategy)
+	assert.Empty(t, git.MergeOpts.Message)
+	assert.False(t, git.MergeNoFF)
+	assert.False(t, git.DeleteBranchForce)
+}
+
+func TestMergeTask_Execute_InputStrategyOverridesConfig(t *testing.T) {
+	// Setup
+	repo, worktrees, git, config := newMergeStrategyTest(t)
+	config.Config.Merge.Strategy = domain.MergeStrategyFFOnly
+	uc := NewMergeTask(repo, testutil.NewMockSessionManager(), worktrees, git, &testutil.MockClock{}, t.TempDir()).
+		WithConfig(config)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), MergeTaskInput{TaskID: 1, Strategy: domain.MergeStrategyMerge})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.MergeStrategyMerge, out.Strategy)
+	assert.True(t, git.MergeNoFF)
+}
+
+func TestMergeTask_Execute_SquashUsesDefaultMessage(t *testing.T) {
+	// Setup
+	repo, worktrees, git, config := newMergeStrategyTest(t)
+	uc := NewMergeTask(repo, testutil.NewMockSessionManager(), worktrees, git, &testutil.MockClock{}, t.TempDir()).
+		WithConfig(config)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), MergeTaskInput{TaskID: 1, Strategy: domain.MergeStrategySquash})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.MergeStrategySquash, out.Strategy)
+	assert.Equal(t, domain.MergeStrategySquash, git.MergeOpts.Strategy)
+	assert.Equal(t, "Add login (#42)\n\nCrew-Task: 1", git.MergeOpts.Message)
+	// Squashed branches are not ancestors of the base branch, so deletion must be forced
+	assert.True(t, git.DeleteBranchForce)
+}
+
+func TestMergeTask_Execute_CustomMessage(t *testing.T) {
+	// Setup
+	repo, worktrees, git, config := newMergeStrategyTest(t)
+	config.Config.Merge.Strategy = domain.MergeStrategySquash
+	config.Config.Merge.Message = "{{.Title}} [{{join .Labels \", \"}}]\n\n{{.Description}}\n\nBranch: {{.Branch}} -> {{.BaseBranch}}"
+	uc := NewMergeTask(repo, testutil.NewMockSessionManager(), worktrees, git, &testutil.MockClock{}, t.TempDir()).
+		WithConfig(config)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), MergeTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Add login [feature, ui]\n\nImplements the login form\n\nBranch: crew-1-gh-42 -> main", git.MergeOpts.Message)
+}
+
+func TestMergeTask_Execute_InvalidMessageTemplate(t *testing.T) {
+	// Setup
+	repo, worktrees, git, config := newMergeStrategyTest(t)
+	config.Config.Merge.Message = "{{.Title"
+	uc := NewMergeTask(repo, testutil.NewMockSessionManager(), worktrees, git, &testutil.MockClock{}, t.TempDir()).
+		WithConfig(config)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), MergeTaskInput{TaskID: 1, Strategy: domain.MergeStrategySquash})
+
+	// Assert
+	require.Error(t, err)
+	assert.False(t, git.MergeCalled)
+}
+
+func TestMergeTask_Execute_RebaseUsesWorktree(t *testing.T) {
+	// Setup
+	repo, worktrees, git, config := newMergeStrategyTest(t)
+	worktrees.ResolvePath = "/tmp/worktrees/crew-1"
+	uc := NewMergeTask(repo, testutil.NewMockSessionManager(), worktrees, git, &testutil.MockClock{}, t.TempDir()).
+		WithConfig(config)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), MergeTaskInput{TaskID: 1, Strategy: domain.MergeStrategyRebase})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.MergeStrategyRebase, out.Strategy)
+	assert.Equal(t, "/tmp/worktrees/crew-1", git.MergeOpts.WorktreeDir)
+	assert.Empty(t, git.MergeOpts.Message)
+	assert.False(t, git.DeleteBranchForce)
+}
+
+func TestMergeTask_Execute_InvalidStrategy(t *testing.T) {
+	// Setup
+	repo, worktrees, git, _ := newMergeStrategyTest(t)
+	uc := NewMergeTask(repo, testutil.NewMockSessionManager(), worktrees, git, &testutil.MockClock{}, t.TempDir())
+
+	// Execute
+	_, err := uc.Execute(context.Background(), MergeTaskInput{TaskID: 1, Strategy: "octopus"})
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrInvalidMergeStrategy)
+	assert.False(t, git.MergeCalled)
+}
+
+func TestMergeTask_Execute_SyncDependentsFailureContinues(t *testing.T) {
+	// Setup: a dependent task that cannot be saved and a stacked child
+	repo, sessions, worktrees, git := newStackedMergeTest(t)
+	repo.Tasks[5] = &domain.Task{
+		ID:          5,
+		Title:       "Dependent",
+		Status:      domain.StatusTodo,
+		DependsOn:   []int{1},
+		BlockReason: "Depends on #1",
+	}
+	logger := testutil.NewMockLogger()
+	uc := NewMergeTask(&saveFailingRepository{MockTaskRepository: repo, failID: 5}, sessions, worktrees, git, &testutil.MockClock{}, t.TempDir()).
+		WithLogger(logger)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), MergeTaskInput{TaskID: 1})
+
+	// Assert: the merge is reported and stacked tasks are still retargeted
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusMerged, out.Task.Status)
+	assert.Empty(t, out.UnblockedTasks)
+	assert.Contains(t, out.SyncError, "disk full")
+	require.Len(t, out.Retargeted, 1)
+	assert.Equal(t, RebaseUpdated, out.Retargeted[0].Outcome)
+	require.Len(t, logger.Entries, 1)
+	assert.Equal(t, "WARN", logger.Entries[0].Level)
+	assert.Contains(t, logger.Entries[0].Msg, "sync dependent tasks")
+}
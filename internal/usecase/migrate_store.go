@@ -133,6 +133,9 @@ func cloneTask(task *domain.Task) *domain.Task {
 	if task.Labels != nil {
 		cloned.Labels = append([]string{}, task.Labels...)
 	}
+	if task.DependsOn != nil {
+		cloned.DependsOn = append([]int{}, task.DependsOn...)
+	}
 	return &cloned
 }
 
@@ -148,6 +151,7 @@ func normalizeTaskForCompare(task *domain.Task) *domain.Task {
 			cloned.Labels = nil
 		}
 	}
+	cloned.DependsOn = domain.NormalizeDependencies(cloned.DependsOn)
 	domain.NormalizeStatus(cloned)
 	return cloned
 }
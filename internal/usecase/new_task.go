@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
 )
 
 // NewTaskInput contains the parameters for creating a new task.
@@ -13,11 +14,13 @@ import (
 type NewTaskInput struct {
 	ParentID    *int     // Parent task ID (optional, nil = root task)
 	SkipReview  *bool    // Skip review on completion (nil=use config, true=skip, false=require review)
-	Title       string   // Task title (required)
+	Title       string   // Task title (required unless fetched from Issue)
 	Description string   // Task description (optional)
 	BaseBranch  string   // Base branch (optional, empty = use default)
 	Labels      []string // Labels (optional)
 	Issue       int      // Linked GitHub issue number (0 = not linked)
+	Stack       bool     // Base the task on the parent's branch instead of BaseBranch
+	Force       bool     // Link Issue even if an active task is already linked to it
 }
 
 // NewTaskOutput contains the result of creating a new task.
@@ -32,6 +35,7 @@ type NewTask struct {
 	configLoader domain.ConfigLoader
 	clock        domain.Clock
 	logger       domain.Logger
+	github       domain.GitHub
 }
 
 // NewNewTask creates a new NewTask use case.
@@ -45,8 +49,38 @@ func NewNewTask(tasks domain.TaskRepository, git domain.Git, configLoader domain
 	}
 }
 
+// WithGitHub sets the GitHub client used to fetch linked issues.
+func (uc *NewTask) WithGitHub(github domain.GitHub) *NewTask {
+	uc.github = github
+	return uc
+}
+
 // Execute creates a new task with the given input.
+// When the task is linked to an issue and the title or description is missing,
+// they are fetched from the issue and the issue labels are added.
+// With Stack, the task's base branch is the parent task's branch.
 func (uc *NewTask) Execute(_ context.Context, in NewTaskInput) (*NewTaskOutput, error) {
+	if in.Issue > 0 {
+		// Reject issues that are already worked on by an active top-level task
+		if !in.Force {
+			index, err := shared.ActiveIssueTaskIndex(uc.tasks)
+			if err != nil {
+				return nil, err
+			}
+			if id, ok := index[in.Issue]; ok {
+				return nil, fmt.Errorf("%w: issue #%d is linked to task #%d", domain.ErrIssueAlreadyLinked, in.Issue, id)
+			}
+		}
+
+		if uc.github != nil && (in.Title == "" || in.Description == "") {
+			issue, err := uc.github.GetIssue(in.Issue)
+			if err != nil {
+				return nil, err
+			}
+			in = applyIssue(in, issue)
+		}
+	}
+
 	// Validate title
 	if in.Title == "" {
 		return nil, domain.ErrEmptyTitle
@@ -61,6 +95,14 @@ func (uc *NewTask) Execute(_ context.Context, in NewTaskInput) (*NewTaskOutput,
 		if parent == nil {
 			return nil, domain.ErrParentNotFound
 		}
+		if in.Stack {
+			if parent.Status.IsTerminal() {
+				return nil, fmt.Errorf("parent task #%d is %s: %w", parent.ID, parent.Status, domain.ErrCannotStack)
+			}
+			in.BaseBranch = domain.BranchName(parent.ID, parent.Issue)
+		}
+	} else if in.Stack {
+		return nil, fmt.Errorf("no parent task specified: %w", domain.ErrCannotStack)
 	}
 
 	// Get next task ID
@@ -109,3 +151,15 @@ func (uc *NewTask) Execute(_ context.Context, in NewTaskInput) (*NewTaskOutput,
 
 	return &NewTaskOutput{TaskID: id}, nil
 }
+
+// applyIssue fills missing title and description from the issue and adds its labels.
+func applyIssue(in NewTaskInput, issue *domain.Issue) NewTaskInput {
+	if in.Title == "" {
+		in.Title = issue.Title
+	}
+	if in.Description == "" {
+		in.Description = issue.Body
+	}
+	in.Labels = updateLabels(in.Labels, issue.Labels, nil)
+	return in
+}
@@ -78,6 +78,64 @@ func TestNewTask_Execute_WithParent(t *testing.T) {
 	assert.Equal(t, parentID, *task.ParentID)
 }
 
+func TestNewTask_Execute_Stack(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	mockGit := &testutil.MockGit{CurrentBranchName: testutil.StringPtr("main")}
+	configLoader := testutil.NewMockConfigLoader()
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	uc := NewNewTask(repo, mockGit, configLoader, clock, nil)
+	parentID := 1
+	repo.Tasks[parentID] = &domain.Task{ID: parentID, Title: "Parent task", Status: domain.StatusInProgress, Issue: 7}
+	repo.NextIDN = 2
+
+	// Execute
+	out, err := uc.Execute(context.Background(), NewTaskInput{
+		Title:    "Child task",
+		ParentID: &parentID,
+		Stack:    true,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	task := repo.Tasks[out.TaskID]
+	assert.Equal(t, "crew-1-gh-7", task.BaseBranch)
+	stackedOn, stacked := task.StackedOn()
+	assert.True(t, stacked)
+	assert.Equal(t, parentID, stackedOn)
+}
+
+func TestNewTask_Execute_StackErrors(t *testing.T) {
+	tests := []struct {
+		parent *domain.Task
+		name   string
+	}{
+		{name: "no parent"},
+		{name: "merged parent", parent: &domain.Task{ID: 1, Title: "Parent task", Status: domain.StatusMerged}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			repo := testutil.NewMockTaskRepository()
+			mockGit := &testutil.MockGit{CurrentBranchName: testutil.StringPtr("main")}
+			uc := NewNewTask(repo, mockGit, testutil.NewMockConfigLoader(), &testutil.MockClock{}, nil)
+			in := NewTaskInput{Title: "Child task", Stack: true}
+			if tt.parent != nil {
+				repo.Tasks[tt.parent.ID] = tt.parent
+				repo.NextIDN = 2
+				in.ParentID = &tt.parent.ID
+			}
+
+			// Execute
+			_, err := uc.Execute(context.Background(), in)
+
+			// Assert
+			assert.ErrorIs(t, err, domain.ErrCannotStack)
+		})
+	}
+}
+
 func TestNewTask_Execute_WithBaseBranch(t *testing.T) {
 	// Setup
 	repo := testutil.NewMockTaskRepository()
@@ -126,6 +184,155 @@ func TestNewTask_Execute_WithIssue(t *testing.T) {
 	assert.Equal(t, 123, task.Issue)
 }
 
+func TestNewTask_Execute_FetchesIssue(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	mockGit := &testutil.MockGit{CurrentBranchName: testutil.StringPtr("main")}
+	configLoader := testutil.NewMockConfigLoader()
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	gh := testutil.NewMockGitHub()
+	gh.Issues[42] = &domain.Issue{
+		Number: 42,
+		Title:  "Login fails",
+		Body:   "Steps to reproduce...",
+		Labels: []string{"bug", "crew"},
+	}
+	uc := NewNewTask(repo, mockGit, configLoader, clock, nil).WithGitHub(gh)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), NewTaskInput{
+		Issue:  42,
+		Labels: []string{"urgent"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	task := repo.Tasks[out.TaskID]
+	require.NotNil(t, task)
+	assert.Equal(t, "Login fails", task.Title)
+	assert.Equal(t, "Steps to reproduce...", task.Description)
+	assert.Equal(t, []string{"bug", "crew", "urgent"}, task.Labels)
+	assert.Equal(t, 42, task.Issue)
+}
+
+func TestNewTask_Execute_IssueKeepsExplicitFields(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	mockGit := &testutil.MockGit{CurrentBranchName: testutil.StringPtr("main")}
+	configLoader := testutil.NewMockConfigLoader()
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	gh := testutil.NewMockGitHub()
+	gh.Issues[42] = &domain.Issue{Number: 42, Title: "Issue title", Body: "Issue body"}
+	uc := NewNewTask(repo, mockGit, configLoader, clock, nil).WithGitHub(gh)
+
+	// Execute - title given, body fetched
+	out, err := uc.Execute(context.Background(), NewTaskInput{
+		Title: "Custom title",
+		Issue: 42,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	task := repo.Tasks[out.TaskID]
+	assert.Equal(t, "Custom title", task.Title)
+	assert.Equal(t, "Issue body", task.Description)
+}
+
+func TestNewTask_Execute_IssueFetchError(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	mockGit := &testutil.MockGit{CurrentBranchName: testutil.StringPtr("main")}
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	gh := testutil.NewMockGitHub()
+	gh.GetIssueErr = assert.AnError
+	uc := NewNewTask(repo, mockGit, testutil.NewMockConfigLoader(), clock, nil).WithGitHub(gh)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), NewTaskInput{Issue: 42})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, repo.Tasks)
+}
+
+func TestNewTask_Execute_IssueAlreadyLinked(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Existing", Issue: 42, Status: domain.StatusTodo}
+	repo.NextIDN = 2
+	mockGit := &testutil.MockGit{CurrentBranchName: testutil.StringPtr("main")}
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	gh := testutil.NewMockGitHub()
+	uc := NewNewTask(repo, mockGit, testutil.NewMockConfigLoader(), clock, nil).WithGitHub(gh)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), NewTaskInput{Title: "Duplicate", Issue: 42})
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrIssueAlreadyLinked)
+	assert.Contains(t, err.Error(), "task #1")
+	assert.Len(t, repo.Tasks, 1)
+}
+
+func TestNewTask_Execute_IssueLinkedToFinishedTask(t *testing.T) {
+	for _, status := range []domain.Status{domain.StatusClosed, domain.StatusMerged} {
+		t.Run(string(status), func(t *testing.T) {
+			// Setup
+			repo := testutil.NewMockTaskRepository()
+			repo.Tasks[1] = &domain.Task{ID: 1, Title: "Earlier attempt", Issue: 42, Status: status}
+			repo.NextIDN = 2
+			mockGit := &testutil.MockGit{CurrentBranchName: testutil.StringPtr("main")}
+			clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+			uc := NewNewTask(repo, mockGit, testutil.NewMockConfigLoader(), clock, nil)
+
+			// Execute
+			out, err := uc.Execute(context.Background(), NewTaskInput{Title: "Retry", Issue: 42})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, 42, repo.Tasks[out.TaskID].Issue)
+		})
+	}
+}
+
+func TestNewTask_Execute_IssueLinkedToSubTask(t *testing.T) {
+	// Setup
+	parentID := 1
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Parent", Status: domain.StatusInProgress}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Part of the issue", Issue: 42, Status: domain.StatusTodo, ParentID: &parentID}
+	repo.NextIDN = 3
+	mockGit := &testutil.MockGit{CurrentBranchName: testutil.StringPtr("main")}
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	uc := NewNewTask(repo, mockGit, testutil.NewMockConfigLoader(), clock, nil)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), NewTaskInput{Title: "Whole issue", Issue: 42})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 3, out.TaskID)
+	assert.Equal(t, 42, repo.Tasks[3].Issue)
+}
+
+func TestNewTask_Execute_IssueAlreadyLinkedForce(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Existing", Issue: 42, Status: domain.StatusInProgress}
+	repo.NextIDN = 2
+	mockGit := &testutil.MockGit{CurrentBranchName: testutil.StringPtr("main")}
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	uc := NewNewTask(repo, mockGit, testutil.NewMockConfigLoader(), clock, nil)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), NewTaskInput{Title: "Second take", Issue: 42, Force: true})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 42, repo.Tasks[out.TaskID].Issue)
+	assert.Len(t, repo.Tasks, 2)
+}
+
 func TestNewTask_Execute_EmptyTitle(t *testing.T) {
 	// Setup
 	repo := testutil.NewMockTaskRepository()
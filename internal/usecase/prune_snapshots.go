@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// DefaultSnapshotKeep is the default number of snapshots kept per commit SHA when pruning.
+const DefaultSnapshotKeep = 5
+
+// PruneSnapshotsInput contains the parameters for pruning task snapshots.
+type PruneSnapshotsInput struct {
+	Keep int // Number of snapshots to keep per commit SHA (0 = DefaultSnapshotKeep)
+}
+
+// PruneSnapshotsOutput contains the result of pruning task snapshots.
+type PruneSnapshotsOutput struct {
+	Removed []domain.SnapshotInfo // Snapshots that were removed
+}
+
+// PruneSnapshots is the use case for removing old task snapshots.
+type PruneSnapshots struct {
+	tasks domain.TaskRepository
+}
+
+// NewPruneSnapshots creates a new PruneSnapshots use case.
+func NewPruneSnapshots(tasks domain.TaskRepository) *PruneSnapshots {
+	return &PruneSnapshots{tasks: tasks}
+}
+
+// Execute removes old snapshots, keeping the most recent ones per commit SHA.
+func (uc *PruneSnapshots) Execute(_ context.Context, in PruneSnapshotsInput) (*PruneSnapshotsOutput, error) {
+	keep := in.Keep
+	if keep <= 0 {
+		keep = DefaultSnapshotKeep
+	}
+
+	before, err := uc.tasks.ListSnapshots("")
+	if err != nil {
+		return nil, fmt.Errorf("list snapshots: %w", err)
+	}
+
+	if err := uc.tasks.PruneSnapshots(keep); err != nil {
+		return nil, fmt.Errorf("prune snapshots: %w", err)
+	}
+
+	after, err := uc.tasks.ListSnapshots("")
+	if err != nil {
+		return nil, fmt.Errorf("list snapshots: %w", err)
+	}
+	remaining := make(map[string]bool, len(after))
+	for _, snap := range after {
+		remaining[snap.Ref] = true
+	}
+
+	out := &PruneSnapshotsOutput{}
+	for _, snap := range before {
+		if !remaining[snap.Ref] {
+			out.Removed = append(out.Removed, snap)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneSnapshots_Execute(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Snapshots = []domain.SnapshotInfo{
+		{Ref: "aaa_001", MainSHA: "aaa", Seq: 1},
+		{Ref: "aaa_002", MainSHA: "aaa", Seq: 2},
+		{Ref: "aaa_003", MainSHA: "aaa", Seq: 3},
+		{Ref: "bbb_001", MainSHA: "bbb", Seq: 1},
+	}
+	uc := NewPruneSnapshots(repo)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), PruneSnapshotsInput{Keep: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []domain.SnapshotInfo{
+		{Ref: "aaa_001", MainSHA: "aaa", Seq: 1},
+		{Ref: "aaa_002", MainSHA: "aaa", Seq: 2},
+	}, out.Removed)
+	assert.Len(t, repo.Snapshots, 2)
+}
+
+func TestPruneSnapshots_Execute_DefaultKeep(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	for range DefaultSnapshotKeep + 2 {
+		require.NoError(t, repo.SaveSnapshot("aaa"))
+	}
+	uc := NewPruneSnapshots(repo)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), PruneSnapshotsInput{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, out.Removed, 2)
+	assert.Len(t, repo.Snapshots, DefaultSnapshotKeep)
+}
+
+func TestPruneSnapshots_Execute_Error(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.SnapshotErr = assert.AnError
+	uc := NewPruneSnapshots(repo)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), PruneSnapshotsInput{Keep: 1})
+
+	// Assert
+	assert.ErrorIs(t, err, assert.AnError)
+}
@@ -39,8 +39,14 @@ func (m *mockGitForPrune) HasMergeConflict(string, string) (bool, error) { retur
 func (m *mockGitForPrune) GetMergeConflictFiles(string, string) ([]string, error) {
 	return nil, nil
 }
-func (m *mockGitForPrune) Merge(string, bool) error          { return nil }
-func (m *mockGitForPrune) GetDefaultBranch() (string, error) { return "main", nil }
+func (m *mockGitForPrune) Merge(string, domain.MergeOptions) error { return nil }
+func (m *mockGitForPrune) GetDefaultBranch() (string, error)       { return "main", nil }
+func (m *mockGitForPrune) RevParse(rev string) (string, error)     { return rev, nil }
+func (m *mockGitForPrune) UpdateBranch(string, string, domain.MergeStrategy) ([]string, error) {
+	return nil, nil
+}
+func (m *mockGitForPrune) BranchDiff(string, string, []string) (string, error) { return "", nil }
+func (m *mockGitForPrune) RebaseOnto(string, string, string) ([]string, error) { return nil, nil }
 
 type mockWorktreeForPrune struct {
 	worktrees []domain.WorktreeInfo
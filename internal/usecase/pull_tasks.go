@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// PullTasksInput contains the parameters for pulling tasks from the remote.
+type PullTasksInput struct {
+	Namespaces []string // Namespaces to pull (empty = all namespaces on the remote)
+}
+
+// PullTasksOutput contains the result of pulling tasks from the remote.
+type PullTasksOutput struct {
+	Namespaces []string // Namespaces that were pulled
+}
+
+// PullTasks is the use case for merging task boards from the remote.
+type PullTasks struct {
+	tasks  domain.TaskRepository
+	logger domain.Logger
+}
+
+// NewPullTasks creates a new PullTasks use case.
+func NewPullTasks(tasks domain.TaskRepository, logger domain.Logger) *PullTasks {
+	return &PullTasks{
+		tasks:  tasks,
+		logger: logger,
+	}
+}
+
+// Execute fetches the namespaces from the remote and merges them into the local task board.
+func (uc *PullTasks) Execute(_ context.Context, in PullTasksInput) (*PullTasksOutput, error) {
+	namespaces := in.Namespaces
+	if len(namespaces) == 0 {
+		var err error
+		namespaces, err = uc.tasks.ListNamespaces()
+		if err != nil {
+			return nil, fmt.Errorf("list namespaces: %w", err)
+		}
+	}
+
+	out := &PullTasksOutput{}
+	for _, namespace := range namespaces {
+		if err := uc.tasks.Fetch(namespace); err != nil {
+			return out, fmt.Errorf("pull namespace %s: %w", namespace, err)
+		}
+		out.Namespaces = append(out.Namespaces, namespace)
+
+		if uc.logger != nil {
+			uc.logger.Info(0, "sync", fmt.Sprintf("pulled namespace %s", namespace))
+		}
+	}
+
+	return out, nil
+}
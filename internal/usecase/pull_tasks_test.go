@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullTasks_Execute_AllNamespaces(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.RemoteNamespaces = []string{"alice", "bob"}
+	uc := NewPullTasks(repo, testutil.NewMockLogger())
+
+	// Execute
+	out, err := uc.Execute(context.Background(), PullTasksInput{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice", "bob"}, out.Namespaces)
+	assert.Equal(t, []string{"alice", "bob"}, repo.FetchedNamespaces)
+}
+
+func TestPullTasks_Execute_SelectedNamespaces(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.RemoteNamespaces = []string{"alice", "bob"}
+	uc := NewPullTasks(repo, nil)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), PullTasksInput{Namespaces: []string{"bob"}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bob"}, out.Namespaces)
+	assert.Equal(t, []string{"bob"}, repo.FetchedNamespaces)
+}
+
+func TestPullTasks_Execute_Error(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.SyncErr = assert.AnError
+	uc := NewPullTasks(repo, nil)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), PullTasksInput{Namespaces: []string{"alice"}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), "alice")
+}
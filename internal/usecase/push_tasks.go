@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// PushTasksInput contains the parameters for pushing tasks to the remote.
+type PushTasksInput struct{}
+
+// PushTasksOutput contains the result of pushing tasks to the remote.
+type PushTasksOutput struct{}
+
+// PushTasks is the use case for publishing the task board to the remote.
+type PushTasks struct {
+	tasks  domain.TaskRepository
+	logger domain.Logger
+}
+
+// NewPushTasks creates a new PushTasks use case.
+func NewPushTasks(tasks domain.TaskRepository, logger domain.Logger) *PushTasks {
+	return &PushTasks{
+		tasks:  tasks,
+		logger: logger,
+	}
+}
+
+// Execute pushes the task board to the remote, merging remote changes first.
+func (uc *PushTasks) Execute(_ context.Context, _ PushTasksInput) (*PushTasksOutput, error) {
+	if err := uc.tasks.Push(); err != nil {
+		return nil, fmt.Errorf("push tasks: %w", err)
+	}
+
+	if uc.logger != nil {
+		uc.logger.Info(0, "sync", "pushed tasks")
+	}
+
+	return &PushTasksOutput{}, nil
+}
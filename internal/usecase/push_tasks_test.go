@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushTasks_Execute(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	uc := NewPushTasks(repo, testutil.NewMockLogger())
+
+	// Execute
+	_, err := uc.Execute(context.Background(), PushTasksInput{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, repo.PushCalled)
+}
+
+func TestPushTasks_Execute_Error(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.SyncErr = assert.AnError
+	uc := NewPushTasks(repo, nil)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), PushTasksInput{})
+
+	// Assert
+	assert.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
+)
+
+// RebaseTasksInput contains the parameters for bringing task branches up to date.
+// Fields are ordered to minimize memory padding.
+type RebaseTasksInput struct {
+	TaskIDs []int // Tasks to update (ignored when All is set)
+	All     bool  // Update every active task that has a worktree
+	Merge   bool  // Merge the base branch into the task branch instead of rebasing
+}
+
+// RebaseOutcome describes what happened to a task branch.
+type RebaseOutcome string
+
+// Rebase outcomes.
+const (
+	RebaseUpdated  RebaseOutcome = "updated"    // Branch now contains the base branch
+	RebaseUpToDate RebaseOutcome = "up_to_date" // Branch already contained the base branch
+	RebaseConflict RebaseOutcome = "conflict"   // Conflicts with the base branch; worktree left unchanged
+	RebaseSkipped  RebaseOutcome = "skipped"    // Not attempted (see Reason)
+	RebaseFailed   RebaseOutcome = "failed"     // Git failed for a reason other than conflicts (see Reason)
+)
+
+// RebasedTask describes the result of updating a single task branch.
+// Fields are ordered to minimize memory padding.
+type RebasedTask struct {
+	Outcome    RebaseOutcome // What happened
+	BaseBranch string        // Base branch the task was updated onto
+	Reason     string        // Why the task was skipped or failed
+	Conflicts  []string      // Conflicting files (only for RebaseConflict)
+	TaskID     int           // Task ID
+	Notified   bool          // The conflict was sent to the running session
+}
+
+// RebaseTasksOutput contains the result of updating task branches.
+type RebaseTasksOutput struct {
+	Tasks []RebasedTask // Per-task results
+}
+
+// RebaseTasks is the use case for updating task branches onto their advanced base branch.
+type RebaseTasks struct {
+	tasks     domain.TaskRepository
+	sessions  domain.SessionManager
+	worktrees domain.WorktreeManager
+	git       domain.Git
+}
+
+// NewRebaseTasks creates a new RebaseTasks use case.
+func NewRebaseTasks(
+	tasks domain.TaskRepository,
+	sessions domain.SessionManager,
+	worktrees domain.WorktreeManager,
+	git domain.Git,
+) *RebaseTasks {
+	return &RebaseTasks{
+		tasks:     tasks,
+		sessions:  sessions,
+		worktrees: worktrees,
+		git:       git,
+	}
+}
+
+// Execute rebases (or merges the base branch into) each task worktree.
+// Conflicting updates are aborted and reported to the task's running session
+// so the agent can resolve the drift while it is still small.
+func (uc *RebaseTasks) Execute(_ context.Context, in RebaseTasksInput) (*RebaseTasksOutput, error) {
+	var targets []*domain.Task
+	if in.All {
+		tasks, err := uc.tasks.List(domain.TaskFilter{})
+		if err != nil {
+			return nil, fmt.Errorf("list tasks: %w", err)
+		}
+		for _, task := range tasks {
+			if task.Status.IsTerminal() {
+				continue
+			}
+			if exists, _ := uc.worktrees.Exists(domain.BranchName(task.ID, task.Issue)); exists {
+				targets = append(targets, task)
+			}
+		}
+	} else {
+		if len(in.TaskIDs) == 0 {
+			return nil, domain.ErrNoTasksSpecified
+		}
+		for _, id := range in.TaskIDs {
+			task, err := shared.GetTask(uc.tasks, id)
+			if err != nil {
+				return nil, err
+			}
+			targets = append(targets, task)
+		}
+	}
+
+	strategy := domain.MergeStrategyRebase
+	if in.Merge {
+		strategy = domain.MergeStrategyMerge
+	}
+
+	out := &RebaseTasksOutput{Tasks: make([]RebasedTask, 0, len(targets))}
+	for _, task := range targets {
+		out.Tasks = append(out.Tasks, uc.rebase(task, strategy))
+	}
+	return out, nil
+}
+
+// rebase updates a single task branch and reports the outcome.
+func (uc *RebaseTasks) rebase(task *domain.Task, strategy domain.MergeStrategy) RebasedTask {
+	result := RebasedTask{TaskID: task.ID}
+	skip := func(reason string) RebasedTask {
+		result.Outcome = RebaseSkipped
+		result.Reason = reason
+		return result
+	}
+	fail := func(err error) RebasedTask {
+		result.Outcome = RebaseFailed
+		result.Reason = err.Error()
+		return result
+	}
+
+	if task.Status.IsTerminal() {
+		return skip(fmt.Sprintf("task is %s", task.Status))
+	}
+
+	branch := domain.BranchName(task.ID, task.Issue)
+	wtPath, err := uc.worktrees.Resolve(branch)
+	if err != nil {
+		return skip("no worktree")
+	}
+
+	// The reviewer is reading the worktree; rewriting it would invalidate the review
+	if running, _ := uc.sessions.IsRunning(domain.ReviewSessionName(task.ID)); running {
+		return skip("review in progress")
+	}
+
+	dirty, err := uc.git.HasUncommittedChanges(wtPath)
+	if err != nil {
+		return fail(fmt.Errorf("check uncommitted changes: %w", err))
+	}
+	if dirty {
+		return skip("uncommitted changes")
+	}
+
+	baseBranch, err := resolveBaseBranch(task, uc.git)
+	if err != nil {
+		return fail(err)
+	}
+	result.BaseBranch = baseBranch
+
+	before, err := uc.git.RevParse(branch)
+	if err != nil {
+		return fail(fmt.Errorf("resolve branch: %w", err))
+	}
+
+	conflicts, err := uc.git.UpdateBranch(wtPath, baseBranch, strategy)
+	if errors.Is(err, domain.ErrMergeConflict) {
+		result.Outcome = RebaseConflict
+		result.Conflicts = conflicts
+		result.Notified = uc.notifyConflict(task.ID, baseBranch, strategy, conflicts)
+		return result
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	after, err := uc.git.RevParse(branch)
+	if err != nil {
+		return fail(fmt.Errorf("resolve branch: %w", err))
+	}
+	if after == before {
+		result.Outcome = RebaseUpToDate
+	} else {
+		result.Outcome = RebaseUpdated
+	}
+	return result
+}
+
+// notifyConflict tells the task's running session which files conflict with the base branch.
+// Returns true if the notification was delivered.
+func (uc *RebaseTasks) notifyConflict(taskID int, baseBranch string, strategy domain.MergeStrategy, files []string) bool {
+	if running, _ := uc.sessions.IsRunning(domain.SessionName(taskID)); !running {
+		return false
+	}
+	command := "git rebase " + baseBranch
+	if strategy == domain.MergeStrategyMerge {
+		command = "git merge " + baseBranch
+	}
+	message := fmt.Sprintf(rebaseConflictNotificationTemplate, baseBranch, strings.Join(files, ", "), command)
+	return shared.SendSessionNotification(uc.sessions, taskID, message) == nil
+}
+
+// rebaseConflictNotificationTemplate is the notification sent to a session whose branch conflicts with its base branch.
+const rebaseConflictNotificationTemplate = "Base branch %s has advanced and conflicts with this branch in: %s. Please run '%s', resolve the conflicts, and continue."
@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRebaseTasksTest(t *testing.T) (*testutil.MockTaskRepository, *testutil.MockSessionManager, *testutil.MockWorktreeManager, *testutil.MockGit) {
+	t.Helper()
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Task 1", Status: domain.StatusInProgress, BaseBranch: "main"}
+	sessions := testutil.NewMockSessionManager()
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.ExistsVal = true
+	worktrees.ResolvePath = "/tmp/worktrees/crew-1"
+	git := &testutil.MockGit{RevParseSHA: "old"}
+	return repo, sessions, worktrees, git
+}
+
+func TestRebaseTasks_Execute_Updated(t *testing.T) {
+	// Setup
+	repo, sessions, worktrees, git := newRebaseTasksTest(t)
+	git.UpdateBranchSHA = "new"
+	uc := NewRebaseTasks(repo, sessions, worktrees, git)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), RebaseTasksInput{TaskIDs: []int{1}})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, out.Tasks, 1)
+	assert.Equal(t, RebaseUpdated, out.Tasks[0].Outcome)
+	assert.Equal(t, "main", out.Tasks[0].BaseBranch)
+	assert.Equal(t, []string{"/tmp/worktrees/crew-1"}, git.UpdateBranchDirs)
+	assert.Equal(t, domain.MergeStrategyRebase, git.UpdateBranchStrategy)
+}
+
+func TestRebaseTasks_Execute_UpToDate(t *testing.T) {
+	// Setup
+	repo, sessions, worktrees, git := newRebaseTasksTest(t)
+	uc := NewRebaseTasks(repo, sessions, worktrees, git)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), RebaseTasksInput{TaskIDs: []int{1}, Merge: true})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, RebaseUpToDate, out.Tasks[0].Outcome)
+	assert.Equal(t, domain.MergeStrategyMerge, git.UpdateBranchStrategy)
+}
+
+func TestRebaseTasks_Execute_ConflictNotifiesSession(t *testing.T) {
+	// Setup
+	repo, sessions, worktrees, git := newRebaseTasksTest(t)
+	git.UpdateBranchErr = domain.ErrMergeConflict
+	git.UpdateBranchConflicts = []string{"a.go", "b.go"}
+	sessions.IsRunningFunc = func(name string) (bool, error) {
+		return name == domain.SessionName(1), nil
+	}
+	var sent []string
+	sessions.SendFunc = func(_, keys string) error {
+		sent = append(sent, keys)
+		return nil
+	}
+	uc := NewRebaseTasks(repo, sessions, worktrees, git)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), RebaseTasksInput{TaskIDs: []int{1}})
+
+	// Assert
+	require.NoError(t, err)
+	result := out.Tasks[0]
+	assert.Equal(t, RebaseConflict, result.Outcome)
+	assert.Equal(t, []string{"a.go", "b.go"}, result.Conflicts)
+	assert.True(t, result.Notified)
+	require.Len(t, sent, 2)
+	assert.Contains(t, sent[0], "a.go, b.go")
+	assert.Contains(t, sent[0], "'git rebase main'")
+	assert.Equal(t, "Enter", sent[1])
+	// Status is left for the agent to resolve
+	assert.Equal(t, domain.StatusInProgress, repo.Tasks[1].Status)
+}
+
+func TestRebaseTasks_Execute_ConflictWithoutSession(t *testing.T) {
+	// Setup
+	repo, sessions, worktrees, git := newRebaseTasksTest(t)
+	git.UpdateBranchErr = domain.ErrMergeConflict
+	git.UpdateBranchConflicts = []string{"a.go"}
+	uc := NewRebaseTasks(repo, sessions, worktrees, git)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), RebaseTasksInput{TaskIDs: []int{1}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, RebaseConflict, out.Tasks[0].Outcome)
+	assert.False(t, out.Tasks[0].Notified)
+	assert.False(t, sessions.SendCalled)
+}
+
+func TestRebaseTasks_Execute_Failed(t *testing.T) {
+	// Setup
+	repo, sessions, worktrees, git := newRebaseTasksTest(t)
+	git.UpdateBranchErr = assert.AnError
+	uc := NewRebaseTasks(repo, sessions, worktrees, git)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), RebaseTasksInput{TaskIDs: []int{1}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, RebaseFailed, out.Tasks[0].Outcome)
+	assert.Equal(t, assert.AnError.Error(), out.Tasks[0].Reason)
+}
+
+func TestRebaseTasks_Execute_Skipped(t *testing.T) {
+	tests := []struct {
+		setup  func(*domain.Task, *testutil.MockSessionManager, *testutil.MockWorktreeManager, *testutil.MockGit)
+		name   string
+		reason string
+	}{
+		{
+			name:   "uncommitted changes",
+			reason: "uncommitted changes",
+			setup: func(_ *domain.Task, _ *testutil.MockSessionManager, _ *testutil.MockWorktreeManager, git *testutil.MockGit) {
+				git.HasUncommittedChangesV = true
+			},
+		},
+		{
+			name:   "review running",
+			reason: "review in progress",
+			setup: func(_ *domain.Task, sessions *testutil.MockSessionManager, _ *testutil.MockWorktreeManager, _ *testutil.MockGit) {
+				sessions.IsRunningFunc = func(name string) (bool, error) {
+					return name == domain.ReviewSessionName(1), nil
+				}
+			},
+		},
+		{
+			name:   "no worktree",
+			reason: "no worktree",
+			setup: func(_ *domain.Task, _ *testutil.MockSessionManager, worktrees *testutil.MockWorktreeManager, _ *testutil.MockGit) {
+				worktrees.ResolveErr = domain.ErrWorktreeNotFound
+			},
+		},
+		{
+			name:   "merged",
+			reason: "task is merged",
+			setup: func(task *domain.Task, _ *testutil.MockSessionManager, _ *testutil.MockWorktreeManager, _ *testutil.MockGit) {
+				task.Status = domain.StatusMerged
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			repo, sessions, worktrees, git := newRebaseTasksTest(t)
+			tt.setup(repo.Tasks[1], sessions, worktrees, git)
+			uc := NewRebaseTasks(repo, sessions, worktrees, git)
+
+			// Execute
+			out, err := uc.Execute(context.Background(), RebaseTasksInput{TaskIDs: []int{1}})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, RebaseSkipped, out.Tasks[0].Outcome)
+			assert.Equal(t, tt.reason, out.Tasks[0].Reason)
+			assert.Empty(t, git.UpdateBranchDirs)
+		})
+	}
+}
+
+func TestRebaseTasks_Execute_All(t *testing.T) {
+	// Setup
+	repo, sessions, worktrees, git := newRebaseTasksTest(t)
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Task 2", Status: domain.StatusDone}
+	repo.Tasks[3] = &domain.Task{ID: 3, Title: "Task 3", Status: domain.StatusMerged}
+	git.DefaultBranchName = testutil.StringPtr("develop")
+	uc := NewRebaseTasks(repo, sessions, worktrees, git)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), RebaseTasksInput{All: true})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, out.Tasks, 2)
+	bases := map[int]string{}
+	for _, result := range out.Tasks {
+		bases[result.TaskID] = result.BaseBranch
+	}
+	assert.Equal(t, map[int]string{1: "main", 2: "develop"}, bases)
+}
+
+func TestRebaseTasks_Execute_NoTasks(t *testing.T) {
+	// Setup
+	repo, sessions, worktrees, git := newRebaseTasksTest(t)
+	uc := NewRebaseTasks(repo, sessions, worktrees, git)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), RebaseTasksInput{})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNoTasksSpecified)
+}
+
+func TestRebaseTasks_Execute_TaskNotFound(t *testing.T) {
+	// Setup
+	repo, sessions, worktrees, git := newRebaseTasksTest(t)
+	uc := NewRebaseTasks(repo, sessions, worktrees, git)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), RebaseTasksInput{TaskIDs: []int{99}})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrTaskNotFound)
+}
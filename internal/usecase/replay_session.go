@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
+)
+
+// ReplaySessionInput contains the parameters for replaying a session recording.
+// Fields are ordered to minimize memory padding.
+type ReplaySessionInput struct {
+	Speed     float64       // Playback speed multiplier (default: 1)
+	IdleLimit time.Duration // Maximum pause between events (0 = keep recorded pauses)
+	TaskID    int           // Task ID whose session to replay
+	Run       int           // Recorded run to replay, 1 being the oldest (0 = latest)
+	Review    bool          // Replay the review session instead of the work session
+}
+
+// ReplaySessionOutput contains the result of replaying a session recording.
+type ReplaySessionOutput struct {
+	RecordingPath string        // Path to the recording
+	Duration      time.Duration // Played duration at 1x speed (pauses capped by IdleLimit)
+}
+
+// ReplaySession is the use case for playing back a session recording in the terminal.
+// Fields are ordered to minimize memory padding.
+type ReplaySession struct {
+	tasks   domain.TaskRepository
+	stdout  io.Writer
+	sleep   func(ctx context.Context, d time.Duration) error
+	crewDir string
+}
+
+// NewReplaySession creates a new ReplaySession use case.
+func NewReplaySession(tasks domain.TaskRepository, crewDir string, stdout io.Writer) *ReplaySession {
+	return &ReplaySession{
+		tasks:   tasks,
+		stdout:  stdout,
+		sleep:   sleepContext,
+		crewDir: crewDir,
+	}
+}
+
+// Execute plays the recording of a run of the task's session with its original timing.
+// Cancelling ctx stops playback and is not an error.
+func (uc *ReplaySession) Execute(ctx context.Context, in ReplaySessionInput) (*ReplaySessionOutput, error) {
+	task, err := shared.GetTask(uc.tasks, in.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionName := domain.SessionName(task.ID)
+	if in.Review {
+		sessionName = domain.ReviewSessionName(task.ID)
+	}
+	recordings := shared.SessionRecordings(uc.crewDir, sessionName)
+	if len(recordings) == 0 {
+		return nil, fmt.Errorf("no recording found for session %s: %w", sessionName, domain.ErrNoSession)
+	}
+	path := recordings[len(recordings)-1]
+	if in.Run != 0 {
+		if in.Run < 0 || in.Run > len(recordings) {
+			return nil, fmt.Errorf("no run %d recorded for session %s (%d runs): %w", in.Run, sessionName, len(recordings), domain.ErrNoSession)
+		}
+		path = recordings[in.Run-1]
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open recording: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	reader, err := domain.NewRecordingReader(file)
+	if err != nil {
+		return nil, err
+	}
+
+	speed := in.Speed
+	if speed <= 0 {
+		speed = 1
+	}
+
+	out := &ReplaySessionOutput{RecordingPath: path}
+	var last float64
+	for {
+		event, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read recording: %w", err)
+		}
+		if event.Type != domain.RecordingOutput {
+			continue
+		}
+
+		delay := time.Duration((event.Time - last) * float64(time.Second))
+		last = event.Time
+		if in.IdleLimit > 0 && delay > in.IdleLimit {
+			delay = in.IdleLimit
+		}
+		out.Duration += delay
+		if delay > 0 {
+			if err := uc.sleep(ctx, time.Duration(float64(delay)/speed)); err != nil {
+				break // Cancelled: stop playback
+			}
+		}
+		if _, err := io.WriteString(uc.stdout, event.Data); err != nil {
+			return nil, fmt.Errorf("write output: %w", err)
+		}
+	}
+
+	// Leave the terminal usable if the recording stopped mid-sequence
+	_, _ = io.WriteString(uc.stdout, "\x1b[0m\x1b[?25h")
+	return out, nil
+}
+
+// sleepContext waits for d or until ctx is cancelled.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
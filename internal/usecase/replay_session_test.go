@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRecording = `{"version": 2, "width": 80, "height": 24}
+[0.500000, "o", "hello "]
+[1.000000, "r", "100x30"]
+[1.500000, "o", "world"]
+[61.500000, "o", "!"]
+`
+
+// writeRecordingRun writes the recording of a session run started at start.
+func writeRecordingRun(t *testing.T, crewDir, sessionName string, start time.Time, recording string) string {
+	t.Helper()
+	path := domain.RecordingRunPath(domain.RecordingPath(crewDir, sessionName), start)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(recording), 0o644))
+	return path
+}
+
+// setupReplaySession creates a ReplaySession that records sleeps instead of sleeping.
+func setupReplaySession(t *testing.T, sessionName, recording string) (*ReplaySession, *bytes.Buffer, *[]time.Duration) {
+	t.Helper()
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Test task", Status: domain.StatusDone}
+
+	crewDir := t.TempDir()
+	if recording != "" {
+		writeRecordingRun(t, crewDir, sessionName, time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC), recording)
+	}
+
+	var stdout bytes.Buffer
+	var sleeps []time.Duration
+	uc := NewReplaySession(repo, crewDir, &stdout)
+	uc.sleep = func(_ context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		return nil
+	}
+	return uc, &stdout, &sleeps
+}
+
+func TestReplaySession_Execute_PlaysOutput(t *testing.T) {
+	// Setup
+	uc, stdout, sleeps := setupReplaySession(t, "crew-1", testRecording)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), ReplaySessionInput{TaskID: 1, Speed: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(stdout.String(), "hello world!"))
+	assert.Equal(t, []time.Duration{500 * time.Millisecond, time.Second, time.Minute}, *sleeps)
+	assert.Equal(t, 61500*time.Millisecond, out.Duration)
+}
+
+func TestReplaySession_Execute_SpeedAndIdleLimit(t *testing.T) {
+	// Setup
+	uc, _, sleeps := setupReplaySession(t, "crew-1", testRecording)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), ReplaySessionInput{
+		TaskID:    1,
+		Speed:     2,
+		IdleLimit: 2 * time.Second,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{250 * time.Millisecond, 500 * time.Millisecond, time.Second}, *sleeps)
+	assert.Equal(t, 3500*time.Millisecond, out.Duration)
+}
+
+func TestReplaySession_Execute_Review(t *testing.T) {
+	// Setup
+	uc, stdout, _ := setupReplaySession(t, "crew-1-review", testRecording)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), ReplaySessionInput{TaskID: 1, Review: true})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, filepath.Base(out.RecordingPath), "crew-1-review.")
+	assert.Contains(t, stdout.String(), "hello world!")
+}
+
+func TestReplaySession_Execute_Runs(t *testing.T) {
+	// Setup
+	uc, _, _ := setupReplaySession(t, "crew-1", testRecording)
+	latest := writeRecordingRun(t, uc.crewDir, "crew-1", time.Date(2026, 1, 18, 11, 0, 0, 0, time.UTC),
+		`{"version": 2, "width": 80, "height": 24}
+[0.500000, "o", "second run"]
+`)
+
+	tests := []struct {
+		name string
+		want string
+		run  int
+	}{
+		{name: "latest by default", run: 0, want: "second run"},
+		{name: "earlier run", run: 1, want: "hello world!"},
+		{name: "latest run", run: 2, want: "second run"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			uc.stdout = &stdout
+
+			// Execute
+			out, err := uc.Execute(context.Background(), ReplaySessionInput{TaskID: 1, Run: tt.run})
+
+			// Assert
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(stdout.String(), tt.want))
+			if tt.run != 1 {
+				assert.Equal(t, latest, out.RecordingPath)
+			}
+		})
+	}
+}
+
+func TestReplaySession_Execute_RunOutOfRange(t *testing.T) {
+	// Setup
+	uc, _, _ := setupReplaySession(t, "crew-1", testRecording)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), ReplaySessionInput{TaskID: 1, Run: 2})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNoSession)
+	assert.Contains(t, err.Error(), "1 runs")
+}
+
+func TestReplaySession_Execute_LegacyRecording(t *testing.T) {
+	// Setup: a recording made before runs were kept apart
+	uc, stdout, _ := setupReplaySession(t, "crew-1", "")
+	path := domain.RecordingPath(uc.crewDir, "crew-1")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(testRecording), 0o644))
+
+	// Execute
+	out, err := uc.Execute(context.Background(), ReplaySessionInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, path, out.RecordingPath)
+	assert.Contains(t, stdout.String(), "hello world!")
+}
+
+func TestReplaySession_Execute_Cancelled(t *testing.T) {
+	// Setup
+	uc, stdout, _ := setupReplaySession(t, "crew-1", testRecording)
+	uc.sleep = func(_ context.Context, d time.Duration) error {
+		if d >= time.Second {
+			return context.Canceled
+		}
+		return nil
+	}
+
+	// Execute
+	_, err := uc.Execute(context.Background(), ReplaySessionInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(stdout.String(), "hello "))
+	assert.NotContains(t, stdout.String(), "world")
+}
+
+func TestReplaySession_Execute_NoRecording(t *testing.T) {
+	// Setup
+	uc, _, _ := setupReplaySession(t, "crew-1", "")
+
+	// Execute
+	_, err := uc.Execute(context.Background(), ReplaySessionInput{TaskID: 1})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNoSession)
+}
+
+func TestReplaySession_Execute_TaskNotFound(t *testing.T) {
+	// Setup
+	uc, _, _ := setupReplaySession(t, "crew-1", testRecording)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), ReplaySessionInput{TaskID: 2})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrTaskNotFound)
+}
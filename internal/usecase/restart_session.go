@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
+)
+
+// RestartSessionInput contains the parameters for restarting a failed session.
+// Fields are ordered to minimize memory padding.
+type RestartSessionInput struct {
+	Agent    string // Agent whose session ended in error
+	TaskID   int    // Task ID
+	ExitCode int    // Exit code of the failed session
+}
+
+// RestartSessionOutput contains the result of restarting a failed session.
+type RestartSessionOutput struct {
+	Delay     time.Duration // Backoff waited before restarting
+	Restarts  int           // Restart count of the task after restarting
+	Restarted bool          // False if the task was restarted or changed status in the meantime
+}
+
+// RestartSession is the use case for restarting a task whose worker session
+// ended in error with the same agent and --continue, after a backoff.
+// It runs detached from the failed session (see SessionEnded), so it first
+// waits for that session to go away.
+// Fields are ordered to minimize memory padding.
+type RestartSession struct {
+	tasks        domain.TaskRepository
+	sessions     domain.SessionManager
+	starter      TaskStarter
+	configLoader domain.ConfigLoader
+	logger       domain.Logger
+	sleep        func(ctx context.Context, d time.Duration) error
+}
+
+// NewRestartSession creates a new RestartSession use case.
+func NewRestartSession(
+	tasks domain.TaskRepository,
+	sessions domain.SessionManager,
+	starter TaskStarter,
+	configLoader domain.ConfigLoader,
+	logger domain.Logger,
+) *RestartSession {
+	return &RestartSession{
+		tasks:        tasks,
+		sessions:     sessions,
+		starter:      starter,
+		configLoader: configLoader,
+		logger:       logger,
+		sleep:        sleepContext,
+	}
+}
+
+// Execute waits for the agent's restart backoff and restarts the task.
+// Nothing is done if the task was restarted or changed status in the meantime.
+func (uc *RestartSession) Execute(ctx context.Context, in RestartSessionInput) (*RestartSessionOutput, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, sessionEndWaitTimeout)
+	defer cancel()
+	if err := uc.sessions.Wait(waitCtx, domain.SessionName(in.TaskID)); err != nil {
+		return nil, fmt.Errorf("wait for session to end: %w", err)
+	}
+
+	task, err := shared.GetTask(uc.tasks, in.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	out := &RestartSessionOutput{Restarts: task.RestartCount}
+	if !awaitingRecovery(task) {
+		return out, nil
+	}
+
+	cfg, err := uc.configLoader.Load()
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+	out.Delay = cfg.Agents[in.Agent].RestartDelay(task.RestartCount)
+	uc.log(task.ID, false, fmt.Sprintf("%s exited with code %d; restarting in %s (restart %d)", in.Agent, in.ExitCode, out.Delay, task.RestartCount+1))
+	if err := uc.sleep(ctx, out.Delay); err != nil {
+		return nil, err
+	}
+
+	// The task may have been started or closed during the backoff
+	task, err = shared.GetTask(uc.tasks, in.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	if !awaitingRecovery(task) {
+		return out, nil
+	}
+
+	if _, err := uc.starter.Execute(ctx, StartTaskInput{
+		TaskID:   task.ID,
+		Agent:    in.Agent,
+		Continue: true,
+		Restart:  true,
+	}); err != nil {
+		uc.log(task.ID, false, fmt.Sprintf("failed to restart with %s: %v", in.Agent, err))
+		return nil, fmt.Errorf("restart task: %w", err)
+	}
+	out.Restarted = true
+	out.Restarts = task.RestartCount + 1
+	uc.log(task.ID, true, fmt.Sprintf("restarted with %s (restart %d)", in.Agent, out.Restarts))
+	return out, nil
+}
+
+// awaitingRecovery reports whether a task whose session failed is still in error
+// with no session, that is, nobody restarted or closed it in the meantime.
+func awaitingRecovery(task *domain.Task) bool {
+	return task.Status == domain.StatusError && !task.IsRunning()
+}
+
+func (uc *RestartSession) log(taskID int, info bool, msg string) {
+	if uc.logger == nil {
+		return
+	}
+	if info {
+		uc.logger.Info(taskID, "restart", msg)
+	} else {
+		uc.logger.Warn(taskID, "restart", msg)
+	}
+}
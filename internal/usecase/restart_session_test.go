@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupRestartSession creates a RestartSession that records sleeps instead of sleeping.
+func setupRestartSession(repo *testutil.MockTaskRepository, sessions *testutil.MockSessionManager, starter TaskStarter) (*RestartSession, *[]time.Duration) {
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config.Agents["claude-dev"] = domain.Agent{MaxRestarts: 3, RestartBackoff: 10}
+	uc := NewRestartSession(repo, sessions, starter, configLoader, nil)
+	var sleeps []time.Duration
+	uc.sleep = func(_ context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		return nil
+	}
+	return uc, &sleeps
+}
+
+func TestRestartSession_Execute_RestartsSameAgent(t *testing.T) {
+	// Setup
+	repo := newFallbackTestRepo()
+	repo.Tasks[1].RestartCount = 1
+	sessions := testutil.NewMockSessionManager()
+	starter := &fallbackStarter{repo: repo}
+	uc, sleeps := setupRestartSession(repo, sessions, starter)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), RestartSessionInput{TaskID: 1, Agent: "claude-dev", ExitCode: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, sessions.WaitCalled)
+	assert.Equal(t, []time.Duration{20 * time.Second}, *sleeps)
+	assert.Equal(t, &RestartSessionOutput{Delay: 20 * time.Second, Restarts: 2, Restarted: true}, out)
+	require.Len(t, starter.inputs, 1)
+	assert.Equal(t, StartTaskInput{TaskID: 1, Agent: "claude-dev", Continue: true, Restart: true}, starter.inputs[0])
+}
+
+func TestRestartSession_Execute_TaskChangedDuringBackoff(t *testing.T) {
+	// Setup
+	repo := newFallbackTestRepo()
+	starter := &fallbackStarter{repo: repo}
+	uc, _ := setupRestartSession(repo, testutil.NewMockSessionManager(), starter)
+	uc.sleep = func(_ context.Context, _ time.Duration) error {
+		repo.Tasks[1].Status = domain.StatusClosed
+		return nil
+	}
+
+	// Execute
+	out, err := uc.Execute(context.Background(), RestartSessionInput{TaskID: 1, Agent: "claude-dev", ExitCode: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, out.Restarted)
+	assert.Empty(t, starter.inputs)
+}
+
+func TestRestartSession_Execute_TaskRestartedMeanwhile(t *testing.T) {
+	// Setup
+	repo := newFallbackTestRepo()
+	repo.Tasks[1].Status = domain.StatusInProgress
+	repo.Tasks[1].Session = "crew-1"
+	starter := &fallbackStarter{repo: repo}
+	uc, sleeps := setupRestartSession(repo, testutil.NewMockSessionManager(), starter)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), RestartSessionInput{TaskID: 1, Agent: "claude-dev", ExitCode: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, out.Restarted)
+	assert.Empty(t, *sleeps)
+	assert.Empty(t, starter.inputs)
+}
+
+func TestRestartSession_Execute_StartError(t *testing.T) {
+	// Setup
+	repo := newFallbackTestRepo()
+	startErr := errors.New("boom")
+	starter := &fallbackStarter{repo: repo, errs: map[string]error{"claude-dev": startErr}}
+	uc, _ := setupRestartSession(repo, testutil.NewMockSessionManager(), starter)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), RestartSessionInput{TaskID: 1, Agent: "claude-dev", ExitCode: 1})
+
+	// Assert
+	require.ErrorIs(t, err, startErr)
+	assert.Equal(t, domain.StatusError, repo.Tasks[1].Status)
+}
+
+func TestRestartSession_Execute_WaitError(t *testing.T) {
+	// Setup
+	repo := newFallbackTestRepo()
+	sessions := testutil.NewMockSessionManager()
+	sessions.WaitErr = context.DeadlineExceeded
+	starter := &fallbackStarter{repo: repo}
+	uc, _ := setupRestartSession(repo, sessions, starter)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), RestartSessionInput{TaskID: 1, Agent: "claude-dev", ExitCode: 1})
+
+	// Assert
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Empty(t, starter.inputs)
+}
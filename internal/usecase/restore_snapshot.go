@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// RestoreSnapshotInput contains the parameters for restoring a task snapshot.
+type RestoreSnapshotInput struct {
+	Ref string // Snapshot ref; the SHA part may be abbreviated (e.g., 1a2b3c4_002)
+}
+
+// RestoreSnapshotOutput contains the result of restoring a task snapshot.
+type RestoreSnapshotOutput struct {
+	Restored domain.SnapshotInfo // The snapshot that was restored
+	Backup   domain.SnapshotInfo // Snapshot of the state before the restore
+}
+
+// RestoreSnapshot is the use case for rolling the task state back to a snapshot.
+type RestoreSnapshot struct {
+	tasks  domain.TaskRepository
+	git    domain.Git
+	logger domain.Logger
+}
+
+// NewRestoreSnapshot creates a new RestoreSnapshot use case.
+func NewRestoreSnapshot(tasks domain.TaskRepository, git domain.Git, logger domain.Logger) *RestoreSnapshot {
+	return &RestoreSnapshot{
+		tasks:  tasks,
+		git:    git,
+		logger: logger,
+	}
+}
+
+// Execute restores the task state from a snapshot.
+// The current state is saved as a new snapshot first so the restore can be undone.
+func (uc *RestoreSnapshot) Execute(_ context.Context, in RestoreSnapshotInput) (*RestoreSnapshotOutput, error) {
+	snapshots, err := uc.tasks.ListSnapshots("")
+	if err != nil {
+		return nil, fmt.Errorf("list snapshots: %w", err)
+	}
+	target, err := resolveSnapshot(snapshots, in.Ref)
+	if err != nil {
+		return nil, err
+	}
+
+	backup, err := saveSnapshot(uc.tasks, uc.git, "")
+	if err != nil {
+		return nil, fmt.Errorf("backup current state: %w", err)
+	}
+
+	if err := uc.tasks.RestoreSnapshot(target.Ref); err != nil {
+		return nil, fmt.Errorf("restore snapshot %s: %w", target.Ref, err)
+	}
+
+	if uc.logger != nil {
+		uc.logger.Info(0, "snapshot", fmt.Sprintf("restored snapshot %s (backup: %s)", target.Ref, backup.Ref))
+	}
+
+	return &RestoreSnapshotOutput{Restored: target, Backup: backup}, nil
+}
+
+// resolveSnapshot finds the snapshot matching ref exactly, or by an
+// abbreviated SHA with the same sequence suffix.
+func resolveSnapshot(snapshots []domain.SnapshotInfo, ref string) (domain.SnapshotInfo, error) {
+	for _, snap := range snapshots {
+		if snap.Ref == ref {
+			return snap, nil
+		}
+	}
+
+	idx := strings.LastIndex(ref, "_")
+	if idx <= 0 {
+		return domain.SnapshotInfo{}, fmt.Errorf("%w: %s", domain.ErrSnapshotNotFound, ref)
+	}
+	prefix, suffix := ref[:idx], ref[idx:]
+
+	var matches []domain.SnapshotInfo
+	for _, snap := range snapshots {
+		if strings.HasPrefix(snap.MainSHA, prefix) && strings.HasSuffix(snap.Ref, suffix) {
+			matches = append(matches, snap)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return domain.SnapshotInfo{}, fmt.Errorf("%w: %s", domain.ErrSnapshotNotFound, ref)
+	case 1:
+		return matches[0], nil
+	default:
+		return domain.SnapshotInfo{}, fmt.Errorf("ambiguous snapshot ref %s: matches %d snapshots", ref, len(matches))
+	}
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreSnapshot_Execute(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Snapshots = []domain.SnapshotInfo{
+		{Ref: "sha-main_001", MainSHA: "sha-main", Seq: 1},
+	}
+	uc := NewRestoreSnapshot(repo, &testutil.MockGit{}, testutil.NewMockLogger())
+
+	// Execute
+	out, err := uc.Execute(context.Background(), RestoreSnapshotInput{Ref: "sha-main_001"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "sha-main_001", out.Restored.Ref)
+	assert.Equal(t, "sha-main_002", out.Backup.Ref)
+	assert.Equal(t, "sha-main_001", repo.RestoredSnapshot)
+}
+
+func TestRestoreSnapshot_Execute_AbbreviatedRef(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Snapshots = []domain.SnapshotInfo{
+		{Ref: "1a2b3c4d5e_001", MainSHA: "1a2b3c4d5e", Seq: 1},
+		{Ref: "1a2b3c4d5e_002", MainSHA: "1a2b3c4d5e", Seq: 2},
+	}
+	uc := NewRestoreSnapshot(repo, &testutil.MockGit{}, nil)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), RestoreSnapshotInput{Ref: "1a2b3c_002"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "1a2b3c4d5e_002", out.Restored.Ref)
+	assert.Equal(t, "1a2b3c4d5e_002", repo.RestoredSnapshot)
+}
+
+func TestRestoreSnapshot_Execute_Ambiguous(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Snapshots = []domain.SnapshotInfo{
+		{Ref: "abc111_001", MainSHA: "abc111", Seq: 1},
+		{Ref: "abc222_001", MainSHA: "abc222", Seq: 1},
+	}
+	uc := NewRestoreSnapshot(repo, &testutil.MockGit{}, nil)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), RestoreSnapshotInput{Ref: "abc_001"})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ambiguous")
+	assert.Empty(t, repo.RestoredSnapshot)
+}
+
+func TestRestoreSnapshot_Execute_NotFound(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	uc := NewRestoreSnapshot(repo, &testutil.MockGit{}, nil)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), RestoreSnapshotInput{Ref: "missing_001"})
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrSnapshotNotFound)
+	assert.Empty(t, repo.Snapshots, "no backup should be taken")
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
+)
+
+// RouteTaskInput contains the parameters for explaining agent routing.
+// Fields are ordered to minimize memory padding.
+type RouteTaskInput struct {
+	Agent  string // Agent given explicitly (routing is skipped if set)
+	TaskID int    // Task ID
+}
+
+// RouteTaskOutput contains the worker agent crew start would use and why.
+// Fields are ordered to minimize memory padding.
+type RouteTaskOutput struct {
+	Task       *domain.Task
+	Size       domain.TaskSize        // Estimated task size
+	SizeReason string                 // How the size was estimated
+	Decision   domain.RoutingDecision // Chosen agent and evaluated rules
+	Rules      int                    // Number of configured routing rules
+	Explicit   bool                   // The agent was given explicitly
+}
+
+// RouteTask is the use case for explaining which worker agent a task is routed to.
+type RouteTask struct {
+	tasks        domain.TaskRepository
+	configLoader domain.ConfigLoader
+}
+
+// NewRouteTask creates a new RouteTask use case.
+func NewRouteTask(tasks domain.TaskRepository, configLoader domain.ConfigLoader) *RouteTask {
+	return &RouteTask{
+		tasks:        tasks,
+		configLoader: configLoader,
+	}
+}
+
+// Execute evaluates the routing rules for the task without starting it.
+func (uc *RouteTask) Execute(_ context.Context, in RouteTaskInput) (*RouteTaskOutput, error) {
+	task, err := shared.GetTask(uc.tasks, in.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := uc.configLoader.Load()
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+
+	out := &RouteTaskOutput{
+		Task:  task,
+		Rules: len(cfg.AgentsConfig.Routing),
+	}
+	out.Size, out.SizeReason = domain.EstimateTaskSize(task)
+	if in.Agent != "" {
+		out.Decision = domain.RoutingDecision{Agent: in.Agent}
+		out.Explicit = true
+		return out, nil
+	}
+	out.Decision = cfg.RouteWorker(task)
+	return out, nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteTask_Execute_RuleMatches(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Refactor the loader", Labels: []string{"size:large"}}
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config.AgentsConfig.DefaultWorker = "claude"
+	configLoader.Config.AgentsConfig.Routing = []domain.RoutingRule{
+		{Name: "docs", Agent: "claude-fast", Labels: []string{"docs"}},
+		{Name: "big", Agent: "codex", Sizes: []domain.TaskSize{domain.TaskSizeLarge}},
+		{Name: "rest", Agent: "opencode"},
+	}
+	uc := NewRouteTask(repo, configLoader)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), RouteTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.TaskSizeLarge, out.Size)
+	assert.Equal(t, "label size:large", out.SizeReason)
+	assert.Equal(t, 3, out.Rules)
+	assert.False(t, out.Explicit)
+	assert.Equal(t, "codex", out.Decision.Agent)
+	assert.Equal(t, "big", out.Decision.Rule)
+	assert.Len(t, out.Decision.Evaluations, 2)
+}
+
+func TestRouteTask_Execute_DefaultWorker(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Add export"}
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config.AgentsConfig.DefaultWorker = "claude"
+	uc := NewRouteTask(repo, configLoader)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), RouteTaskInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "claude", out.Decision.Agent)
+	assert.Empty(t, out.Decision.Rule)
+	assert.Zero(t, out.Rules)
+}
+
+func TestRouteTask_Execute_ExplicitAgent(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Add export"}
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config.AgentsConfig.Routing = []domain.RoutingRule{{Agent: "codex"}}
+	uc := NewRouteTask(repo, configLoader)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), RouteTaskInput{TaskID: 1, Agent: "opencode"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, out.Explicit)
+	assert.Equal(t, "opencode", out.Decision.Agent)
+	assert.Empty(t, out.Decision.Evaluations)
+}
+
+func TestRouteTask_Execute_TaskNotFound(t *testing.T) {
+	// Setup
+	uc := NewRouteTask(testutil.NewMockTaskRepository(), testutil.NewMockConfigLoader())
+
+	// Execute
+	_, err := uc.Execute(context.Background(), RouteTaskInput{TaskID: 99})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrTaskNotFound)
+}
@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
+)
+
+// TaskStarter starts a task session.
+// StartTask implements this interface; tests can provide a fake.
+type TaskStarter interface {
+	Execute(ctx context.Context, in StartTaskInput) (*StartTaskOutput, error)
+}
+
+// RunSchedulerInput contains the parameters for running the scheduler.
+// Zero values fall back to the [scheduler] config section.
+// Fields are ordered to minimize memory padding.
+type RunSchedulerInput struct {
+	Agent       string // Agent used to start every task (optional, routed per task if empty)
+	MaxParallel int    // Maximum number of tasks running at the same time (optional)
+	Interval    int    // Polling interval in seconds (optional)
+	Once        bool   // Run a single scheduling pass and exit
+}
+
+// RunSchedulerOutput contains the result of running the scheduler.
+type RunSchedulerOutput struct {
+	Started []int // IDs of tasks started by the scheduler
+}
+
+// RunScheduler is the use case for starting ready tasks automatically.
+// A task is ready when its status is todo, it is not blocked, and all of
+// its dependencies are merged.
+type RunScheduler struct {
+	tasks        domain.TaskRepository
+	starter      TaskStarter
+	configLoader domain.ConfigLoader
+	logger       domain.Logger
+	stdout       io.Writer
+}
+
+// NewRunScheduler creates a new RunScheduler use case.
+func NewRunScheduler(
+	tasks domain.TaskRepository,
+	starter TaskStarter,
+	configLoader domain.ConfigLoader,
+	logger domain.Logger,
+	stdout io.Writer,
+) *RunScheduler {
+	return &RunScheduler{
+		tasks:        tasks,
+		starter:      starter,
+		configLoader: configLoader,
+		logger:       logger,
+		stdout:       stdout,
+	}
+}
+
+// schedulerSettings holds the resolved scheduler settings for a run.
+type schedulerSettings struct {
+	limits      map[string]int
+	config      *domain.Config
+	agent       string // Fixed agent for every task (empty to route each task)
+	maxParallel int
+	interval    int
+}
+
+// Execute runs the scheduler until the context is cancelled.
+// With Once set, it runs a single scheduling pass and returns.
+func (uc *RunScheduler) Execute(ctx context.Context, in RunSchedulerInput) (*RunSchedulerOutput, error) {
+	cfg, err := uc.configLoader.Load()
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+
+	settings := resolveSchedulerSettings(cfg, in)
+	if settings.agent == "" && cfg.AgentsConfig.DefaultWorker == "" && len(cfg.AgentsConfig.Routing) == 0 {
+		return nil, fmt.Errorf("no agent configured for scheduler: %w", domain.ErrAgentNotFound)
+	}
+
+	out := &RunSchedulerOutput{}
+
+	started, err := uc.schedule(ctx, settings)
+	out.Started = append(out.Started, started...)
+	if err != nil {
+		return out, err
+	}
+	if in.Once {
+		return out, nil
+	}
+
+	ticker := time.NewTicker(time.Duration(settings.interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Context cancellation (Ctrl+C, SIGTERM) is normal exit for the daemon
+			return out, nil
+		case <-ticker.C:
+			started, err := uc.schedule(ctx, settings)
+			out.Started = append(out.Started, started...)
+			if err != nil {
+				return out, err
+			}
+		}
+	}
+}
+
+// resolveSchedulerSettings merges input overrides with the [scheduler] config.
+// Without a scheduler agent, each task is routed by the [[agents.routing]] rules.
+func resolveSchedulerSettings(cfg *domain.Config, in RunSchedulerInput) schedulerSettings {
+	settings := schedulerSettings{
+		config:      cfg,
+		agent:       cfg.Scheduler.Agent,
+		maxParallel: cfg.Scheduler.MaxParallel,
+		interval:    cfg.Scheduler.Interval,
+		limits:      cfg.Scheduler.AgentLimits,
+	}
+	if in.Agent != "" {
+		settings.agent = in.Agent
+	}
+	if in.MaxParallel > 0 {
+		settings.maxParallel = in.MaxParallel
+	}
+	if in.Interval > 0 {
+		settings.interval = in.Interval
+	}
+	if settings.maxParallel <= 0 {
+		settings.maxParallel = domain.DefaultSchedulerMaxParallel
+	}
+	if settings.interval <= 0 {
+		settings.interval = domain.DefaultSchedulerInterval
+	}
+	return settings
+}
+
+// agentFor returns the agent a task will be started with.
+func (s schedulerSettings) agentFor(task *domain.Task) string {
+	if s.agent != "" {
+		return s.agent
+	}
+	return s.config.RouteWorker(task).Agent
+}
+
+// schedule runs a single scheduling pass and returns the IDs of started tasks.
+// Failing to start an individual task is logged and does not stop the pass.
+func (uc *RunScheduler) schedule(ctx context.Context, settings schedulerSettings) ([]int, error) {
+	tasks, err := uc.tasks.List(domain.TaskFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("list tasks: %w", err)
+	}
+
+	running := 0
+	runningByAgent := make(map[string]int)
+	var candidates []*domain.Task
+	for _, t := range tasks {
+		switch {
+		case t.Status == domain.StatusInProgress:
+			running++
+			runningByAgent[t.Agent]++
+		case t.Status == domain.StatusTodo && !t.IsBlocked():
+			candidates = append(candidates, t)
+		}
+	}
+
+	// Oldest tasks first
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].ID < candidates[j].ID
+	})
+
+	var started []int
+	for _, t := range candidates {
+		if running >= settings.maxParallel {
+			break
+		}
+		agent := settings.agentFor(t)
+		if limit, ok := settings.limits[agent]; ok && runningByAgent[agent] >= limit {
+			// Tasks for other agents may still fit
+			continue
+		}
+
+		unfinished, err := shared.UnfinishedDependencies(uc.tasks, t)
+		if err != nil {
+			return started, err
+		}
+		if len(unfinished) > 0 {
+			continue
+		}
+
+		// An empty agent lets StartTask route the task and record the rule
+		if _, err := uc.starter.Execute(ctx, StartTaskInput{
+			TaskID: t.ID,
+			Agent:  settings.agent,
+		}); err != nil {
+			uc.logger.Warn(t.ID, "scheduler", fmt.Sprintf("failed to start: %v", err))
+			_, _ = fmt.Fprintf(uc.stdout, "Failed to start task #%d: %v\n", t.ID, err)
+			continue
+		}
+
+		running++
+		runningByAgent[agent]++
+		started = append(started, t.ID)
+		uc.logger.Info(t.ID, "scheduler", fmt.Sprintf("started (agent: %s)", agent))
+		_, _ = fmt.Fprintf(uc.stdout, "Started task #%d (agent: %s, running: %d/%d)\n", t.ID, agent, running, settings.maxParallel)
+	}
+
+	return started, nil
+}
@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTaskStarter is a test double for TaskStarter.
+// It marks started tasks as in_progress in the repository like StartTask does.
+type fakeTaskStarter struct {
+	repo    *testutil.MockTaskRepository
+	errs    map[int]error
+	inputs  []StartTaskInput
+	started []int
+}
+
+func (f *fakeTaskStarter) Execute(_ context.Context, in StartTaskInput) (*StartTaskOutput, error) {
+	f.inputs = append(f.inputs, in)
+	if err := f.errs[in.TaskID]; err != nil {
+		return nil, err
+	}
+	task := f.repo.Tasks[in.TaskID]
+	task.Status = domain.StatusInProgress
+	task.Agent = in.Agent
+	f.started = append(f.started, in.TaskID)
+	return &StartTaskOutput{SessionName: domain.SessionName(in.TaskID)}, nil
+}
+
+func newSchedulerTestConfig() *testutil.MockConfigLoader {
+	loader := testutil.NewMockConfigLoader()
+	loader.Config.AgentsConfig.DefaultWorker = "claude"
+	return loader
+}
+
+func TestRunScheduler_Execute_StartsReadyTasks(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Ready", Status: domain.StatusTodo}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Blocked", Status: domain.StatusTodo, BlockReason: "waiting for API"}
+	repo.Tasks[3] = &domain.Task{ID: 3, Title: "Done", Status: domain.StatusDone}
+	repo.Tasks[4] = &domain.Task{ID: 4, Title: "Ready too", Status: domain.StatusTodo}
+	repo.Tasks[5] = &domain.Task{ID: 5, Title: "Errored", Status: domain.StatusError}
+
+	starter := &fakeTaskStarter{repo: repo}
+	var stdout bytes.Buffer
+	uc := NewRunScheduler(repo, starter, newSchedulerTestConfig(), testutil.NewMockLogger(), &stdout)
+
+	out, err := uc.Execute(context.Background(), RunSchedulerInput{Once: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 4}, out.Started)
+	require.Len(t, starter.inputs, 2)
+	assert.Empty(t, starter.inputs[0].Agent, "StartTask routes the task")
+	assert.Contains(t, stdout.String(), "Started task #1 (agent: claude, running: 1/3)")
+	assert.Contains(t, stdout.String(), "Started task #4 (agent: claude, running: 2/3)")
+}
+
+func TestRunScheduler_Execute_RespectsMaxParallel(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Running", Status: domain.StatusInProgress, Agent: "codex"}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Todo 2", Status: domain.StatusTodo}
+	repo.Tasks[3] = &domain.Task{ID: 3, Title: "Todo 3", Status: domain.StatusTodo}
+	repo.Tasks[4] = &domain.Task{ID: 4, Title: "Todo 4", Status: domain.StatusTodo}
+
+	starter := &fakeTaskStarter{repo: repo}
+	uc := NewRunScheduler(repo, starter, newSchedulerTestConfig(), testutil.NewMockLogger(), &bytes.Buffer{})
+
+	out, err := uc.Execute(context.Background(), RunSchedulerInput{MaxParallel: 2, Once: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, out.Started)
+	assert.Equal(t, domain.StatusTodo, repo.Tasks[3].Status)
+}
+
+func TestRunScheduler_Execute_RespectsAgentLimit(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Running", Status: domain.StatusInProgress, Agent: "claude"}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Todo 2", Status: domain.StatusTodo}
+	repo.Tasks[3] = &domain.Task{ID: 3, Title: "Todo 3", Status: domain.StatusTodo}
+
+	loader := newSchedulerTestConfig()
+	loader.Config.Scheduler.AgentLimits = map[string]int{"claude": 2}
+
+	starter := &fakeTaskStarter{repo: repo}
+	uc := NewRunScheduler(repo, starter, loader, testutil.NewMockLogger(), &bytes.Buffer{})
+
+	out, err := uc.Execute(context.Background(), RunSchedulerInput{Once: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, out.Started)
+}
+
+func TestRunScheduler_Execute_AgentLimitsPerAgent(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Running", Status: domain.StatusInProgress, Agent: "claude"}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Claude 2", Status: domain.StatusTodo}
+	repo.Tasks[3] = &domain.Task{ID: 3, Title: "Codex 3", Status: domain.StatusTodo, Labels: []string{"codex"}}
+	repo.Tasks[4] = &domain.Task{ID: 4, Title: "Claude 4", Status: domain.StatusTodo}
+	repo.Tasks[5] = &domain.Task{ID: 5, Title: "Codex 5", Status: domain.StatusTodo, Labels: []string{"codex"}}
+	repo.Tasks[6] = &domain.Task{ID: 6, Title: "Codex 6", Status: domain.StatusTodo, Labels: []string{"codex"}}
+
+	loader := newSchedulerTestConfig()
+	loader.Config.AgentsConfig.Routing = []domain.RoutingRule{{Agent: "codex", Labels: []string{"codex"}}}
+	loader.Config.Scheduler.AgentLimits = map[string]int{"claude": 1, "codex": 2}
+
+	starter := &fakeTaskStarter{repo: repo}
+	uc := NewRunScheduler(repo, starter, loader, testutil.NewMockLogger(), &bytes.Buffer{})
+
+	out, err := uc.Execute(context.Background(), RunSchedulerInput{MaxParallel: 10, Once: true})
+
+	// claude is already at its cap, codex takes two more tasks
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 5}, out.Started)
+}
+
+func TestRunScheduler_Execute_AgentOverride(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Todo", Status: domain.StatusTodo}
+
+	loader := newSchedulerTestConfig()
+	loader.Config.Scheduler.Agent = "codex"
+
+	starter := &fakeTaskStarter{repo: repo}
+	uc := NewRunScheduler(repo, starter, loader, testutil.NewMockLogger(), &bytes.Buffer{})
+
+	// Config agent takes precedence over worker_default
+	_, err := uc.Execute(context.Background(), RunSchedulerInput{Once: true})
+	require.NoError(t, err)
+	require.Len(t, starter.inputs, 1)
+	assert.Equal(t, "codex", starter.inputs[0].Agent)
+
+	// Input agent takes precedence over config
+	repo.Tasks[1].Status = domain.StatusTodo
+	_, err = uc.Execute(context.Background(), RunSchedulerInput{Agent: "opencode", Once: true})
+	require.NoError(t, err)
+	require.Len(t, starter.inputs, 2)
+	assert.Equal(t, "opencode", starter.inputs[1].Agent)
+}
+
+func TestRunScheduler_Execute_RoutesTasks(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Docs", Status: domain.StatusTodo, Labels: []string{"docs"}}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Feature", Status: domain.StatusTodo}
+
+	loader := newSchedulerTestConfig()
+	loader.Config.AgentsConfig.Routing = []domain.RoutingRule{{Agent: "codex", Labels: []string{"docs"}}}
+
+	starter := &fakeTaskStarter{repo: repo}
+	var stdout bytes.Buffer
+	uc := NewRunScheduler(repo, starter, loader, testutil.NewMockLogger(), &stdout)
+
+	out, err := uc.Execute(context.Background(), RunSchedulerInput{Once: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, out.Started)
+	require.Len(t, starter.inputs, 2)
+	assert.Empty(t, starter.inputs[0].Agent)
+	assert.Empty(t, starter.inputs[1].Agent)
+	assert.Contains(t, stdout.String(), "Started task #1 (agent: codex, running: 1/3)")
+	assert.Contains(t, stdout.String(), "Started task #2 (agent: claude, running: 2/3)")
+}
+
+func TestRunScheduler_Execute_SkipsUnfinishedDependencies(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Dependency", Status: domain.StatusDone}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Dependent", Status: domain.StatusTodo, DependsOn: []int{1}}
+	repo.Tasks[3] = &domain.Task{ID: 3, Title: "Merged dependency", Status: domain.StatusTodo, DependsOn: []int{4}}
+	repo.Tasks[4] = &domain.Task{ID: 4, Title: "Merged", Status: domain.StatusMerged}
+
+	starter := &fakeTaskStarter{repo: repo}
+	uc := NewRunScheduler(repo, starter, newSchedulerTestConfig(), testutil.NewMockLogger(), &bytes.Buffer{})
+
+	out, err := uc.Execute(context.Background(), RunSchedulerInput{Once: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{3}, out.Started)
+}
+
+func TestRunScheduler_Execute_StartFailureContinues(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Broken", Status: domain.StatusTodo}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Fine", Status: domain.StatusTodo}
+
+	starter := &fakeTaskStarter{repo: repo, errs: map[int]error{1: errors.New("worktree failed")}}
+	logger := testutil.NewMockLogger()
+	var stdout bytes.Buffer
+	uc := NewRunScheduler(repo, starter, newSchedulerTestConfig(), logger, &stdout)
+
+	out, err := uc.Execute(context.Background(), RunSchedulerInput{Once: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, out.Started)
+	assert.Contains(t, stdout.String(), "Failed to start task #1: worktree failed")
+	require.NotEmpty(t, logger.Entries)
+	assert.Equal(t, "WARN", logger.Entries[0].Level)
+	assert.Equal(t, 1, logger.Entries[0].TaskID)
+}
+
+func TestRunScheduler_Execute_NoAgent(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	loader := testutil.NewMockConfigLoader()
+	loader.Config.AgentsConfig.DefaultWorker = ""
+
+	uc := NewRunScheduler(repo, &fakeTaskStarter{repo: repo}, loader, testutil.NewMockLogger(), &bytes.Buffer{})
+
+	_, err := uc.Execute(context.Background(), RunSchedulerInput{Once: true})
+
+	assert.ErrorIs(t, err, domain.ErrAgentNotFound)
+}
+
+func TestRunScheduler_Execute_ConfigError(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	loader := testutil.NewMockConfigLoader()
+	loader.LoadErr = assert.AnError
+
+	uc := NewRunScheduler(repo, &fakeTaskStarter{repo: repo}, loader, testutil.NewMockLogger(), &bytes.Buffer{})
+
+	_, err := uc.Execute(context.Background(), RunSchedulerInput{Once: true})
+
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestRunScheduler_Execute_ListError(t *testing.T) {
+	repo := &testutil.MockTaskRepositoryWithListError{
+		MockTaskRepository: testutil.NewMockTaskRepository(),
+		ListErr:            assert.AnError,
+	}
+
+	uc := NewRunScheduler(repo, &fakeTaskStarter{}, newSchedulerTestConfig(), testutil.NewMockLogger(), &bytes.Buffer{})
+
+	_, err := uc.Execute(context.Background(), RunSchedulerInput{Once: true})
+
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestRunScheduler_Execute_ContextCancel(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Todo", Status: domain.StatusTodo}
+
+	starter := &fakeTaskStarter{repo: repo}
+	uc := NewRunScheduler(repo, starter, newSchedulerTestConfig(), testutil.NewMockLogger(), &bytes.Buffer{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	out, err := uc.Execute(ctx, RunSchedulerInput{Interval: 60})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, out.Started)
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// SaveSnapshotInput contains the parameters for saving a task snapshot.
+type SaveSnapshotInput struct {
+	Rev string // Revision to associate the snapshot with (optional, empty = default branch)
+}
+
+// SaveSnapshotOutput contains the result of saving a task snapshot.
+type SaveSnapshotOutput struct {
+	Snapshot domain.SnapshotInfo // The created snapshot
+}
+
+// SaveSnapshot is the use case for saving the current task state as a snapshot.
+type SaveSnapshot struct {
+	tasks  domain.TaskRepository
+	git    domain.Git
+	logger domain.Logger
+}
+
+// NewSaveSnapshot creates a new SaveSnapshot use case.
+func NewSaveSnapshot(tasks domain.TaskRepository, git domain.Git, logger domain.Logger) *SaveSnapshot {
+	return &SaveSnapshot{
+		tasks:  tasks,
+		git:    git,
+		logger: logger,
+	}
+}
+
+// Execute saves a snapshot associated with the commit SHA of the given revision.
+func (uc *SaveSnapshot) Execute(_ context.Context, in SaveSnapshotInput) (*SaveSnapshotOutput, error) {
+	snapshot, err := saveSnapshot(uc.tasks, uc.git, in.Rev)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.logger != nil {
+		uc.logger.Info(0, "snapshot", fmt.Sprintf("saved snapshot %s", snapshot.Ref))
+	}
+
+	return &SaveSnapshotOutput{Snapshot: snapshot}, nil
+}
+
+// saveSnapshot saves a snapshot for the commit SHA of rev (default branch if empty)
+// and returns the created snapshot.
+func saveSnapshot(tasks domain.TaskRepository, git domain.Git, rev string) (domain.SnapshotInfo, error) {
+	if rev == "" {
+		defaultBranch, err := git.GetDefaultBranch()
+		if err != nil {
+			return domain.SnapshotInfo{}, fmt.Errorf("get default branch: %w", err)
+		}
+		rev = defaultBranch
+	}
+	sha, err := git.RevParse(rev)
+	if err != nil {
+		return domain.SnapshotInfo{}, err
+	}
+
+	if err := tasks.SaveSnapshot(sha); err != nil {
+		return domain.SnapshotInfo{}, fmt.Errorf("save snapshot: %w", err)
+	}
+
+	snapshots, err := tasks.ListSnapshots(sha)
+	if err != nil {
+		return domain.SnapshotInfo{}, fmt.Errorf("list snapshots: %w", err)
+	}
+	if len(snapshots) == 0 {
+		return domain.SnapshotInfo{}, fmt.Errorf("save snapshot: %w", domain.ErrSnapshotNotFound)
+	}
+	return snapshots[len(snapshots)-1], nil
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveSnapshot_Execute_DefaultBranch(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	mockGit := &testutil.MockGit{DefaultBranchName: testutil.StringPtr("develop")}
+	uc := NewSaveSnapshot(repo, mockGit, testutil.NewMockLogger())
+
+	// Execute twice to get increasing sequence numbers
+	_, err := uc.Execute(context.Background(), SaveSnapshotInput{})
+	require.NoError(t, err)
+	out, err := uc.Execute(context.Background(), SaveSnapshotInput{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "sha-develop", out.Snapshot.MainSHA)
+	assert.Equal(t, "sha-develop_002", out.Snapshot.Ref)
+	assert.Equal(t, 2, out.Snapshot.Seq)
+}
+
+func TestSaveSnapshot_Execute_Rev(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	mockGit := &testutil.MockGit{}
+	uc := NewSaveSnapshot(repo, mockGit, nil)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), SaveSnapshotInput{Rev: "v1.0"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "sha-v1.0_001", out.Snapshot.Ref)
+	assert.False(t, mockGit.GetDefaultBranchCalled)
+}
+
+func TestSaveSnapshot_Execute_RevParseError(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	mockGit := &testutil.MockGit{RevParseErr: assert.AnError}
+	uc := NewSaveSnapshot(repo, mockGit, nil)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), SaveSnapshotInput{Rev: "missing"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, repo.Snapshots)
+}
+
+func TestSaveSnapshot_Execute_SaveError(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.SnapshotErr = assert.AnError
+	uc := NewSaveSnapshot(repo, &testutil.MockGit{}, nil)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), SaveSnapshotInput{})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.NotErrorIs(t, err, domain.ErrSnapshotNotFound)
+}
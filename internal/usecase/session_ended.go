@@ -2,9 +2,11 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
 )
 
 // SessionEndedInput contains the parameters for handling session termination.
@@ -14,27 +16,44 @@ type SessionEndedInput struct {
 }
 
 // SessionEndedOutput contains the result of handling session termination.
+// Fields are ordered to minimize memory padding.
 type SessionEndedOutput struct {
-	Ignored bool // True if the callback was ignored (already cleaned up)
+	Agent    string // Agent whose session ended
+	Fallback string // Fallback agent to restart the task with (empty if none)
+	Ignored  bool   // True if the callback was ignored (already cleaned up)
+	Restart  bool   // True if the task should be restarted with the same agent
 }
 
 // SessionEnded is the use case for handling session termination.
 // This is called by the task script's EXIT trap.
 type SessionEnded struct {
 	tasks   domain.TaskRepository
+	clock   domain.Clock
+	config  domain.ConfigLoader
 	crewDir string
 }
 
 // NewSessionEnded creates a new SessionEnded use case.
-func NewSessionEnded(tasks domain.TaskRepository, crewDir string) *SessionEnded {
+func NewSessionEnded(tasks domain.TaskRepository, clock domain.Clock, crewDir string) *SessionEnded {
 	return &SessionEnded{
 		tasks:   tasks,
+		clock:   clock,
 		crewDir: crewDir,
 	}
 }
 
+// WithConfig sets the config loader used to look up agent restart and fallback settings.
+// Without it, a session that ends in error leaves the task in error.
+func (uc *SessionEnded) WithConfig(config domain.ConfigLoader) *SessionEnded {
+	uc.config = config
+	return uc
+}
+
 // Execute handles session termination.
-// It clears agent info, deletes script files, and updates status based on exit code.
+// It records the usage the agent reported, clears agent info, deletes script files,
+// and updates status based on exit code. If the task ends up in error after a
+// failure (see domain.ClassifyExitCode), the output tells whether to restart it
+// with the same agent (while restarts are left) or with a fallback agent.
 func (uc *SessionEnded) Execute(_ context.Context, in SessionEndedInput) (*SessionEndedOutput, error) {
 	// Get task
 	task, err := uc.tasks.Get(in.TaskID)
@@ -52,17 +71,27 @@ func (uc *SessionEnded) Execute(_ context.Context, in SessionEndedInput) (*Sessi
 		return &SessionEndedOutput{Ignored: true}, nil
 	}
 
+	// Attach the usage summary the agent printed before exiting (best effort)
+	sessionName := task.Session
+	if sessionName == "" {
+		sessionName = domain.SessionName(task.ID)
+	}
+	_, _ = shared.RecordSessionUsage(uc.tasks, uc.clock, uc.crewDir, task.ID, task.Agent, sessionName, 0)
+
 	// Update status based on task status
 	// - in_progress: transition to error (session end while in_progress = abnormal)
 	// - Other states: maintain current status
-	if task.Status == domain.StatusInProgress {
+	failed := task.Status == domain.StatusInProgress
+	if failed {
 		task.Status = domain.StatusError
 	}
 
 	// Always clear agent info on session end
 	// This prevents TUI from showing "running" state when session is gone
+	agentName := task.Agent
 	task.Agent = ""
 	task.Session = ""
+	task.Stalled = ""
 
 	// Save task
 	if err := uc.tasks.Save(task); err != nil {
@@ -72,7 +101,58 @@ func (uc *SessionEnded) Execute(_ context.Context, in SessionEndedInput) (*Sessi
 	// Cleanup script files (ignore errors)
 	uc.cleanupScriptFiles(in.TaskID)
 
-	return &SessionEndedOutput{Ignored: false}, nil
+	out := &SessionEndedOutput{Agent: agentName}
+	if failed && uc.config != nil {
+		if err := uc.planRecovery(out, task, in.ExitCode); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// planRecovery decides how to recover a task whose session failed:
+// restart the same agent while restarts are left, then move on to the fallback chain.
+func (uc *SessionEnded) planRecovery(out *SessionEndedOutput, task *domain.Task, exitCode int) error {
+	cfg, err := uc.config.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+	agent := cfg.Agents[out.Agent]
+	if domain.ClassifyExitCode(exitCode, agent.NoRetryExitCodes) != domain.ExitFailed {
+		return nil
+	}
+	if task.RestartCount < agent.MaxRestarts {
+		out.Restart = true
+		return nil
+	}
+	out.Fallback, err = uc.nextFallback(cfg, task, out.Agent, exitCode)
+	return err
+}
+
+// nextFallback returns the next agent of the fallback chain, recording on the
+// task when a chain has run out of agents.
+func (uc *SessionEnded) nextFallback(cfg *domain.Config, task *domain.Task, agentName string, exitCode int) (string, error) {
+	comments, err := uc.tasks.GetComments(task.ID)
+	if err != nil {
+		return "", fmt.Errorf("get comments: %w", err)
+	}
+	step, ok := domain.NextFallback(cfg, task, comments, agentName, 0)
+	if ok {
+		return step.Agent, nil
+	}
+	if step.Attempt > 0 {
+		exhausted := domain.FallbackAttempt{
+			Time:     uc.clock.Now(),
+			Origin:   step.Origin,
+			From:     agentName,
+			Attempt:  step.Attempt,
+			ExitCode: exitCode,
+		}
+		if err := uc.tasks.AddComment(task.ID, exhausted.Comment()); err != nil {
+			return "", fmt.Errorf("add comment: %w", err)
+		}
+	}
+	return "", nil
 }
 
 // cleanupScriptFiles removes the generated script file.
@@ -2,9 +2,11 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/runoshun/git-crew/v2/internal/domain"
 	"github.com/runoshun/git-crew/v2/internal/testutil"
@@ -25,7 +27,7 @@ func TestSessionEnded_Execute_NormalExit(t *testing.T) {
 		Session: "crew-1",
 	}
 
-	uc := NewSessionEnded(repo, crewDir)
+	uc := NewSessionEnded(repo, &testutil.MockClock{}, crewDir)
 
 	// Execute with normal exit (code 0)
 	out, err := uc.Execute(context.Background(), SessionEndedInput{
@@ -63,7 +65,7 @@ func TestSessionEnded_Execute_AbnormalExit(t *testing.T) {
 		Session: "crew-1",
 	}
 
-	uc := NewSessionEnded(repo, crewDir)
+	uc := NewSessionEnded(repo, &testutil.MockClock{}, crewDir)
 
 	// Execute with abnormal exit (code 1)
 	out, err := uc.Execute(context.Background(), SessionEndedInput{
@@ -94,7 +96,7 @@ func TestSessionEnded_Execute_CtrlC(t *testing.T) {
 		Session: "crew-1",
 	}
 
-	uc := NewSessionEnded(repo, crewDir)
+	uc := NewSessionEnded(repo, &testutil.MockClock{}, crewDir)
 
 	// Execute with Ctrl+C exit (code 130)
 	out, err := uc.Execute(context.Background(), SessionEndedInput{
@@ -123,7 +125,7 @@ func TestSessionEnded_Execute_AlreadyCleared(t *testing.T) {
 		Session: "", // Already cleared
 	}
 
-	uc := NewSessionEnded(repo, crewDir)
+	uc := NewSessionEnded(repo, &testutil.MockClock{}, crewDir)
 
 	// Execute
 	out, err := uc.Execute(context.Background(), SessionEndedInput{
@@ -145,7 +147,7 @@ func TestSessionEnded_Execute_TaskNotFound(t *testing.T) {
 
 	repo := testutil.NewMockTaskRepository()
 
-	uc := NewSessionEnded(repo, crewDir)
+	uc := NewSessionEnded(repo, &testutil.MockClock{}, crewDir)
 
 	// Execute with non-existent task
 	out, err := uc.Execute(context.Background(), SessionEndedInput{
@@ -170,7 +172,7 @@ func TestSessionEnded_Execute_MaintainDoneStatus(t *testing.T) {
 		Session: "crew-1",
 	}
 
-	uc := NewSessionEnded(repo, crewDir)
+	uc := NewSessionEnded(repo, &testutil.MockClock{}, crewDir)
 
 	// Execute with normal exit
 	out, err := uc.Execute(context.Background(), SessionEndedInput{
@@ -201,7 +203,7 @@ func TestSessionEnded_Execute_ClosedStatusUnchanged(t *testing.T) {
 		Session: "crew-1",
 	}
 
-	uc := NewSessionEnded(repo, crewDir)
+	uc := NewSessionEnded(repo, &testutil.MockClock{}, crewDir)
 
 	// Execute
 	out, err := uc.Execute(context.Background(), SessionEndedInput{
@@ -220,6 +222,70 @@ func TestSessionEnded_Execute_ClosedStatusUnchanged(t *testing.T) {
 	assert.Empty(t, task.Session, "session should be cleared on session end")
 }
 
+func TestSessionEnded_Execute_RecordsUsage(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:      1,
+		Title:   "Test task",
+		Status:  domain.StatusInProgress,
+		Agent:   "claude-fast",
+		Session: "crew-1",
+	}
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// Worker stdout only reaches the recording
+	event, err := json.Marshal(domain.RecordingEvent{
+		Type: domain.RecordingOutput,
+		Data: `{"type":"result","total_cost_usd":0.25,"usage":{"input_tokens":100,"output_tokens":20}}` + "\r\n",
+	})
+	require.NoError(t, err)
+	recording := `{"version": 2, "width": 80, "height": 24}` + "\n" + string(event) + "\n"
+	require.NoError(t, os.MkdirAll(filepath.Join(crewDir, "logs"), 0o755))
+	require.NoError(t, os.WriteFile(domain.RecordingPath(crewDir, "crew-1"), []byte(recording), 0o644))
+
+	uc := NewSessionEnded(repo, &testutil.MockClock{NowTime: now}, crewDir)
+
+	// Execute
+	_, err = uc.Execute(context.Background(), SessionEndedInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	records := domain.UsageRecords(repo.Comments[1])
+	require.Len(t, records, 1)
+	assert.Equal(t, domain.UsageRecord{
+		Time:    now,
+		Agent:   "claude-fast",
+		Session: "crew-1",
+		Usage:   domain.Usage{InputTokens: 100, OutputTokens: 20, CostUSD: 0.25, HasCost: true},
+	}, records[0])
+}
+
+func TestSessionEnded_Execute_NoUsageReported(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:      1,
+		Title:   "Test task",
+		Status:  domain.StatusInProgress,
+		Agent:   "claude",
+		Session: "crew-1",
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(crewDir, "logs"), 0o755))
+	require.NoError(t, os.WriteFile(domain.SessionLogPath(crewDir, "crew-1"), []byte("some error\n"), 0o644))
+
+	uc := NewSessionEnded(repo, &testutil.MockClock{}, crewDir)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), SessionEndedInput{TaskID: 1, ExitCode: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, repo.Comments[1])
+}
+
 // Helper function to create script file for testing cleanup
 func createScriptFiles(t *testing.T, crewDir string, taskID int) {
 	t.Helper()
@@ -227,3 +293,146 @@ func createScriptFiles(t *testing.T, crewDir string, taskID int) {
 	require.NoError(t, os.MkdirAll(scriptsDir, 0755))
 	require.NoError(t, os.WriteFile(domain.ScriptPath(crewDir, taskID), []byte("test"), 0755))
 }
+
+func TestSessionEnded_Execute_Fallback(t *testing.T) {
+	tests := []struct {
+		name         string
+		wantFallback string
+		exitCode     int
+	}{
+		{name: "error exit restarts with fallback", exitCode: 1, wantFallback: "codex-dev"},
+		{name: "clean exit is not retried", exitCode: 0},
+		{name: "Ctrl+C is not retried", exitCode: domain.ExitCodeInterrupted},
+		{name: "SIGTERM is not retried", exitCode: domain.ExitCodeTerminated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			repo := testutil.NewMockTaskRepository()
+			repo.Tasks[1] = &domain.Task{
+				ID:      1,
+				Title:   "Test task",
+				Status:  domain.StatusInProgress,
+				Agent:   "claude-dev",
+				Session: "crew-1",
+			}
+			configLoader := testutil.NewMockConfigLoader()
+			configLoader.Config.Agents["claude-dev"] = domain.Agent{Fallback: []string{"codex-dev", "opencode-dev"}}
+			uc := NewSessionEnded(repo, &testutil.MockClock{}, t.TempDir()).WithConfig(configLoader)
+
+			// Execute
+			out, err := uc.Execute(context.Background(), SessionEndedInput{TaskID: 1, ExitCode: tt.exitCode})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, "claude-dev", out.Agent)
+			assert.Equal(t, tt.wantFallback, out.Fallback)
+			assert.Equal(t, domain.StatusError, repo.Tasks[1].Status)
+		})
+	}
+}
+
+func TestSessionEnded_Execute_FallbackChainExhausted(t *testing.T) {
+	// Setup
+	started := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	now := started.Add(time.Hour)
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:      1,
+		Title:   "Test task",
+		Status:  domain.StatusInProgress,
+		Agent:   "codex-dev",
+		Session: "crew-1",
+		Started: started,
+	}
+	// The running session is the last agent of claude-dev's chain
+	repo.Comments[1] = []domain.Comment{
+		domain.FallbackAttempt{Time: started, Origin: "claude-dev", From: "claude-dev", Agent: "codex-dev", Attempt: 1, ExitCode: 1}.Comment(),
+	}
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config.Agents["claude-dev"] = domain.Agent{Fallback: []string{"codex-dev"}}
+	uc := NewSessionEnded(repo, &testutil.MockClock{NowTime: now}, t.TempDir()).WithConfig(configLoader)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), SessionEndedInput{TaskID: 1, ExitCode: 2})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, out.Fallback)
+	require.Len(t, repo.Comments[1], 2)
+	exhausted, ok := domain.FallbackAttemptFromComment(repo.Comments[1][1])
+	require.True(t, ok)
+	assert.Equal(t, domain.FallbackAttempt{Time: now, Origin: "claude-dev", From: "codex-dev", Attempt: 1, ExitCode: 2}, exhausted)
+	assert.Equal(t, "codex-dev exited with code 2; fallback chain of claude-dev exhausted, leaving the task in error", repo.Comments[1][1].Text)
+}
+
+func TestSessionEnded_Execute_Restart(t *testing.T) {
+	tests := []struct {
+		name         string
+		wantFallback string
+		restarts     int
+		exitCode     int
+		wantRestart  bool
+	}{
+		{name: "error exit restarts the same agent", exitCode: 1, wantRestart: true},
+		{name: "restarts left", exitCode: 1, restarts: 1, wantRestart: true},
+		{name: "restarts exhausted falls back", exitCode: 1, restarts: 2, wantFallback: "codex-dev"},
+		{name: "no_retry_exit_codes are not retried", exitCode: 3},
+		{name: "SIGTERM is not retried", exitCode: domain.ExitCodeTerminated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			repo := testutil.NewMockTaskRepository()
+			repo.Tasks[1] = &domain.Task{
+				ID:           1,
+				Title:        "Test task",
+				Status:       domain.StatusInProgress,
+				Agent:        "claude-dev",
+				Session:      "crew-1",
+				RestartCount: tt.restarts,
+			}
+			configLoader := testutil.NewMockConfigLoader()
+			configLoader.Config.Agents["claude-dev"] = domain.Agent{
+				Fallback:         []string{"codex-dev"},
+				NoRetryExitCodes: []int{3},
+				MaxRestarts:      2,
+			}
+			uc := NewSessionEnded(repo, &testutil.MockClock{}, t.TempDir()).WithConfig(configLoader)
+
+			// Execute
+			out, err := uc.Execute(context.Background(), SessionEndedInput{TaskID: 1, ExitCode: tt.exitCode})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRestart, out.Restart)
+			assert.Equal(t, tt.wantFallback, out.Fallback)
+			assert.Equal(t, domain.StatusError, repo.Tasks[1].Status)
+			assert.Equal(t, tt.restarts, repo.Tasks[1].RestartCount)
+		})
+	}
+}
+
+func TestSessionEnded_Execute_NoFallbackWithoutConfig(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:      1,
+		Title:   "Test task",
+		Status:  domain.StatusInProgress,
+		Agent:   "claude-dev",
+		Session: "crew-1",
+	}
+	uc := NewSessionEnded(repo, &testutil.MockClock{}, t.TempDir())
+
+	// Execute
+	out, err := uc.Execute(context.Background(), SessionEndedInput{TaskID: 1, ExitCode: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, out.Restart)
+	assert.Empty(t, out.Fallback)
+	assert.Empty(t, repo.Comments[1])
+}
@@ -42,6 +42,10 @@ func (uc *SetSubstate) Execute(_ context.Context, in SetSubstateInput) (*SetSubs
 		return nil, err
 	}
 
+	if task.ExecutionSubstate != in.Substate {
+		// A substate change means the session is active again
+		task.Stalled = ""
+	}
 	task.ExecutionSubstate = in.Substate
 	if err := uc.tasks.Save(task); err != nil {
 		return nil, fmt.Errorf("save task: %w", err)
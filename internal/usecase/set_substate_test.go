@@ -25,6 +25,20 @@ func TestSetSubstate_Execute(t *testing.T) {
 	assert.Equal(t, domain.SubstateRunning, repo.Tasks[1].ExecutionSubstate)
 }
 
+func TestSetSubstate_ClearsStall(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, ExecutionSubstate: domain.SubstateAwaitingPermission, Stalled: domain.StallPermission}
+	uc := NewSetSubstate(repo)
+
+	_, err := uc.Execute(context.Background(), SetSubstateInput{TaskID: 1, Substate: domain.SubstateAwaitingPermission})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StallPermission, repo.Tasks[1].Stalled, "unchanged substate keeps the stall")
+
+	_, err = uc.Execute(context.Background(), SetSubstateInput{TaskID: 1, Substate: domain.SubstateRunning})
+	require.NoError(t, err)
+	assert.Empty(t, repo.Tasks[1].Stalled)
+}
+
 func TestSetSubstate_InvalidSubstate(t *testing.T) {
 	repo := testutil.NewMockTaskRepository()
 	uc := NewSetSubstate(repo)
@@ -2,6 +2,7 @@
 package shared
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -14,6 +15,7 @@ type ConflictHandler struct {
 	sessions domain.SessionManager
 	git      domain.Git
 	clock    domain.Clock
+	resolver *ConflictResolver
 }
 
 // NewConflictHandler creates a new ConflictHandler.
@@ -31,12 +33,19 @@ func NewConflictHandler(
 	}
 }
 
+// WithResolver sets the conflict resolver tried before handing conflicts back to the worker.
+func (h *ConflictHandler) WithResolver(resolver *ConflictResolver) *ConflictHandler {
+	h.resolver = resolver
+	return h
+}
+
 // ConflictCheckInput contains the parameters for conflict checking.
 // Fields are ordered to minimize memory padding.
 type ConflictCheckInput struct {
 	Branch     string // Task branch to merge
 	BaseBranch string // Target branch to merge into
 	TaskID     int    // Task ID
+	Rebase     bool   // Resolve by rebasing onto the base branch instead of merging it
 }
 
 // ConflictCheckOutput contains the result of conflict checking.
@@ -45,14 +54,16 @@ type ConflictCheckOutput struct {
 }
 
 // CheckAndHandle checks for merge conflicts and handles them if found.
-// If conflicts exist:
+// If conflicts exist and a conflict resolver is configured, the resolver runs first;
+// when it leaves the branch mergeable, no conflict is reported.
+// Otherwise:
 // - Transitions task status to in_progress
 // - Notifies the running session (if any)
 // - Returns a ConflictCheckOutput with the message and ErrMergeConflict
 //
 // The caller is responsible for displaying the conflict message to stdout.
 // Always returns a non-nil ConflictCheckOutput (empty on error or no conflict).
-func (h *ConflictHandler) CheckAndHandle(in ConflictCheckInput) (*ConflictCheckOutput, error) {
+func (h *ConflictHandler) CheckAndHandle(ctx context.Context, in ConflictCheckInput) (*ConflictCheckOutput, error) {
 	// Get conflicting files
 	conflictFiles, err := h.git.GetMergeConflictFiles(in.Branch, in.BaseBranch)
 	if err != nil {
@@ -70,8 +81,35 @@ func (h *ConflictHandler) CheckAndHandle(in ConflictCheckInput) (*ConflictCheckO
 		return &ConflictCheckOutput{}, err
 	}
 
+	// Let the conflict resolver try first
+	var resolverNote string
+	if h.resolver != nil {
+		resolved, resolveErr := h.resolver.Resolve(ctx, ConflictResolveInput{
+			Task:       task,
+			Branch:     in.Branch,
+			BaseBranch: in.BaseBranch,
+			Files:      conflictFiles,
+			Rebase:     in.Rebase,
+		})
+		if resolved {
+			return &ConflictCheckOutput{}, nil
+		}
+		if resolveErr != nil {
+			if ctx.Err() != nil {
+				return &ConflictCheckOutput{}, resolveErr
+			}
+			resolverNote = fmt.Sprintf("Conflict resolver failed: %v", resolveErr)
+		}
+		if remaining, checkErr := h.git.GetMergeConflictFiles(in.Branch, in.BaseBranch); checkErr == nil && len(remaining) > 0 {
+			conflictFiles = remaining
+		}
+	}
+
 	// Build conflict message
 	message := buildConflictMessage(conflictFiles, in.BaseBranch, in.TaskID)
+	if resolverNote != "" {
+		message = resolverNote + "\n\n" + message
+	}
 
 	// Transition status to in_progress
 	task.Status = domain.StatusInProgress
@@ -0,0 +1,271 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// conflictDiffMaxBytes caps each side's diff in the resolver prompt.
+const conflictDiffMaxBytes = 64 * 1024
+
+// ConflictResolver runs the configured conflict_resolver agent in a task worktree
+// and reports whether the branch became mergeable.
+// Fields are ordered to minimize memory padding.
+type ConflictResolver struct {
+	config    domain.ConfigLoader
+	sessions  domain.SessionManager
+	worktrees domain.WorktreeManager
+	git       domain.Git
+	logger    domain.Logger
+	output    io.Writer
+	crewDir   string
+	repoRoot  string
+}
+
+// NewConflictResolver creates a new ConflictResolver.
+// Progress messages are written to output (may be nil).
+func NewConflictResolver(
+	config domain.ConfigLoader,
+	sessions domain.SessionManager,
+	worktrees domain.WorktreeManager,
+	git domain.Git,
+	logger domain.Logger,
+	output io.Writer,
+	crewDir string,
+	repoRoot string,
+) *ConflictResolver {
+	return &ConflictResolver{
+		config:    config,
+		sessions:  sessions,
+		worktrees: worktrees,
+		git:       git,
+		logger:    logger,
+		output:    output,
+		crewDir:   crewDir,
+		repoRoot:  repoRoot,
+	}
+}
+
+// ConflictResolveInput contains the parameters for resolving conflicts.
+// Fields are ordered to minimize memory padding.
+type ConflictResolveInput struct {
+	Task       *domain.Task
+	Branch     string   // Task branch
+	BaseBranch string   // Branch the task conflicts with
+	Files      []string // Conflicting files
+	Rebase     bool     // Rebase onto the base branch instead of merging it
+}
+
+// Resolve starts the conflict resolver session and waits for it to exit.
+// Returns false without error when no conflict resolver is configured.
+// The branch counts as resolved only if the worktree is clean and the branch
+// no longer conflicts with the base branch.
+func (r *ConflictResolver) Resolve(ctx context.Context, in ConflictResolveInput) (bool, error) {
+	cfg, err := r.config.Load()
+	if err != nil {
+		return false, fmt.Errorf("load config: %w", err)
+	}
+	agentName := cfg.AgentsConfig.DefaultConflictResolver
+	if agentName == "" {
+		return false, nil
+	}
+
+	agent, ok := cfg.EnabledAgents()[agentName]
+	if !ok {
+		if _, exists := cfg.Agents[agentName]; exists {
+			return false, fmt.Errorf("agent %q is disabled: %w", agentName, domain.ErrAgentDisabled)
+		}
+		return false, fmt.Errorf("agent %q: %w", agentName, domain.ErrAgentNotFound)
+	}
+	if agent.Role != domain.RoleConflictResolver {
+		return false, fmt.Errorf("agent %q has role %q, want %q: %w", agentName, agent.Role, domain.RoleConflictResolver, domain.ErrAgentRoleMismatch)
+	}
+
+	wtPath, err := r.worktrees.Resolve(in.Branch)
+	if err != nil {
+		return false, fmt.Errorf("resolve worktree: %w", err)
+	}
+	dirty, err := r.git.HasUncommittedChanges(wtPath)
+	if err != nil {
+		return false, fmt.Errorf("check uncommitted changes: %w", err)
+	}
+	if dirty {
+		return false, fmt.Errorf("worktree has uncommitted changes: %w", domain.ErrUncommittedChanges)
+	}
+
+	sessionName := domain.ConflictResolverSessionName(in.Task.ID)
+	if running, _ := r.sessions.IsRunning(sessionName); running {
+		return false, fmt.Errorf("conflict resolver for task #%d: %w", in.Task.ID, domain.ErrSessionRunning)
+	}
+
+	cmdData := domain.CommandData{
+		GitDir:      r.repoRoot + "/.git",
+		RepoRoot:    r.repoRoot,
+		Worktree:    wtPath,
+		Title:       in.Task.Title,
+		Description: in.Task.Description,
+		Branch:      in.Branch,
+		Issue:       in.Task.Issue,
+		TaskID:      in.Task.ID,
+		Model:       agent.DefaultModel,
+	}
+	result, err := agent.RenderCommand(cmdData, `"$PROMPT"`, domain.DefaultConflictResolverSystemPrompt, cfg.AgentsConfig.ConflictResolverPrompt)
+	if err != nil {
+		return false, fmt.Errorf("render agent command: %w", err)
+	}
+	// Conflict details are appended after rendering so diffs are never template-expanded
+	result.Prompt += "\n\n" + r.buildConflictPrompt(in)
+
+	scriptPath, err := r.writeScript(sessionName, in.Task.ID, result)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = os.Remove(scriptPath)
+	}()
+
+	r.writeMessage(fmt.Sprintf("Starting conflict resolver %q for task #%d (%d file(s))...", agentName, in.Task.ID, len(in.Files)))
+	r.log(in.Task.ID, fmt.Sprintf("conflict resolver started (agent: %s, files: %s)", agentName, strings.Join(in.Files, ", ")))
+	if startErr := r.sessions.Start(ctx, domain.StartSessionOptions{
+		Name:      sessionName,
+		Dir:       wtPath,
+		Command:   scriptPath,
+		TaskID:    in.Task.ID,
+		TaskTitle: in.Task.Title,
+		TaskAgent: agentName,
+		Type:      domain.SessionTypeWorker,
+	}); startErr != nil {
+		return false, fmt.Errorf("start conflict resolver session: %w", startErr)
+	}
+
+	if waitErr := r.sessions.Wait(ctx, sessionName); waitErr != nil {
+		if errors.Is(waitErr, context.Canceled) || errors.Is(waitErr, context.DeadlineExceeded) {
+			_ = r.sessions.Stop(sessionName)
+		}
+		return false, fmt.Errorf("wait for conflict resolver: %w", waitErr)
+	}
+
+	dirty, err = r.git.HasUncommittedChanges(wtPath)
+	if err != nil {
+		return false, fmt.Errorf("check uncommitted changes: %w", err)
+	}
+	if dirty {
+		r.writeMessage("Conflict resolver left uncommitted changes in the worktree.")
+		r.log(in.Task.ID, "conflict resolver left uncommitted changes")
+		return false, nil
+	}
+	remaining, err := r.git.GetMergeConflictFiles(in.Branch, in.BaseBranch)
+	if err != nil {
+		return false, fmt.Errorf("check merge conflict: %w", err)
+	}
+	if len(remaining) > 0 {
+		r.writeMessage(fmt.Sprintf("Conflicts remain after conflict resolver: %s", strings.Join(remaining, ", ")))
+		r.log(in.Task.ID, "conflict resolver finished with conflicts remaining")
+		return false, nil
+	}
+
+	r.writeMessage(fmt.Sprintf("Conflict resolver resolved the conflicts for task #%d.", in.Task.ID))
+	r.log(in.Task.ID, "conflict resolver resolved the conflicts")
+	return true, nil
+}
+
+// buildConflictPrompt describes the conflict: files, command to run, and both sides' changes.
+func (r *ConflictResolver) buildConflictPrompt(in ConflictResolveInput) string {
+	command := "git merge " + in.BaseBranch
+	if in.Rebase {
+		command = "git rebase " + in.BaseBranch
+	}
+
+	var sb strings.Builder
+	sb.WriteString("## Conflicting Files\n\n")
+	for _, f := range in.Files {
+		sb.WriteString("- ")
+		sb.WriteString(f)
+		sb.WriteString("\n")
+	}
+	sb.WriteString(fmt.Sprintf("\nRun '%s' (use the local branch directly - no fetch needed), then resolve the conflicts.\n", command))
+
+	sb.WriteString(fmt.Sprintf("\n## Changes on %s (task branch)\n\n", in.Branch))
+	sb.WriteString(r.sideDiff(in.BaseBranch, in.Branch, in.Files))
+	sb.WriteString(fmt.Sprintf("\n## Changes on %s (base branch)\n\n", in.BaseBranch))
+	sb.WriteString(r.sideDiff(in.Branch, in.BaseBranch, in.Files))
+	return sb.String()
+}
+
+// sideDiff returns the changes to files on branch since it diverged from base, fenced for the prompt.
+func (r *ConflictResolver) sideDiff(base, branch string, files []string) string {
+	diff, err := r.git.BranchDiff(base, branch, files)
+	if err != nil {
+		return fmt.Sprintf("(diff unavailable: %v)\n", err)
+	}
+	if len(diff) > conflictDiffMaxBytes {
+		diff = diff[:conflictDiffMaxBytes] + "\n... (truncated)\n"
+	}
+	if !strings.HasSuffix(diff, "\n") {
+		diff += "\n"
+	}
+	return "```diff\n" + diff + "```\n"
+}
+
+// writeScript writes the resolver script, which tees its output to the session log.
+func (r *ConflictResolver) writeScript(sessionName string, taskID int, result domain.RenderCommandResult) (string, error) {
+	scriptsDir := filepath.Join(r.crewDir, "scripts")
+	if err := os.MkdirAll(scriptsDir, 0750); err != nil {
+		return "", fmt.Errorf("create scripts directory: %w", err)
+	}
+
+	logPath := domain.SessionLogPath(r.crewDir, sessionName)
+	if err := os.MkdirAll(filepath.Dir(logPath), 0750); err != nil {
+		return "", fmt.Errorf("create log directory: %w", err)
+	}
+	script := fmt.Sprintf(`#!/bin/bash
+set -o pipefail
+
+exec > >(tee %q) 2>&1
+
+read -r -d '' PROMPT << 'END_OF_PROMPT'
+%s
+END_OF_PROMPT
+
+%s
+`, logPath, result.Prompt, result.Command)
+
+	file, err := os.CreateTemp(scriptsDir, fmt.Sprintf("resolve-%d-*.sh", taskID))
+	if err != nil {
+		return "", fmt.Errorf("create conflict resolver script: %w", err)
+	}
+
+	scriptPath := file.Name()
+	if _, err := file.WriteString(script); err != nil {
+		_ = file.Close()
+		return "", fmt.Errorf("write conflict resolver script: %w", err)
+	}
+	if err := file.Chmod(0700); err != nil {
+		_ = file.Close()
+		return "", fmt.Errorf("chmod conflict resolver script: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("close conflict resolver script: %w", err)
+	}
+
+	return scriptPath, nil
+}
+
+func (r *ConflictResolver) writeMessage(message string) {
+	if r.output != nil {
+		_, _ = fmt.Fprintln(r.output, message)
+	}
+}
+
+func (r *ConflictResolver) log(taskID int, msg string) {
+	if r.logger != nil {
+		r.logger.Info(taskID, "conflict", msg)
+	}
+}
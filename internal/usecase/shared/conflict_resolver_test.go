@@ -0,0 +1,231 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newConflictResolverTest(t *testing.T) (*testutil.MockConfigLoader, *testutil.MockSessionManager, *testutil.MockGit, *ConflictResolver, *bytes.Buffer) {
+	t.Helper()
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config.Agents["resolver"] = domain.Agent{
+		Role:            domain.RoleConflictResolver,
+		CommandTemplate: "resolve {{.Prompt}}",
+	}
+	configLoader.Config.AgentsConfig.DefaultConflictResolver = "resolver"
+	sessions := testutil.NewMockSessionManager()
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.ResolvePath = "/tmp/worktrees/crew-1"
+	conflicts := []string{"go.sum"}
+	git := &testutil.MockGit{
+		MergeConflictFiles: &conflicts,
+		BranchDiffs: map[string]string{
+			"main...crew-1": "+task side\n",
+			"crew-1...main": "+base side\n",
+		},
+	}
+	var output bytes.Buffer
+	resolver := NewConflictResolver(configLoader, sessions, worktrees, git, nil, &output, t.TempDir(), "/repo")
+	return configLoader, sessions, git, resolver, &output
+}
+
+func newConflictResolveInput() ConflictResolveInput {
+	return ConflictResolveInput{
+		Task:       &domain.Task{ID: 1, Title: "Bump deps", Description: "Update modules"},
+		Branch:     "crew-1",
+		BaseBranch: "main",
+		Files:      []string{"go.sum"},
+	}
+}
+
+func TestConflictResolver_Resolve_Resolved(t *testing.T) {
+	// Setup
+	_, sessions, git, resolver, output := newConflictResolverTest(t)
+	var script string
+	sessions.WaitFunc = func(_ context.Context, _ string) error {
+		content, err := os.ReadFile(sessions.StartOpts.Command)
+		require.NoError(t, err)
+		script = string(content)
+		git.MergeConflictFiles = nil
+		return nil
+	}
+
+	// Execute
+	resolved, err := resolver.Resolve(context.Background(), newConflictResolveInput())
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, resolved)
+	assert.Equal(t, domain.ConflictResolverSessionName(1), sessions.StartOpts.Name)
+	assert.Equal(t, "/tmp/worktrees/crew-1", sessions.StartOpts.Dir)
+	assert.Equal(t, "resolver", sessions.StartOpts.TaskAgent)
+	assert.Contains(t, script, "resolving merge conflicts for crew Task #1: Bump deps")
+	assert.Contains(t, script, "Update modules")
+	assert.Contains(t, script, "- go.sum")
+	assert.Contains(t, script, "Run 'git merge main'")
+	assert.Contains(t, script, "+task side")
+	assert.Contains(t, script, "+base side")
+	assert.Contains(t, output.String(), "resolved the conflicts")
+	// The script is removed once the resolver exits
+	assert.NoFileExists(t, sessions.StartOpts.Command)
+}
+
+func TestConflictResolver_Resolve_RebaseCommand(t *testing.T) {
+	// Setup
+	_, sessions, _, resolver, _ := newConflictResolverTest(t)
+	var script string
+	sessions.WaitFunc = func(_ context.Context, _ string) error {
+		content, err := os.ReadFile(sessions.StartOpts.Command)
+		require.NoError(t, err)
+		script = string(content)
+		return nil
+	}
+	in := newConflictResolveInput()
+	in.Rebase = true
+
+	// Execute
+	_, err := resolver.Resolve(context.Background(), in)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, script, "Run 'git rebase main'")
+}
+
+func TestConflictResolver_Resolve_ConflictsRemain(t *testing.T) {
+	// Setup
+	_, sessions, _, resolver, output := newConflictResolverTest(t)
+
+	// Execute
+	resolved, err := resolver.Resolve(context.Background(), newConflictResolveInput())
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, resolved)
+	assert.True(t, sessions.WaitCalled)
+	assert.Contains(t, output.String(), "Conflicts remain after conflict resolver: go.sum")
+}
+
+func TestConflictResolver_Resolve_LeavesUncommittedChanges(t *testing.T) {
+	// Setup
+	_, sessions, git, resolver, _ := newConflictResolverTest(t)
+	sessions.WaitFunc = func(_ context.Context, _ string) error {
+		git.MergeConflictFiles = nil
+		git.HasUncommittedChangesV = true
+		return nil
+	}
+
+	// Execute
+	resolved, err := resolver.Resolve(context.Background(), newConflictResolveInput())
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, resolved)
+}
+
+func TestConflictResolver_Resolve_NotConfigured(t *testing.T) {
+	// Setup
+	configLoader, sessions, _, resolver, _ := newConflictResolverTest(t)
+	configLoader.Config.AgentsConfig.DefaultConflictResolver = ""
+
+	// Execute
+	resolved, err := resolver.Resolve(context.Background(), newConflictResolveInput())
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, resolved)
+	assert.False(t, sessions.StartCalled)
+}
+
+func TestConflictResolver_Resolve_Errors(t *testing.T) {
+	tests := []struct {
+		setup   func(*testutil.MockConfigLoader, *testutil.MockGit)
+		wantErr error
+		name    string
+	}{
+		{
+			name: "wrong role",
+			setup: func(configLoader *testutil.MockConfigLoader, _ *testutil.MockGit) {
+				agent := configLoader.Config.Agents["resolver"]
+				agent.Role = domain.RoleWorker
+				configLoader.Config.Agents["resolver"] = agent
+			},
+			wantErr: domain.ErrAgentRoleMismatch,
+		},
+		{
+			name: "unknown agent",
+			setup: func(configLoader *testutil.MockConfigLoader, _ *testutil.MockGit) {
+				configLoader.Config.AgentsConfig.DefaultConflictResolver = "missing"
+			},
+			wantErr: domain.ErrAgentNotFound,
+		},
+		{
+			name: "dirty worktree",
+			setup: func(_ *testutil.MockConfigLoader, git *testutil.MockGit) {
+				git.HasUncommittedChangesV = true
+			},
+			wantErr: domain.ErrUncommittedChanges,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			configLoader, sessions, git, resolver, _ := newConflictResolverTest(t)
+			tt.setup(configLoader, git)
+
+			// Execute
+			resolved, err := resolver.Resolve(context.Background(), newConflictResolveInput())
+
+			// Assert
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.False(t, resolved)
+			assert.False(t, sessions.StartCalled)
+		})
+	}
+}
+
+func TestConflictHandler_CheckAndHandle_ResolverResolves(t *testing.T) {
+	// Setup
+	_, sessions, git, resolver, _ := newConflictResolverTest(t)
+	sessions.WaitFunc = func(_ context.Context, _ string) error {
+		git.MergeConflictFiles = nil
+		return nil
+	}
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Bump deps", Status: domain.StatusDone}
+	handler := NewConflictHandler(repo, sessions, git, &testutil.MockClock{}).WithResolver(resolver)
+
+	// Execute
+	out, err := handler.CheckAndHandle(context.Background(), ConflictCheckInput{TaskID: 1, Branch: "crew-1", BaseBranch: "main"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, out.Message)
+	assert.Equal(t, domain.StatusDone, repo.Tasks[1].Status)
+	assert.False(t, sessions.SendCalled)
+}
+
+func TestConflictHandler_CheckAndHandle_ResolverFails(t *testing.T) {
+	// Setup
+	configLoader, sessions, git, resolver, _ := newConflictResolverTest(t)
+	configLoader.Config.AgentsConfig.DefaultConflictResolver = "missing"
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Bump deps", Status: domain.StatusDone}
+	handler := NewConflictHandler(repo, sessions, git, &testutil.MockClock{}).WithResolver(resolver)
+
+	// Execute
+	out, err := handler.CheckAndHandle(context.Background(), ConflictCheckInput{TaskID: 1, Branch: "crew-1", BaseBranch: "main"})
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrMergeConflict)
+	assert.Contains(t, out.Message, "Conflict resolver failed")
+	assert.Contains(t, out.Message, "go.sum")
+	assert.Equal(t, domain.StatusInProgress, repo.Tasks[1].Status)
+}
@@ -112,10 +112,16 @@ func (m *mockGit) UserEmail() (string, error)                   { return "", nil
 func (m *mockGit) BranchExists(_ string) (bool, error)          { return true, nil }
 func (m *mockGit) HasUncommittedChanges(_ string) (bool, error) { return false, nil }
 func (m *mockGit) HasMergeConflict(_, _ string) (bool, error)   { return false, nil }
-func (m *mockGit) Merge(_ string, _ bool) error                 { return nil }
+func (m *mockGit) Merge(_ string, _ domain.MergeOptions) error  { return nil }
 func (m *mockGit) DeleteBranch(_ string, _ bool) error          { return nil }
 func (m *mockGit) ListBranches() ([]string, error)              { return nil, nil }
 func (m *mockGit) GetDefaultBranch() (string, error)            { return "main", nil }
+func (m *mockGit) RevParse(rev string) (string, error)          { return rev, nil }
+func (m *mockGit) UpdateBranch(_, _ string, _ domain.MergeStrategy) ([]string, error) {
+	return nil, nil
+}
+func (m *mockGit) BranchDiff(_, _ string, _ []string) (string, error) { return "", nil }
+func (m *mockGit) RebaseOnto(_, _, _ string) ([]string, error)        { return nil, nil }
 
 // mockClock is a test double for domain.Clock.
 type mockClock struct {
@@ -141,7 +147,7 @@ func TestConflictHandler_CheckAndHandle_NoConflict(t *testing.T) {
 	handler := NewConflictHandler(tasks, sessions, git, clock)
 
 	// Execute
-	out, err := handler.CheckAndHandle(ConflictCheckInput{
+	out, err := handler.CheckAndHandle(context.Background(), ConflictCheckInput{
 		TaskID:     1,
 		Branch:     "crew-1",
 		BaseBranch: "main",
@@ -171,7 +177,7 @@ func TestConflictHandler_CheckAndHandle_WithConflict(t *testing.T) {
 	handler := NewConflictHandler(tasks, sessions, git, clock)
 
 	// Execute
-	out, err := handler.CheckAndHandle(ConflictCheckInput{
+	out, err := handler.CheckAndHandle(context.Background(), ConflictCheckInput{
 		TaskID:     1,
 		Branch:     "crew-1",
 		BaseBranch: "main",
@@ -217,7 +223,7 @@ func TestConflictHandler_CheckAndHandle_SessionNotRunning(t *testing.T) {
 	handler := NewConflictHandler(tasks, sessions, git, clock)
 
 	// Execute
-	out, err := handler.CheckAndHandle(ConflictCheckInput{
+	out, err := handler.CheckAndHandle(context.Background(), ConflictCheckInput{
 		TaskID:     1,
 		Branch:     "crew-1",
 		BaseBranch: "main",
@@ -250,7 +256,7 @@ func TestConflictHandler_CheckAndHandle_TaskNotFound(t *testing.T) {
 	handler := NewConflictHandler(tasks, sessions, git, clock)
 
 	// Execute
-	out, err := handler.CheckAndHandle(ConflictCheckInput{
+	out, err := handler.CheckAndHandle(context.Background(), ConflictCheckInput{
 		TaskID:     999,
 		Branch:     "crew-999",
 		BaseBranch: "main",
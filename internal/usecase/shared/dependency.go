@@ -0,0 +1,90 @@
+package shared
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// UnfinishedDependencies returns the IDs of the task's dependencies that have not been merged.
+// Dependencies that no longer exist are treated as unfinished.
+func UnfinishedDependencies(repo domain.TaskRepository, task *domain.Task) ([]int, error) {
+	var unfinished []int
+	for _, depID := range task.DependsOn {
+		dep, err := repo.Get(depID)
+		if err != nil {
+			return nil, fmt.Errorf("get dependency #%d: %w", depID, err)
+		}
+		if dep == nil || dep.Status != domain.StatusMerged {
+			unfinished = append(unfinished, depID)
+		}
+	}
+	return unfinished, nil
+}
+
+// SyncDependencyBlock recomputes the dependency block reason of a task.
+// The task is modified in place but not saved. Returns true if BlockReason changed.
+func SyncDependencyBlock(repo domain.TaskRepository, task *domain.Task) (bool, error) {
+	unfinished, err := UnfinishedDependencies(repo, task)
+	if err != nil {
+		return false, err
+	}
+	return task.ApplyDependencyBlock(unfinished), nil
+}
+
+// SyncDependents recomputes the dependency block of every task that depends on taskID
+// and saves the ones that changed. Returns the IDs of tasks that became unblocked.
+func SyncDependents(repo domain.TaskRepository, taskID int) ([]int, error) {
+	tasks, err := repo.List(domain.TaskFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("list tasks: %w", err)
+	}
+
+	var unblocked []int
+	for _, t := range tasks {
+		if t.Status.IsTerminal() || !slices.Contains(t.DependsOn, taskID) {
+			continue
+		}
+		changed, err := SyncDependencyBlock(repo, t)
+		if err != nil {
+			return unblocked, err
+		}
+		if !changed {
+			continue
+		}
+		if err := repo.Save(t); err != nil {
+			return unblocked, fmt.Errorf("save task #%d: %w", t.ID, err)
+		}
+		if !t.IsBlocked() {
+			unblocked = append(unblocked, t.ID)
+		}
+	}
+	return unblocked, nil
+}
+
+// ValidateDependencies checks that every dependency exists and that the
+// resulting graph has no cycles.
+func ValidateDependencies(repo domain.TaskRepository, taskID int, dependsOn []int) error {
+	if len(dependsOn) == 0 {
+		return nil
+	}
+
+	tasks, err := repo.List(domain.TaskFilter{})
+	if err != nil {
+		return fmt.Errorf("list tasks: %w", err)
+	}
+
+	graph := make(map[int][]int, len(tasks))
+	for _, t := range tasks {
+		graph[t.ID] = t.DependsOn
+	}
+
+	for _, depID := range dependsOn {
+		if _, ok := graph[depID]; !ok && depID != taskID {
+			return fmt.Errorf("%w: #%d", domain.ErrDependencyNotFound, depID)
+		}
+	}
+
+	return domain.CheckDependencyCycle(taskID, dependsOn, graph)
+}
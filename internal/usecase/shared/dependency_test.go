@@ -0,0 +1,71 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnfinishedDependencies(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Status: domain.StatusMerged}
+	repo.Tasks[2] = &domain.Task{ID: 2, Status: domain.StatusDone}
+	repo.Tasks[3] = &domain.Task{ID: 3, Status: domain.StatusClosed}
+
+	task := &domain.Task{ID: 4, DependsOn: []int{1, 2, 3, 99}}
+
+	unfinished, err := UnfinishedDependencies(repo, task)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 3, 99}, unfinished)
+}
+
+func TestSyncDependents(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Status: domain.StatusMerged}
+	repo.Tasks[2] = &domain.Task{ID: 2, Status: domain.StatusTodo}
+	// Depends only on #1: becomes unblocked
+	repo.Tasks[3] = &domain.Task{ID: 3, Status: domain.StatusTodo, DependsOn: []int{1}, BlockReason: "Depends on #1"}
+	// Depends on #1 and #2: still blocked by #2
+	repo.Tasks[4] = &domain.Task{ID: 4, Status: domain.StatusTodo, DependsOn: []int{1, 2}, BlockReason: "Depends on #1, #2"}
+	// Manual block reason is preserved
+	repo.Tasks[5] = &domain.Task{ID: 5, Status: domain.StatusTodo, DependsOn: []int{1}, BlockReason: "Waiting for design"}
+	// Unrelated task
+	repo.Tasks[6] = &domain.Task{ID: 6, Status: domain.StatusTodo, DependsOn: []int{2}, BlockReason: "Depends on #2"}
+
+	unblocked, err := SyncDependents(repo, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int{3}, unblocked)
+
+	assert.Empty(t, repo.Tasks[3].BlockReason)
+	assert.Equal(t, "Depends on #2", repo.Tasks[4].BlockReason)
+	assert.Equal(t, "Waiting for design", repo.Tasks[5].BlockReason)
+	assert.Equal(t, "Depends on #2", repo.Tasks[6].BlockReason)
+}
+
+func TestValidateDependencies(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1}
+	repo.Tasks[2] = &domain.Task{ID: 2, DependsOn: []int{1}}
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, ValidateDependencies(repo, 3, []int{1, 2}))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		err := ValidateDependencies(repo, 3, []int{42})
+		assert.ErrorIs(t, err, domain.ErrDependencyNotFound)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		err := ValidateDependencies(repo, 1, []int{2})
+		assert.ErrorIs(t, err, domain.ErrDependencyCycle)
+	})
+
+	t.Run("self", func(t *testing.T) {
+		err := ValidateDependencies(repo, 1, []int{1})
+		assert.ErrorIs(t, err, domain.ErrDependencyCycle)
+	})
+}
@@ -0,0 +1,186 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// hookTimeout bounds how long a single hook command may run.
+const hookTimeout = 2 * time.Minute
+
+// Ensure HookRunner implements domain.EventHandler interface.
+var _ domain.EventHandler = (*HookRunner)(nil)
+
+// HookLauncher starts a rendered hook command without waiting for it to finish.
+// The container launches hooks in a detached crew process that runs them with Exec.
+type HookLauncher interface {
+	LaunchHook(taskID int, hook domain.Hook, command, dir string) error
+}
+
+// HookRunner runs the [hooks] commands for task lifecycle changes.
+// Hooks are best-effort: failures are logged and never returned to the caller.
+// Fields are ordered to minimize memory padding.
+type HookRunner struct {
+	config    domain.ConfigLoader
+	worktrees domain.WorktreeManager
+	executor  domain.CommandExecutor
+	logger    domain.Logger
+	launcher  HookLauncher
+	repoRoot  string
+	gitDir    string
+}
+
+// NewHookRunner creates a new HookRunner.
+func NewHookRunner(
+	config domain.ConfigLoader,
+	worktrees domain.WorktreeManager,
+	executor domain.CommandExecutor,
+	logger domain.Logger,
+	repoRoot string,
+	gitDir string,
+) *HookRunner {
+	return &HookRunner{
+		config:    config,
+		worktrees: worktrees,
+		executor:  executor,
+		logger:    logger,
+		repoRoot:  repoRoot,
+		gitDir:    gitDir,
+	}
+}
+
+// WithLauncher makes state change hooks run through launcher, so that saving
+// a task never waits for them. Without a launcher they run inline.
+func (r *HookRunner) WithLauncher(launcher HookLauncher) *HookRunner {
+	r.launcher = launcher
+	return r
+}
+
+// HandleEvents runs the hooks triggered by the recorded events of task.
+// Commands are rendered now, while the worktree and event data are current,
+// and started through the launcher if there is one.
+func (r *HookRunner) HandleEvents(task *domain.Task, events []domain.Event) {
+	if task == nil || len(events) == 0 {
+		return
+	}
+	hooks := r.load()
+	if hooks == nil {
+		return
+	}
+	for _, event := range events {
+		hook, ok := domain.HookForEvent(event)
+		if !ok || hooks.Command(hook) == "" {
+			continue
+		}
+		rendered, ok := r.render(hooks.Command(hook), hook, task, event)
+		if !ok {
+			continue
+		}
+		if r.launcher == nil {
+			r.Exec(task.ID, hook, rendered, r.repoRoot)
+			continue
+		}
+		if err := r.launcher.LaunchHook(task.ID, hook, rendered, r.repoRoot); err != nil {
+			r.warn(task.ID, fmt.Sprintf("hooks.%s: launch: %v", hook, err))
+		}
+	}
+}
+
+// Run runs the hook for task from dir (the repository root if empty) and waits for it.
+// It is used for hooks that are not triggered by a state change, such as on_complete.
+func (r *HookRunner) Run(hook domain.Hook, task *domain.Task, dir string) {
+	if task == nil {
+		return
+	}
+	hooks := r.load()
+	if hooks == nil || hooks.Command(hook) == "" {
+		return
+	}
+	if dir == "" {
+		dir = r.repoRoot
+	}
+	if rendered, ok := r.render(hooks.Command(hook), hook, task, domain.Event{}); ok {
+		r.Exec(task.ID, hook, rendered, dir)
+	}
+}
+
+// load returns the configured hooks, or nil if there is no configuration.
+func (r *HookRunner) load() *domain.HooksConfig {
+	if r.config == nil || r.executor == nil {
+		return nil
+	}
+	cfg, err := r.config.Load()
+	if err != nil || cfg == nil {
+		return nil
+	}
+	return &cfg.Hooks
+}
+
+// Exec runs a rendered hook command from dir and waits for it (up to the hook timeout).
+// Failures are logged.
+func (r *HookRunner) Exec(taskID int, hook domain.Hook, command, dir string) {
+	if r.executor == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), hookTimeout)
+	defer cancel()
+
+	var output bytes.Buffer
+	if err := r.executor.ExecuteWithContext(ctx, domain.NewShellCommand(command, dir), &output, &output); err != nil {
+		r.warn(taskID, fmt.Sprintf("hooks.%s failed: %v: %s", hook, err, strings.TrimSpace(output.String())))
+		return
+	}
+	if r.logger != nil {
+		r.logger.Info(taskID, "hooks", fmt.Sprintf("ran hooks.%s", hook))
+	}
+}
+
+// render renders the hook command for task, logging template errors.
+func (r *HookRunner) render(command string, hook domain.Hook, task *domain.Task, event domain.Event) (string, bool) {
+	rendered, err := domain.RenderHookCommand(command, r.hookData(hook, task, event))
+	if err != nil {
+		r.warn(task.ID, fmt.Sprintf("hooks.%s: render command: %v", hook, err))
+		return "", false
+	}
+	return rendered, true
+}
+
+func (r *HookRunner) hookData(hook domain.Hook, task *domain.Task, event domain.Event) domain.HookData {
+	branch := domain.BranchName(task.ID, task.Issue)
+	var worktree string
+	if r.worktrees != nil {
+		if path, err := r.worktrees.Resolve(branch); err == nil {
+			worktree = path
+		}
+	}
+	return domain.HookData{
+		GitDir:      r.gitDir,
+		RepoRoot:    r.repoRoot,
+		Worktree:    worktree,
+		Title:       task.Title,
+		Description: task.Description,
+		Branch:      branch,
+		BaseBranch:  task.BaseBranch,
+		Status:      task.Status,
+		Substate:    task.ExecutionSubstate,
+		Agent:       task.Agent,
+		Hook:        hook,
+		Actor:       event.Actor,
+		Old:         event.Old,
+		New:         event.New,
+		Issue:       task.Issue,
+		TaskID:      task.ID,
+	}
+}
+
+func (r *HookRunner) warn(taskID int, msg string) {
+	if r.logger != nil {
+		r.logger.Warn(taskID, "hooks", msg)
+	}
+}
@@ -0,0 +1,131 @@
+package shared
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHookRunner(hooks domain.HooksConfig) (*HookRunner, *testutil.MockCommandExecutor, *testutil.MockLogger) {
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config.Hooks = hooks
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.ResolvePath = "/repo/.crew/worktrees/1"
+	executor := testutil.NewMockCommandExecutor()
+	logger := testutil.NewMockLogger()
+	return NewHookRunner(configLoader, worktrees, executor, logger, "/repo", "/repo/.git"), executor, logger
+}
+
+func TestHookRunner_HandleEvents_RunsMatchingHook(t *testing.T) {
+	// Setup
+	runner, executor, _ := newTestHookRunner(domain.HooksConfig{OnError: "notify {{.TaskID}} {{.Old}}->{{.New}} {{.Worktree}}"})
+	task := &domain.Task{ID: 1, Title: "Task", Status: domain.StatusError}
+
+	// Execute
+	runner.HandleEvents(task, []domain.Event{
+		{Type: domain.EventStatus, Old: "in_progress", New: "error", TaskID: 1},
+		{Type: domain.EventSessionEnd, Old: "crew-1", TaskID: 1},
+	})
+
+	// Assert
+	require.True(t, executor.ExecuteWithContextCalled)
+	assert.Equal(t, "/repo", executor.ExecutedCmd.Dir)
+	assert.Equal(t, []string{"-c", "notify 1 in_progress->error /repo/.crew/worktrees/1"}, executor.ExecutedCmd.Args)
+}
+
+// recordingLauncher records launched hook commands without running them.
+type recordingLauncher struct {
+	err      error
+	commands []string
+	dirs     []string
+}
+
+func (l *recordingLauncher) LaunchHook(_ int, _ domain.Hook, command, dir string) error {
+	l.commands = append(l.commands, command)
+	l.dirs = append(l.dirs, dir)
+	return l.err
+}
+
+func TestHookRunner_HandleEvents_Launcher(t *testing.T) {
+	// Setup
+	runner, executor, _ := newTestHookRunner(domain.HooksConfig{OnError: "notify {{.TaskID}} {{.New}} {{.Worktree}}"})
+	launcher := &recordingLauncher{}
+	runner.WithLauncher(launcher)
+
+	// Execute
+	runner.HandleEvents(&domain.Task{ID: 1}, []domain.Event{{Type: domain.EventStatus, Old: "in_progress", New: "error", TaskID: 1}})
+
+	// Assert: the rendered command is launched, not run inline
+	assert.False(t, executor.ExecuteWithContextCalled)
+	assert.Equal(t, []string{"notify 1 error /repo/.crew/worktrees/1"}, launcher.commands)
+	assert.Equal(t, []string{"/repo"}, launcher.dirs)
+}
+
+func TestHookRunner_HandleEvents_LaunchFailureIsLogged(t *testing.T) {
+	// Setup
+	runner, _, logger := newTestHookRunner(domain.HooksConfig{OnDone: "echo done"})
+	runner.WithLauncher(&recordingLauncher{err: errors.New("no such file")})
+
+	// Execute
+	runner.HandleEvents(&domain.Task{ID: 1}, []domain.Event{{Type: domain.EventStatus, Old: "in_progress", New: "done", TaskID: 1}})
+
+	// Assert
+	require.Len(t, logger.Entries, 1)
+	assert.Equal(t, "WARN", logger.Entries[0].Level)
+	assert.Equal(t, "hooks.on_done: launch: no such file", logger.Entries[0].Msg)
+}
+
+func TestHookRunner_HandleEvents_NoHookConfigured(t *testing.T) {
+	// Setup
+	runner, executor, _ := newTestHookRunner(domain.HooksConfig{OnDone: "echo done"})
+
+	// Execute
+	runner.HandleEvents(&domain.Task{ID: 1}, []domain.Event{{Type: domain.EventStatus, Old: "in_progress", New: "error", TaskID: 1}})
+
+	// Assert
+	assert.False(t, executor.ExecuteWithContextCalled)
+}
+
+func TestHookRunner_HandleEvents_FailureIsLogged(t *testing.T) {
+	// Setup
+	runner, executor, logger := newTestHookRunner(domain.HooksConfig{OnDone: "exit 1"})
+	executor.ExecuteWithContextErr = errors.New("exit status 1")
+	executor.StderrOutput = []byte("boom\n")
+
+	// Execute
+	runner.HandleEvents(&domain.Task{ID: 1}, []domain.Event{{Type: domain.EventStatus, Old: "in_progress", New: "done", TaskID: 1}})
+
+	// Assert
+	require.Len(t, logger.Entries, 1)
+	assert.Equal(t, "WARN", logger.Entries[0].Level)
+	assert.Equal(t, "hooks.on_done failed: exit status 1: boom", logger.Entries[0].Msg)
+}
+
+func TestHookRunner_Run_InDir(t *testing.T) {
+	// Setup
+	runner, executor, _ := newTestHookRunner(domain.HooksConfig{OnComplete: "gofmt -w ."})
+
+	// Execute
+	runner.Run(domain.HookOnComplete, &domain.Task{ID: 1}, "/repo/.crew/worktrees/1")
+
+	// Assert
+	require.True(t, executor.ExecuteWithContextCalled)
+	assert.Equal(t, "/repo/.crew/worktrees/1", executor.ExecutedCmd.Dir)
+}
+
+func TestHookRunner_NilConfig(t *testing.T) {
+	// Setup
+	executor := testutil.NewMockCommandExecutor()
+	runner := NewHookRunner(nil, nil, executor, nil, "/repo", "/repo/.git")
+
+	// Execute
+	runner.HandleEvents(&domain.Task{ID: 1}, []domain.Event{{Type: domain.EventStatus, New: "done", TaskID: 1}})
+	runner.Run(domain.HookOnComplete, &domain.Task{ID: 1}, "")
+
+	// Assert
+	assert.False(t, executor.ExecuteWithContextCalled)
+}
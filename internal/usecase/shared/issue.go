@@ -0,0 +1,40 @@
+package shared
+
+import (
+	"fmt"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// IssueTaskIndex maps issue numbers to the IDs of tasks linked to them.
+// When several tasks are linked to the same issue, the lowest ID is kept.
+func IssueTaskIndex(repo domain.TaskRepository) (map[int]int, error) {
+	return issueTaskIndex(repo, false)
+}
+
+// ActiveIssueTaskIndex is like IssueTaskIndex, but only top-level tasks that are
+// not closed or merged claim an issue. Sub-tasks and finished tasks are left out.
+func ActiveIssueTaskIndex(repo domain.TaskRepository) (map[int]int, error) {
+	return issueTaskIndex(repo, true)
+}
+
+func issueTaskIndex(repo domain.TaskRepository, activeOnly bool) (map[int]int, error) {
+	tasks, err := repo.List(domain.TaskFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("list tasks: %w", err)
+	}
+
+	index := make(map[int]int)
+	for _, t := range tasks {
+		if t.Issue <= 0 {
+			continue
+		}
+		if activeOnly && (t.Status.IsTerminal() || t.ParentID != nil) {
+			continue
+		}
+		if id, ok := index[t.Issue]; !ok || t.ID < id {
+			index[t.Issue] = t.ID
+		}
+	}
+	return index, nil
+}
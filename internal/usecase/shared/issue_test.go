@@ -0,0 +1,49 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueTaskIndex(t *testing.T) {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Unlinked"}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "A", Issue: 10}
+	repo.Tasks[3] = &domain.Task{ID: 3, Title: "B", Issue: 11}
+	repo.Tasks[4] = &domain.Task{ID: 4, Title: "C", Issue: 10}
+
+	index, err := IssueTaskIndex(repo)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[int]int{10: 2, 11: 3}, index)
+}
+
+func TestActiveIssueTaskIndex(t *testing.T) {
+	parentID := 1
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Active", Issue: 10, Status: domain.StatusInProgress}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Sub-task", Issue: 11, Status: domain.StatusTodo, ParentID: &parentID}
+	repo.Tasks[3] = &domain.Task{ID: 3, Title: "Closed", Issue: 12, Status: domain.StatusClosed}
+	repo.Tasks[4] = &domain.Task{ID: 4, Title: "Merged", Issue: 13, Status: domain.StatusMerged}
+	repo.Tasks[5] = &domain.Task{ID: 5, Title: "Done", Issue: 14, Status: domain.StatusDone}
+
+	index, err := ActiveIssueTaskIndex(repo)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[int]int{10: 1, 14: 5}, index)
+}
+
+func TestIssueTaskIndex_ListError(t *testing.T) {
+	repo := &testutil.MockTaskRepositoryWithListError{
+		MockTaskRepository: testutil.NewMockTaskRepository(),
+		ListErr:            assert.AnError,
+	}
+
+	_, err := IssueTaskIndex(repo)
+
+	assert.ErrorIs(t, err, assert.AnError)
+}
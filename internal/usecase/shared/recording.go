@@ -0,0 +1,48 @@
+package shared
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// SessionRecordings returns the paths of the recorded runs of a session, oldest first.
+// A recording at the base path, made before runs were kept apart, counts as the oldest run.
+func SessionRecordings(crewDir, sessionName string) []string {
+	base := domain.RecordingPath(crewDir, sessionName)
+
+	var paths []string
+	if _, err := os.Stat(base); err == nil {
+		paths = append(paths, base)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(base))
+	if err != nil {
+		return paths
+	}
+	var runs []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(filepath.Dir(base), entry.Name())
+		if _, ok := domain.ParseRecordingRunPath(base, path); ok {
+			runs = append(runs, path)
+		}
+	}
+	// Run names sort by start time
+	sort.Strings(runs)
+	return append(paths, runs...)
+}
+
+// LatestSessionRecording returns the path of the most recent recorded run of a session,
+// or false if the session has no recording.
+func LatestSessionRecording(crewDir, sessionName string) (string, bool) {
+	paths := SessionRecordings(crewDir, sessionName)
+	if len(paths) == 0 {
+		return "", false
+	}
+	return paths[len(paths)-1], true
+}
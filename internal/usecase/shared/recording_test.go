@@ -0,0 +1,46 @@
+package shared_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionRecordings(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	base := domain.RecordingPath(crewDir, "crew-1")
+	start := time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC)
+	later := domain.RecordingRunPath(base, start.Add(time.Hour))
+	earlier := domain.RecordingRunPath(base, start)
+	other := domain.RecordingRunPath(domain.RecordingPath(crewDir, "crew-10"), start)
+	require.NoError(t, os.MkdirAll(filepath.Dir(base), 0o755))
+	for _, path := range []string{base, later, earlier, other, domain.SessionLogPath(crewDir, "crew-1")} {
+		require.NoError(t, os.WriteFile(path, nil, 0o644))
+	}
+
+	// Execute
+	paths := shared.SessionRecordings(crewDir, "crew-1")
+
+	// Assert
+	assert.Equal(t, []string{base, earlier, later}, paths)
+	latest, ok := shared.LatestSessionRecording(crewDir, "crew-1")
+	assert.True(t, ok)
+	assert.Equal(t, later, latest)
+}
+
+func TestSessionRecordings_None(t *testing.T) {
+	// Execute
+	paths := shared.SessionRecordings(t.TempDir(), "crew-1")
+
+	// Assert
+	assert.Empty(t, paths)
+	_, ok := shared.LatestSessionRecording(t.TempDir(), "crew-1")
+	assert.False(t, ok)
+}
@@ -23,11 +23,12 @@ type ReviewCommandDeps struct {
 // Fields are ordered to minimize memory padding.
 type ReviewCommandInput struct {
 	Task           *domain.Task
-	Agent          string // Agent name (optional, uses default reviewer if empty)
-	Model          string // Model name override (optional, uses agent default if empty)
-	Message        string // Additional instructions for the reviewer (optional)
-	PreviousReview string // Previous review result (empty on first attempt)
-	ReviewAttempt  int    // Current review attempt number (1 = first review)
+	PreviousReview *domain.ReviewFeedback // Previous review text and findings (nil on first attempt)
+	Agent          string                 // Agent name (optional, uses default reviewer if empty)
+	Model          string                 // Model name override (optional, uses agent default if empty)
+	Message        string                 // Additional instructions for the reviewer (optional)
+	ResultFile     string                 // Path where the reviewer writes its JSON verdict (optional)
+	ReviewAttempt  int                    // Current review attempt number (1 = first review)
 }
 
 // ReviewCommandOutput contains prepared review command data.
@@ -78,18 +79,19 @@ func PrepareReviewCommand(deps ReviewCommandDeps, in ReviewCommandInput) (*Revie
 		reviewAttempt = 1
 	}
 	cmdData := domain.CommandData{
-		GitDir:         deps.RepoRoot + "/.git",
-		RepoRoot:       deps.RepoRoot,
-		Worktree:       wtPath,
-		Title:          in.Task.Title,
-		Description:    in.Task.Description,
-		Branch:         branch,
-		PreviousReview: in.PreviousReview,
-		Issue:          in.Task.Issue,
-		TaskID:         in.Task.ID,
-		ReviewAttempt:  reviewAttempt,
-		IsFollowUp:     reviewAttempt > 1,
-		Model:          model,
+		GitDir:           deps.RepoRoot + "/.git",
+		RepoRoot:         deps.RepoRoot,
+		Worktree:         wtPath,
+		Title:            in.Task.Title,
+		Description:      in.Task.Description,
+		Branch:           branch,
+		PreviousReview:   in.PreviousReview,
+		ReviewResultFile: in.ResultFile,
+		Issue:            in.Task.Issue,
+		TaskID:           in.Task.ID,
+		ReviewAttempt:    reviewAttempt,
+		IsFollowUp:       reviewAttempt > 1,
+		Model:            model,
 	}
 
 	userPrompt := in.Message
@@ -142,10 +144,14 @@ type ReviewOutput struct {
 // This should be called after the reviewer has added a comment via crew comment.
 func UpdateReviewMetadata(clock domain.Clock, task *domain.Task, reviewText string) {
 	isLGTM := strings.HasPrefix(strings.TrimSpace(reviewText), domain.ReviewLGTMPrefix)
-	now := clock.Now()
+	RecordReviewOutcome(clock, task, isLGTM)
+}
 
+// RecordReviewOutcome counts a review attempt and records whether it passed.
+// Multi-reviewer reviews record the outcome of the review policy.
+func RecordReviewOutcome(clock domain.Clock, task *domain.Task, isLGTM bool) {
 	task.ReviewCount++
-	task.LastReviewAt = now
+	task.LastReviewAt = clock.Now()
 	task.LastReviewIsLGTM = &isLGTM
 }
 
@@ -0,0 +1,106 @@
+package shared
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// usageReadMaxBytes bounds how much of each session output source is parsed.
+// Agents print their usage summary at the end, so only the tail is read.
+const usageReadMaxBytes = 1 << 20
+
+// SessionUsage parses the usage summary an agent printed in a session.
+// Both the session log (from logOffset on) and the latest session recording are
+// searched, since only the recording captures the stdout of worker sessions.
+func SessionUsage(crewDir, sessionName string, logOffset int64) (domain.Usage, bool) {
+	var output strings.Builder
+	output.WriteString(readLogTail(domain.SessionLogPath(crewDir, sessionName), logOffset))
+	output.WriteByte('\n')
+	if path, ok := LatestSessionRecording(crewDir, sessionName); ok {
+		output.WriteString(readRecordingTail(path))
+	}
+	return domain.ParseUsage(output.String())
+}
+
+// RecordSessionUsage attaches the usage of a finished session to its task.
+// It returns false if the agent printed no usage summary.
+func RecordSessionUsage(tasks domain.TaskRepository, clock domain.Clock, crewDir string, taskID int, agent, sessionName string, logOffset int64) (bool, error) {
+	usage, ok := SessionUsage(crewDir, sessionName, logOffset)
+	if !ok {
+		return false, nil
+	}
+	record := domain.UsageRecord{
+		Time:    clock.Now(),
+		Agent:   agent,
+		Session: sessionName,
+		Usage:   usage,
+	}
+	if err := tasks.AddComment(taskID, record.Comment()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// readLogTail returns up to usageReadMaxBytes from the end of the log, starting at offset.
+func readLogTail(path string, offset int64) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = file.Close() }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return ""
+	}
+	if start := info.Size() - usageReadMaxBytes; start > offset {
+		offset = start
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return ""
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// readRecordingTail returns up to usageReadMaxBytes of the terminal output at the end of a recording.
+func readRecordingTail(path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = file.Close() }()
+
+	reader, err := domain.NewRecordingReader(file)
+	if err != nil {
+		return ""
+	}
+	var output []byte
+	for {
+		event, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return string(output)
+		}
+		if event.Type != domain.RecordingOutput {
+			continue
+		}
+		output = append(output, event.Data...)
+		if len(output) > 2*usageReadMaxBytes {
+			output = append(output[:0], output[len(output)-usageReadMaxBytes:]...)
+		}
+	}
+	if len(output) > usageReadMaxBytes {
+		output = output[len(output)-usageReadMaxBytes:]
+	}
+	return string(output)
+}
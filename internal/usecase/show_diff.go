@@ -18,8 +18,9 @@ const DefaultDiffCommand = "git diff {{.BaseBranch}}...HEAD{{if .Args}} {{.Args}
 // ShowDiffInput contains the parameters for showing task diff.
 // Fields are ordered to minimize memory padding.
 type ShowDiffInput struct {
-	Args   []string // Additional diff arguments
-	TaskID int      // Task ID (required)
+	Args       []string // Additional diff arguments
+	TaskID     int      // Task ID (required)
+	NoFindings bool     // Do not interleave findings of the latest review with the diff
 }
 
 // ShowDiffOutput contains the result of showing task diff.
@@ -122,6 +123,20 @@ func (uc *ShowDiff) GetCommand(_ context.Context, in ShowDiffInput) (*domain.Exe
 	}, nil
 }
 
+// ReviewFindings returns the findings of the task's latest review
+// (of every reviewer for a multi-reviewer review).
+func (uc *ShowDiff) ReviewFindings(taskID int) ([]domain.ReviewFinding, error) {
+	comments, err := uc.tasks.GetComments(taskID)
+	if err != nil {
+		return nil, fmt.Errorf("get comments: %w", err)
+	}
+	var findings []domain.ReviewFinding
+	for _, comment := range domain.LatestReviewComments(comments) {
+		findings = append(findings, comment.ReviewFindings()...)
+	}
+	return findings, nil
+}
+
 // Execute displays the diff for a task.
 // Preconditions:
 //   - Task exists
@@ -131,15 +146,30 @@ func (uc *ShowDiff) GetCommand(_ context.Context, in ShowDiffInput) (*domain.Exe
 //   - Resolve task's worktree path
 //   - Execute diff using diff.command from config
 //   - Expand {{.Args}} with additional arguments
+//   - Interleave findings of the latest review with the diff (unless NoFindings)
 func (uc *ShowDiff) Execute(ctx context.Context, in ShowDiffInput) (*ShowDiffOutput, error) {
 	execCmd, err := uc.GetCommand(ctx, in)
 	if err != nil {
 		return nil, err
 	}
 
+	var findings []domain.ReviewFinding
+	if !in.NoFindings {
+		findings, err = uc.ReviewFindings(in.TaskID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Execute the diff command via CommandExecutor
 	// Ignore exit code as diff can return non-zero when there are differences
-	_ = uc.executor.ExecuteWithContext(ctx, execCmd, uc.stdout, uc.stderr)
+	if len(findings) == 0 {
+		_ = uc.executor.ExecuteWithContext(ctx, execCmd, uc.stdout, uc.stderr)
+	} else {
+		var diff bytes.Buffer
+		_ = uc.executor.ExecuteWithContext(ctx, execCmd, &diff, uc.stderr)
+		_, _ = io.WriteString(uc.stdout, domain.AnnotateDiff(diff.String(), findings))
+	}
 
 	return &ShowDiffOutput{
 		WorktreePath: execCmd.Dir,
@@ -408,3 +408,50 @@ func TestShowDiff_Execute_WithCustomDiffCommand(t *testing.T) {
 	// Verify custom diff.command was used
 	assert.Contains(t, executor.ExecutedCmd.Args[1], "my-custom-diff-viewer")
 }
+
+func TestShowDiff_Execute_InterleavesReviewFindings(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Reviewed task", Status: domain.StatusInProgress}
+	repo.Comments[1] = []domain.Comment{
+		{Author: domain.ReviewCommentAuthor, Text: "old", Findings: []domain.ReviewFinding{{File: "a.go", Line: 1, Severity: domain.ReviewSeverityNit, Message: "Outdated"}}},
+		{Author: domain.ReviewCommentAuthor, Text: "new", Findings: []domain.ReviewFinding{{File: "a.go", Line: 1, Severity: domain.ReviewSeverityBlocking, Message: "Check the error"}}},
+	}
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.ResolvePath = "/tmp/worktree"
+	executor := testutil.NewMockCommandExecutor()
+	executor.ExecuteOutput = []byte("--- a/a.go\n+++ b/a.go\n@@ -1 +1 @@\n-x\n+y\n")
+
+	var stdout, stderr bytes.Buffer
+	uc := NewShowDiff(repo, worktrees, &testutil.MockGit{}, testutil.NewMockConfigLoader(), executor, &stdout, &stderr)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), ShowDiffInput{TaskID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "--- a/a.go\n+++ b/a.go\n@@ -1 +1 @@\n-x\n+y\n>> [BLOCKING] Check the error\n", stdout.String())
+}
+
+func TestShowDiff_Execute_NoFindings(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Reviewed task", Status: domain.StatusInProgress}
+	repo.Comments[1] = []domain.Comment{
+		{Author: domain.ReviewCommentAuthor, Text: "review", Findings: []domain.ReviewFinding{{File: "a.go", Line: 1, Severity: domain.ReviewSeverityNit, Message: "Hidden"}}},
+	}
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.ResolvePath = "/tmp/worktree"
+	executor := testutil.NewMockCommandExecutor()
+	executor.ExecuteOutput = []byte("+++ b/a.go\n@@ -1 +1 @@\n-x\n+y\n")
+
+	var stdout, stderr bytes.Buffer
+	uc := NewShowDiff(repo, worktrees, &testutil.MockGit{}, testutil.NewMockConfigLoader(), executor, &stdout, &stderr)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), ShowDiffInput{TaskID: 1, NoFindings: true})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotContains(t, stdout.String(), "Hidden")
+}
@@ -17,9 +17,10 @@ type ShowTaskInput struct {
 
 // ShowTaskOutput contains the result of showing a task.
 type ShowTaskOutput struct {
-	Task     *domain.Task     // The task details
-	Children []*domain.Task   // Direct child tasks
-	Comments []domain.Comment // Comments on the task
+	Task     *domain.Task        // The task details
+	Children []*domain.Task      // Direct child tasks
+	Comments []domain.Comment    // Comments on the task
+	Gates    []domain.GateResult // Latest result of each completion gate (from all comments)
 }
 
 // ShowTask is the use case for displaying task details.
@@ -50,6 +51,7 @@ func (uc *ShowTask) Execute(_ context.Context, in ShowTaskInput) (*ShowTaskOutpu
 	if err != nil {
 		return nil, fmt.Errorf("get comments: %w", err)
 	}
+	gates := domain.LatestGateResults(comments)
 
 	// Filter comments
 	if in.LastReview {
@@ -83,5 +85,6 @@ func (uc *ShowTask) Execute(_ context.Context, in ShowTaskInput) (*ShowTaskOutpu
 		Task:     task,
 		Children: children,
 		Comments: comments,
+		Gates:    gates,
 	}, nil
 }
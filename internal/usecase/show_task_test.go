@@ -293,3 +293,28 @@ func TestShowTask_Execute_WithLastReview_NoReview(t *testing.T) {
 	require.NoError(t, err)
 	assert.Empty(t, out.Comments)
 }
+
+func TestShowTask_Execute_GateResultsIgnoreCommentFilter(t *testing.T) {
+	// Setup
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Test task", Status: domain.StatusInProgress}
+	repo.Comments[1] = []domain.Comment{
+		domain.GateResult{Name: "lint", Status: domain.GateStatusFailed, Required: true}.Comment(),
+		{Text: "Fixed lint", Author: "worker"},
+		domain.GateResult{Name: "lint", Status: domain.GateStatusPassed, Required: true}.Comment(),
+	}
+	uc := NewShowTask(repo)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), ShowTaskInput{
+		TaskID:     1,
+		CommentsBy: "worker",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, out.Comments, 1)
+	require.Len(t, out.Gates, 1)
+	assert.Equal(t, "lint", out.Gates[0].Name)
+	assert.Equal(t, domain.GateStatusPassed, out.Gates[0].Status)
+}
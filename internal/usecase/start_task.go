@@ -24,12 +24,15 @@ type StartTaskInput struct {
 	AdditionalPrompts []string // Additional prompts to append (optional, multiple allowed)
 	TaskID            int      // Task ID to start
 	Continue          bool     // Continue from previous session (adds agent-specific continue args)
+	Restart           bool     // Automatic restart after an error (counts the restart and keeps the start time)
 }
 
 // StartTaskOutput contains the result of starting a task.
 type StartTaskOutput struct {
 	SessionName  string // Name of the tmux session
 	WorktreePath string // Path to the worktree
+	Agent        string // Agent the task was started with
+	RoutingRule  string // Routing rule that selected the agent (empty if given or default)
 }
 
 // StartTask is the use case for starting a task.
@@ -91,6 +94,15 @@ func (uc *StartTask) Execute(ctx context.Context, in StartTaskInput) (*StartTask
 		return nil, fmt.Errorf("%w: %q", domain.ErrTaskBlocked, task.BlockReason)
 	}
 
+	// Check if all dependencies are merged
+	unfinished, err := shared.UnfinishedDependencies(uc.tasks, task)
+	if err != nil {
+		return nil, err
+	}
+	if len(unfinished) > 0 {
+		return nil, fmt.Errorf("%w: %s", domain.ErrUnfinishedDependencies, domain.DependencyBlockReason(unfinished))
+	}
+
 	// Check if session is already running
 	sessionName := domain.SessionName(task.ID)
 	if runningErr := shared.EnsureNoRunningSession(uc.sessions, task.ID); runningErr != nil {
@@ -103,20 +115,27 @@ func (uc *StartTask) Execute(ctx context.Context, in StartTaskInput) (*StartTask
 		return nil, fmt.Errorf("load config: %w", loadErr)
 	}
 
-	// Resolve agent from input or default
+	// Resolve agent from input, routing rules, or default
 	agentName := in.Agent
+	routingRule := ""
 	if agentName == "" {
-		agentName = cfg.AgentsConfig.DefaultWorker
+		decision := cfg.RouteWorker(task)
+		agentName = decision.Agent
+		routingRule = decision.Rule
 	}
 
 	// Get agent configuration from enabled agents only
 	agent, ok := cfg.EnabledAgents()[agentName]
 	if !ok {
+		routedBy := ""
+		if routingRule != "" {
+			routedBy = fmt.Sprintf(" (selected by routing rule %q)", routingRule)
+		}
 		// Check if agent exists but is disabled
 		if _, exists := cfg.Agents[agentName]; exists {
-			return nil, fmt.Errorf("agent %q is disabled: %w", agentName, domain.ErrAgentDisabled)
+			return nil, fmt.Errorf("agent %q%s is disabled: %w", agentName, routedBy, domain.ErrAgentDisabled)
 		}
-		return nil, fmt.Errorf("agent %q: %w", agentName, domain.ErrAgentNotFound)
+		return nil, fmt.Errorf("agent %q%s: %w", agentName, routedBy, domain.ErrAgentNotFound)
 	}
 
 	// Resolve model priority: CLI flag > agent config > builtin default
@@ -131,6 +150,16 @@ func (uc *StartTask) Execute(ctx context.Context, in StartTaskInput) (*StartTask
 	if err != nil {
 		return nil, err
 	}
+	if parentID, stacked := task.StackedOn(); stacked {
+		// The parent's branch only exists once the parent has been started
+		exists, existsErr := uc.git.BranchExists(baseBranch)
+		if existsErr != nil {
+			return nil, fmt.Errorf("check base branch: %w", existsErr)
+		}
+		if !exists {
+			return nil, fmt.Errorf("task #%d is stacked on task #%d, whose branch %s does not exist yet (start task #%d first): %w", task.ID, parentID, baseBranch, parentID, domain.ErrBranchNotFound)
+		}
+	}
 
 	wtPath, err := uc.worktrees.Create(branch, baseBranch)
 	if err != nil {
@@ -176,7 +205,13 @@ func (uc *StartTask) Execute(ctx context.Context, in StartTaskInput) (*StartTask
 	task.Status = domain.StatusInProgress
 	task.Agent = agentName
 	task.Session = sessionName
-	task.Started = uc.clock.Now()
+	task.Stalled = ""
+	if in.Restart {
+		task.RestartCount++
+	} else {
+		task.Started = uc.clock.Now()
+		task.RestartCount = 0
+	}
 	if in.SkipReview != nil {
 		task.SkipReview = in.SkipReview
 	}
@@ -196,6 +231,8 @@ func (uc *StartTask) Execute(ctx context.Context, in StartTaskInput) (*StartTask
 	return &StartTaskOutput{
 		SessionName:  sessionName,
 		WorktreePath: wtPath,
+		Agent:        agentName,
+		RoutingRule:  routingRule,
 	}, nil
 }
 
@@ -223,6 +260,18 @@ func (uc *StartTask) generateScript(task *domain.Task, worktreePath string, agen
 	return scriptPath, nil
 }
 
+// pendingReviewFeedback returns the latest review of the task if it did not pass.
+func (uc *StartTask) pendingReviewFeedback(task *domain.Task) (*domain.ReviewFeedback, error) {
+	if task.LastReviewIsLGTM == nil || *task.LastReviewIsLGTM {
+		return nil, nil
+	}
+	comments, err := uc.tasks.GetComments(task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get comments: %w", err)
+	}
+	return domain.NewReviewFeedback(domain.LatestReviewComments(comments)), nil
+}
+
 // scriptTemplateData holds the data for script template execution.
 // Fields are ordered to minimize memory padding.
 type scriptTemplateData struct {
@@ -270,10 +319,26 @@ func (uc *StartTask) buildScript(task *domain.Task, worktreePath string, agent d
 	case domain.RoleReviewer:
 		defaultSystemPrompt = domain.DefaultReviewerSystemPrompt
 		defaultPrompt = cfg.AgentsConfig.ReviewerPrompt
+	case domain.RoleConflictResolver:
+		defaultSystemPrompt = domain.DefaultConflictResolverSystemPrompt
+		defaultPrompt = cfg.AgentsConfig.ConflictResolverPrompt
 	case domain.RoleWorker:
 		// Already set as defaults
 	}
 
+	// Hand the latest review to a worker restarted after a review that did not pass
+	if agent.Role == domain.RoleWorker || agent.Role == "" {
+		feedback, err := uc.pendingReviewFeedback(task)
+		if err != nil {
+			return "", err
+		}
+		if feedback != nil {
+			cmdData.PreviousReview = feedback
+			cmdData.ReviewAttempt = task.ReviewCount + 1
+			cmdData.IsFollowUp = true
+		}
+	}
+
 	// Render command and prompt using Agent.RenderCommand
 	// Pass shell variable reference as promptOverride - will be expanded at runtime
 	result, err := agent.RenderCommand(cmdData, `"$PROMPT"`, defaultSystemPrompt, defaultPrompt)
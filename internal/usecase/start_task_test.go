@@ -86,6 +86,49 @@ func TestStartTask_Execute_Success(t *testing.T) {
 	assert.Contains(t, script, "crew --help-worker")
 }
 
+func TestStartTask_Execute_ReviewFeedbackOnFollowUp(t *testing.T) {
+	// Setup
+	crewDir := t.TempDir()
+	repoRoot := t.TempDir()
+	worktreeDir := setupTestWorktree(t)
+
+	repo := testutil.NewMockTaskRepository()
+	notLGTM := false
+	repo.Tasks[1] = &domain.Task{
+		ID:               1,
+		Title:            "Test task",
+		Status:           domain.StatusTodo,
+		BaseBranch:       "main",
+		ReviewCount:      1,
+		LastReviewIsLGTM: &notLGTM,
+	}
+	repo.Comments[1] = []domain.Comment{{
+		Author: domain.ReviewCommentAuthor,
+		Text:   "❌ Needs changes",
+		Findings: []domain.ReviewFinding{
+			{File: "main.go", Line: 12, EndLine: 14, Severity: domain.ReviewSeverityBlocking, Message: "Handle the error"},
+			{Severity: domain.ReviewSeverityNit, Message: "Typo in README"},
+		},
+	}}
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.CreatePath = worktreeDir
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	uc := NewStartTask(repo, testutil.NewMockSessionManager(), worktrees, testutil.NewMockConfigLoader(), &testutil.MockGit{}, clock, nil, testutil.NewMockScriptRunner(), crewDir, repoRoot)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), StartTaskInput{TaskID: 1, Agent: "claude"})
+
+	// Assert
+	require.NoError(t, err)
+	scriptContent, err := os.ReadFile(domain.ScriptPath(crewDir, 1))
+	require.NoError(t, err)
+	script := string(scriptContent)
+	assert.Contains(t, script, "## Review Feedback")
+	assert.Contains(t, script, "- [blocking] main.go:12-14 Handle the error")
+	assert.Contains(t, script, "- [nit] Typo in README")
+}
+
 func TestStartTask_Execute_WithAgentSetup(t *testing.T) {
 	// Setup temp directories
 	crewDir := t.TempDir()
@@ -381,6 +424,99 @@ func TestStartTask_Execute_WithDefaultAgent(t *testing.T) {
 	assert.Contains(t, string(scriptContent), "opencode")
 }
 
+func TestStartTask_Execute_WithRoutingRule(t *testing.T) {
+	crewDir := t.TempDir()
+	repoRoot := t.TempDir()
+	worktreeDir := setupTestWorktree(t)
+
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:         1,
+		Title:      "Fix typo in docs",
+		Status:     domain.StatusTodo,
+		BaseBranch: "main",
+		Labels:     []string{"docs"},
+	}
+	sessions := testutil.NewMockSessionManager()
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.CreatePath = worktreeDir
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config.AgentsConfig.DefaultWorker = "claude"
+	configLoader.Config.AgentsConfig.Routing = []domain.RoutingRule{
+		{Name: "refactors", Agent: "codex", Title: "(?i)refactor"},
+		{Name: "docs", Agent: "opencode", Labels: []string{"docs"}},
+	}
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	uc := NewStartTask(repo, sessions, worktrees, configLoader, &testutil.MockGit{}, clock, nil, testutil.NewMockScriptRunner(), crewDir, repoRoot)
+
+	// Execute without specifying agent
+	out, err := uc.Execute(context.Background(), StartTaskInput{TaskID: 1})
+
+	// Assert - the first matching rule picks the agent
+	require.NoError(t, err)
+	assert.Equal(t, "opencode", out.Agent)
+	assert.Equal(t, "docs", out.RoutingRule)
+	assert.Equal(t, "opencode", repo.Tasks[1].Agent)
+}
+
+func TestStartTask_Execute_RoutingRuleUnknownAgent(t *testing.T) {
+	crewDir := t.TempDir()
+
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:         1,
+		Title:      "Test task",
+		Status:     domain.StatusTodo,
+		BaseBranch: "main",
+	}
+	sessions := testutil.NewMockSessionManager()
+	worktrees := testutil.NewMockWorktreeManager()
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config.AgentsConfig.Routing = []domain.RoutingRule{{Name: "all", Agent: "missing-agent"}}
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	repoRoot := t.TempDir()
+	uc := NewStartTask(repo, sessions, worktrees, configLoader, &testutil.MockGit{}, clock, nil, testutil.NewMockScriptRunner(), crewDir, repoRoot)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), StartTaskInput{TaskID: 1})
+
+	// Assert - the error names the rule that selected the agent
+	require.ErrorIs(t, err, domain.ErrAgentNotFound)
+	assert.Contains(t, err.Error(), `agent "missing-agent" (selected by routing rule "all")`)
+}
+
+func TestStartTask_Execute_ExplicitAgentSkipsRouting(t *testing.T) {
+	crewDir := t.TempDir()
+	repoRoot := t.TempDir()
+	worktreeDir := setupTestWorktree(t)
+
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:         1,
+		Title:      "Test task",
+		Status:     domain.StatusTodo,
+		BaseBranch: "main",
+	}
+	sessions := testutil.NewMockSessionManager()
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.CreatePath = worktreeDir
+	configLoader := testutil.NewMockConfigLoader()
+	configLoader.Config.AgentsConfig.Routing = []domain.RoutingRule{{Name: "all", Agent: "missing-agent"}}
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	uc := NewStartTask(repo, sessions, worktrees, configLoader, &testutil.MockGit{}, clock, nil, testutil.NewMockScriptRunner(), crewDir, repoRoot)
+
+	// Execute with an explicit agent
+	out, err := uc.Execute(context.Background(), StartTaskInput{TaskID: 1, Agent: "opencode"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "opencode", out.Agent)
+	assert.Empty(t, out.RoutingRule)
+}
+
 func TestStartTask_Execute_WorktreeCreateError(t *testing.T) {
 	crewDir := t.TempDir()
 
@@ -411,6 +547,33 @@ func TestStartTask_Execute_WorktreeCreateError(t *testing.T) {
 	assert.Contains(t, err.Error(), "create worktree")
 }
 
+func TestStartTask_Execute_StackedParentNotStarted(t *testing.T) {
+	crewDir := t.TempDir()
+
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[2] = &domain.Task{
+		ID:         2,
+		Title:      "Stacked task",
+		Status:     domain.StatusTodo,
+		BaseBranch: "crew-1",
+	}
+	worktrees := testutil.NewMockWorktreeManager()
+	git := &testutil.MockGit{BranchExistsMap: map[string]bool{}}
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	uc := NewStartTask(repo, testutil.NewMockSessionManager(), worktrees, testutil.NewMockConfigLoader(), git, clock, nil, testutil.NewMockScriptRunner(), crewDir, t.TempDir())
+
+	// Execute
+	_, err := uc.Execute(context.Background(), StartTaskInput{
+		TaskID: 2,
+		Agent:  "claude",
+	})
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrBranchNotFound)
+	assert.Contains(t, err.Error(), "start task #1 first")
+	assert.False(t, worktrees.CreateCalled)
+}
+
 func TestStartTask_Execute_SessionStartError(t *testing.T) {
 	crewDir := t.TempDir()
 	repoRoot := t.TempDir()
@@ -1456,3 +1619,118 @@ func TestStartTask_Execute_BlockedTask(t *testing.T) {
 	// Verify worktree was not created
 	assert.False(t, worktrees.CreateCalled)
 }
+
+func TestStartTask_Execute_UnfinishedDependencies(t *testing.T) {
+	crewDir := t.TempDir()
+	repoRoot := t.TempDir()
+
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Merged dependency", Status: domain.StatusMerged}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Pending dependency", Status: domain.StatusDone}
+	repo.Tasks[3] = &domain.Task{
+		ID:         3,
+		Title:      "Dependent task",
+		Status:     domain.StatusTodo,
+		BaseBranch: "main",
+		DependsOn:  []int{1, 2},
+	}
+	sessions := testutil.NewMockSessionManager()
+	worktrees := testutil.NewMockWorktreeManager()
+	configLoader := testutil.NewMockConfigLoader()
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	uc := NewStartTask(repo, sessions, worktrees, configLoader, &testutil.MockGit{}, clock, nil, testutil.NewMockScriptRunner(), crewDir, repoRoot)
+
+	// Execute - should fail even though BlockReason is empty
+	_, err := uc.Execute(context.Background(), StartTaskInput{
+		TaskID: 3,
+		Agent:  "claude",
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrUnfinishedDependencies)
+	assert.Contains(t, err.Error(), "#2")
+	assert.NotContains(t, err.Error(), "#1")
+	assert.False(t, sessions.StartCalled)
+	assert.False(t, worktrees.CreateCalled)
+}
+
+func TestStartTask_Execute_DependenciesMerged(t *testing.T) {
+	crewDir := t.TempDir()
+	repoRoot := t.TempDir()
+	worktreeDir := setupTestWorktree(t)
+
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Merged dependency", Status: domain.StatusMerged}
+	repo.Tasks[2] = &domain.Task{
+		ID:         2,
+		Title:      "Dependent task",
+		Status:     domain.StatusTodo,
+		BaseBranch: "main",
+		DependsOn:  []int{1},
+	}
+	sessions := testutil.NewMockSessionManager()
+	worktrees := testutil.NewMockWorktreeManager()
+	worktrees.CreatePath = worktreeDir
+	configLoader := testutil.NewMockConfigLoader()
+	clock := &testutil.MockClock{NowTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	uc := NewStartTask(repo, sessions, worktrees, configLoader, &testutil.MockGit{}, clock, nil, testutil.NewMockScriptRunner(), crewDir, repoRoot)
+
+	_, err := uc.Execute(context.Background(), StartTaskInput{
+		TaskID: 2,
+		Agent:  "claude",
+	})
+
+	require.NoError(t, err)
+	assert.True(t, sessions.StartCalled)
+	assert.Equal(t, domain.StatusInProgress, repo.Tasks[2].Status)
+}
+
+func TestStartTask_Execute_RestartCount(t *testing.T) {
+	started := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := started.Add(time.Hour)
+	tests := []struct {
+		name         string
+		wantStarted  time.Time
+		wantRestarts int
+		restart      bool
+	}{
+		{name: "automatic restart counts and keeps start time", restart: true, wantStarted: started, wantRestarts: 2},
+		{name: "manual start resets the count", wantStarted: now, wantRestarts: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			repo := testutil.NewMockTaskRepository()
+			repo.Tasks[1] = &domain.Task{
+				ID:           1,
+				Title:        "Test task",
+				Status:       domain.StatusError,
+				BaseBranch:   "main",
+				Started:      started,
+				RestartCount: 1,
+			}
+			worktrees := testutil.NewMockWorktreeManager()
+			worktrees.CreatePath = setupTestWorktree(t)
+			clock := &testutil.MockClock{NowTime: now}
+			uc := NewStartTask(repo, testutil.NewMockSessionManager(), worktrees, testutil.NewMockConfigLoader(), &testutil.MockGit{}, clock, nil, testutil.NewMockScriptRunner(), t.TempDir(), t.TempDir())
+
+			// Execute
+			_, err := uc.Execute(context.Background(), StartTaskInput{
+				TaskID:   1,
+				Agent:    "claude",
+				Continue: tt.restart,
+				Restart:  tt.restart,
+			})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, domain.StatusInProgress, repo.Tasks[1].Status)
+			assert.Equal(t, tt.wantStarted, repo.Tasks[1].Started)
+			assert.Equal(t, tt.wantRestarts, repo.Tasks[1].RestartCount)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// Group keys for usage that cannot be attributed.
+const (
+	usageUnknownAgent = "(unknown)"
+	usageNoLabel      = "(no label)"
+)
+
+// StatsCostInput contains the parameters for aggregating agent usage.
+// Fields are ordered to minimize memory padding.
+type StatsCostInput struct {
+	Since time.Time           // Only count usage recorded at or after this time (zero = all)
+	By    domain.UsageGroupBy // Grouping (default: agent)
+}
+
+// CostGroup is the aggregated usage of one group.
+// Fields are ordered to minimize memory padding.
+type CostGroup struct {
+	Key      string       // Agent name, label, or task ID
+	Title    string       // Task title (task grouping only)
+	Usage    domain.Usage // Summed usage
+	Sessions int          // Number of sessions with recorded usage
+	Tasks    int          // Number of distinct tasks
+}
+
+// StatsCostOutput contains the aggregated usage.
+// Fields are ordered to minimize memory padding.
+type StatsCostOutput struct {
+	Groups []CostGroup // Groups, most expensive first
+	Total  CostGroup   // Usage of all sessions (a session is counted once even with several labels)
+}
+
+// StatsCost is the use case for reporting token and cost usage.
+type StatsCost struct {
+	tasks domain.TaskRepository
+}
+
+// NewStatsCost creates a new StatsCost use case.
+func NewStatsCost(tasks domain.TaskRepository) *StatsCost {
+	return &StatsCost{tasks: tasks}
+}
+
+// costAccumulator sums usage records into a group.
+type costAccumulator struct {
+	tasks map[int]bool
+	group CostGroup
+}
+
+func (a *costAccumulator) add(taskID int, record domain.UsageRecord) {
+	a.group.Usage.Add(record.Usage)
+	a.group.Sessions++
+	a.tasks[taskID] = true
+	a.group.Tasks = len(a.tasks)
+}
+
+// Execute aggregates the usage records attached to tasks.
+func (uc *StatsCost) Execute(_ context.Context, in StatsCostInput) (*StatsCostOutput, error) {
+	by := in.By
+	if by == "" {
+		by = domain.UsageByAgent
+	}
+	if !by.IsValid() {
+		return nil, fmt.Errorf("%w: %q (expected agent, label, or task)", domain.ErrInvalidUsageGroup, by)
+	}
+
+	tasks, err := uc.tasks.List(domain.TaskFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("list tasks: %w", err)
+	}
+
+	total := &costAccumulator{tasks: make(map[int]bool)}
+	groups := make(map[string]*costAccumulator)
+	group := func(key, title string) *costAccumulator {
+		acc, ok := groups[key]
+		if !ok {
+			acc = &costAccumulator{group: CostGroup{Key: key, Title: title}, tasks: make(map[int]bool)}
+			groups[key] = acc
+		}
+		return acc
+	}
+
+	for _, task := range tasks {
+		if task == nil {
+			continue
+		}
+		comments, err := uc.tasks.GetComments(task.ID)
+		if err != nil {
+			return nil, fmt.Errorf("get comments: %w", err)
+		}
+		for _, record := range domain.UsageRecords(comments) {
+			if !in.Since.IsZero() && record.Time.Before(in.Since) {
+				continue
+			}
+			total.add(task.ID, record)
+			switch by {
+			case domain.UsageByAgent:
+				agent := record.Agent
+				if agent == "" {
+					agent = usageUnknownAgent
+				}
+				group(agent, "").add(task.ID, record)
+			case domain.UsageByLabel:
+				if len(task.Labels) == 0 {
+					group(usageNoLabel, "").add(task.ID, record)
+				}
+				for _, label := range task.Labels {
+					group(label, "").add(task.ID, record)
+				}
+			case domain.UsageByTask:
+				group(strconv.Itoa(task.ID), task.Title).add(task.ID, record)
+			}
+		}
+	}
+
+	out := &StatsCostOutput{
+		Groups: make([]CostGroup, 0, len(groups)),
+		Total:  total.group,
+	}
+	for _, acc := range groups {
+		out.Groups = append(out.Groups, acc.group)
+	}
+	slices.SortFunc(out.Groups, func(a, b CostGroup) int {
+		if a.Usage.CostUSD != b.Usage.CostUSD {
+			if a.Usage.CostUSD > b.Usage.CostUSD {
+				return -1
+			}
+			return 1
+		}
+		if a.Usage.TotalTokens() != b.Usage.TotalTokens() {
+			if a.Usage.TotalTokens() > b.Usage.TotalTokens() {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Key, b.Key)
+	})
+	return out, nil
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newStatsCostRepo(base time.Time) *testutil.MockTaskRepository {
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{ID: 1, Title: "Fix typo", Status: domain.StatusDone, Labels: []string{"docs"}}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Redesign storage", Status: domain.StatusInProgress, Labels: []string{"backend", "docs"}}
+	repo.Tasks[3] = &domain.Task{ID: 3, Title: "Unlabeled", Status: domain.StatusTodo}
+
+	usage := func(agent string, at time.Time, cost float64, tokens int64) domain.Comment {
+		return domain.UsageRecord{
+			Time:  at,
+			Agent: agent,
+			Usage: domain.Usage{InputTokens: tokens, CostUSD: cost, HasCost: cost > 0},
+		}.Comment()
+	}
+	repo.Comments[1] = []domain.Comment{
+		{Text: "Done", Time: base},
+		usage("claude-fast", base, 0.10, 1000),
+	}
+	repo.Comments[2] = []domain.Comment{
+		usage("claude-architect", base.Add(-48*time.Hour), 2.00, 50000),
+		usage("claude-architect", base, 1.50, 40000),
+		usage("claude-fast", base, 0.20, 3000),
+	}
+	repo.Comments[3] = []domain.Comment{
+		usage("codex", base, 0, 7000),
+	}
+	return repo
+}
+
+func TestStatsCost_Execute_ByAgent(t *testing.T) {
+	// Setup
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	uc := NewStatsCost(newStatsCostRepo(base))
+
+	// Execute
+	out, err := uc.Execute(context.Background(), StatsCostInput{})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, out.Groups, 3)
+	assert.Equal(t, "claude-architect", out.Groups[0].Key)
+	assert.InDelta(t, 3.50, out.Groups[0].Usage.CostUSD, 1e-9)
+	assert.Equal(t, 2, out.Groups[0].Sessions)
+	assert.Equal(t, 1, out.Groups[0].Tasks)
+
+	assert.Equal(t, "claude-fast", out.Groups[1].Key)
+	assert.InDelta(t, 0.30, out.Groups[1].Usage.CostUSD, 1e-9)
+	assert.Equal(t, 2, out.Groups[1].Tasks)
+
+	assert.Equal(t, "codex", out.Groups[2].Key)
+	assert.False(t, out.Groups[2].Usage.HasCost)
+	assert.Equal(t, int64(7000), out.Groups[2].Usage.InputTokens)
+
+	assert.Equal(t, 5, out.Total.Sessions)
+	assert.Equal(t, 3, out.Total.Tasks)
+	assert.InDelta(t, 3.80, out.Total.Usage.CostUSD, 1e-9)
+}
+
+func TestStatsCost_Execute_ByLabelSince(t *testing.T) {
+	// Setup
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	uc := NewStatsCost(newStatsCostRepo(base))
+
+	// Execute
+	out, err := uc.Execute(context.Background(), StatsCostInput{
+		By:    domain.UsageByLabel,
+		Since: base.Add(-24 * time.Hour),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, out.Groups, 3)
+	assert.Equal(t, "docs", out.Groups[0].Key)
+	assert.InDelta(t, 1.80, out.Groups[0].Usage.CostUSD, 1e-9)
+	assert.Equal(t, 3, out.Groups[0].Sessions)
+	assert.Equal(t, "backend", out.Groups[1].Key)
+	assert.InDelta(t, 1.70, out.Groups[1].Usage.CostUSD, 1e-9)
+	assert.Equal(t, "(no label)", out.Groups[2].Key)
+
+	// A session of a task with two labels is counted once in the total
+	assert.Equal(t, 4, out.Total.Sessions)
+	assert.InDelta(t, 1.80, out.Total.Usage.CostUSD, 1e-9)
+}
+
+func TestStatsCost_Execute_ByTask(t *testing.T) {
+	// Setup
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	uc := NewStatsCost(newStatsCostRepo(base))
+
+	// Execute
+	out, err := uc.Execute(context.Background(), StatsCostInput{By: domain.UsageByTask})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, out.Groups, 3)
+	assert.Equal(t, "2", out.Groups[0].Key)
+	assert.Equal(t, "Redesign storage", out.Groups[0].Title)
+	assert.Equal(t, "1", out.Groups[1].Key)
+	assert.Equal(t, "3", out.Groups[2].Key)
+}
+
+func TestStatsCost_Execute_InvalidGrouping(t *testing.T) {
+	// Setup
+	uc := NewStatsCost(testutil.NewMockTaskRepository())
+
+	// Execute
+	_, err := uc.Execute(context.Background(), StatsCostInput{By: "model"})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrInvalidUsageGroup)
+}
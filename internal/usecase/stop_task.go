@@ -78,6 +78,7 @@ func (uc *StopTask) saveStoppedTask(task *domain.Task, sessionStopped string) (*
 	// Clear agent info
 	task.Agent = ""
 	task.Session = ""
+	task.Stalled = ""
 
 	// Save task
 	if err := uc.tasks.Save(task); err != nil {
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+)
+
+// eventFollowInterval is how often the event log is checked for new events when following.
+const eventFollowInterval = 500 * time.Millisecond
+
+// StreamEventsInput contains the parameters for streaming events.
+// Fields are ordered to minimize memory padding.
+type StreamEventsInput struct {
+	Types  []domain.EventType // Only stream events of these types (empty = all)
+	TaskID int                // Only stream events of this task (0 = all)
+	Tail   int                // Only write the last N existing events (0 = all)
+	Follow bool               // Keep streaming new events until the context is cancelled
+	JSON   bool               // Write events as JSON lines instead of text
+}
+
+// StreamEventsOutput contains the result of streaming events.
+type StreamEventsOutput struct {
+	Count int // Number of events written
+}
+
+// StreamEvents is the use case for writing task events from the event log.
+type StreamEvents struct {
+	events domain.EventLog
+	stdout io.Writer
+}
+
+// NewStreamEvents creates a new StreamEvents use case.
+func NewStreamEvents(events domain.EventLog, stdout io.Writer) *StreamEvents {
+	return &StreamEvents{
+		events: events,
+		stdout: stdout,
+	}
+}
+
+// Execute writes the matching events in the log.
+// With Follow set, it keeps writing new events until the context is cancelled.
+func (uc *StreamEvents) Execute(ctx context.Context, in StreamEventsInput) (*StreamEventsOutput, error) {
+	for _, eventType := range in.Types {
+		if !eventType.IsValid() {
+			return nil, fmt.Errorf("invalid event type: %q (expected one of %s)", eventType, joinEventTypes(domain.AllEventTypes()))
+		}
+	}
+
+	out := &StreamEventsOutput{}
+	var offset int64
+	history := true
+
+	ticker := time.NewTicker(eventFollowInterval)
+	defer ticker.Stop()
+
+	for {
+		events, next, err := uc.events.ReadFrom(offset)
+		if err != nil {
+			return out, fmt.Errorf("read events: %w", err)
+		}
+		offset = next
+
+		matched := make([]domain.Event, 0, len(events))
+		for _, event := range events {
+			if matchEvent(event, in) {
+				matched = append(matched, event)
+			}
+		}
+		// Tail only limits the history written before following
+		if history && in.Tail > 0 && len(matched) > in.Tail {
+			matched = matched[len(matched)-in.Tail:]
+		}
+		history = false
+		for _, event := range matched {
+			if err := uc.write(event, in.JSON); err != nil {
+				return out, err
+			}
+			out.Count++
+		}
+
+		if !in.Follow {
+			return out, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			// Context cancellation (Ctrl+C, SIGTERM) is the normal way to stop following
+			return out, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (uc *StreamEvents) write(event domain.Event, asJSON bool) error {
+	if asJSON {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("encode event: %w", err)
+		}
+		_, err = fmt.Fprintf(uc.stdout, "%s\n", data)
+		return err
+	}
+	_, err := fmt.Fprintln(uc.stdout, FormatEvent(event))
+	return err
+}
+
+// FormatEvent formats an event as a single human-readable line.
+// Example: 2026-01-18 10:00:00  #3  status  todo -> in_progress  (by alice)
+func FormatEvent(event domain.Event) string {
+	var change string
+	switch {
+	case event.Old != "" && event.New != "":
+		change = event.Old + " -> " + event.New
+	case event.New != "":
+		change = event.New
+	case event.Type == domain.EventStall:
+		change = event.Old + " -> resumed"
+	default:
+		change = event.Old
+	}
+
+	line := fmt.Sprintf("%s  #%d  %s  %s", event.Time.Local().Format(time.DateTime), event.TaskID, event.Type, change)
+	if event.Actor != "" {
+		line += "  (by " + event.Actor + ")"
+	}
+	return line
+}
+
+func matchEvent(event domain.Event, in StreamEventsInput) bool {
+	if in.TaskID != 0 && event.TaskID != in.TaskID {
+		return false
+	}
+	if len(in.Types) > 0 && !slices.Contains(in.Types, event.Type) {
+		return false
+	}
+	return true
+}
+
+func joinEventTypes(types []domain.EventType) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = string(t)
+	}
+	return strings.Join(names, ", ")
+}
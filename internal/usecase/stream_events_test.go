@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEventLog() *testutil.MockEventLog {
+	at := time.Date(2026, 1, 18, 10, 0, 0, 0, time.UTC)
+	events := testutil.NewMockEventLog()
+	events.Events = []domain.Event{
+		{Time: at, Type: domain.EventStatus, Actor: "alice", New: "todo", TaskID: 1},
+		{Time: at, Type: domain.EventStatus, Actor: "alice", Old: "todo", New: "in_progress", TaskID: 1},
+		{Time: at, Type: domain.EventSessionStart, Actor: "alice", New: "crew-1", TaskID: 1},
+		{Time: at, Type: domain.EventStatus, Actor: "alice", New: "todo", TaskID: 2},
+		{Time: at, Type: domain.EventReview, Actor: "worker", New: domain.ReviewEventLGTM, TaskID: 1},
+	}
+	return events
+}
+
+func TestStreamEvents_Execute_All(t *testing.T) {
+	// Setup
+	var stdout bytes.Buffer
+	uc := NewStreamEvents(newTestEventLog(), &stdout)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), StreamEventsInput{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 5, out.Count)
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	require.Len(t, lines, 5)
+	assert.Contains(t, lines[1], "#1  status  todo -> in_progress  (by alice)")
+}
+
+func TestStreamEvents_Execute_Filters(t *testing.T) {
+	// Setup
+	var stdout bytes.Buffer
+	uc := NewStreamEvents(newTestEventLog(), &stdout)
+
+	// Execute
+	out, err := uc.Execute(context.Background(), StreamEventsInput{
+		Types:  []domain.EventType{domain.EventStatus, domain.EventReview},
+		TaskID: 1,
+		Tail:   2,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, out.Count)
+	assert.Contains(t, stdout.String(), "todo -> in_progress")
+	assert.Contains(t, stdout.String(), "review  lgtm")
+	assert.NotContains(t, stdout.String(), "session_start")
+}
+
+func TestStreamEvents_Execute_JSON(t *testing.T) {
+	// Setup
+	var stdout bytes.Buffer
+	uc := NewStreamEvents(newTestEventLog(), &stdout)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), StreamEventsInput{TaskID: 2, JSON: true})
+
+	// Assert
+	require.NoError(t, err)
+	var event domain.Event
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &event))
+	assert.Equal(t, 2, event.TaskID)
+	assert.Equal(t, domain.EventStatus, event.Type)
+}
+
+func TestStreamEvents_Execute_InvalidType(t *testing.T) {
+	// Setup
+	uc := NewStreamEvents(newTestEventLog(), &bytes.Buffer{})
+
+	// Execute
+	_, err := uc.Execute(context.Background(), StreamEventsInput{Types: []domain.EventType{"bogus"}})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid event type")
+	assert.Contains(t, err.Error(), "session_start")
+}
+
+func TestStreamEvents_Execute_ReadError(t *testing.T) {
+	// Setup
+	events := testutil.NewMockEventLog()
+	events.ReadErr = errors.New("permission denied")
+	uc := NewStreamEvents(events, &bytes.Buffer{})
+
+	// Execute
+	_, err := uc.Execute(context.Background(), StreamEventsInput{})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "read events")
+}
+
+func TestStreamEvents_Execute_FollowStopsOnCancel(t *testing.T) {
+	// Setup
+	var stdout bytes.Buffer
+	uc := NewStreamEvents(newTestEventLog(), &stdout)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Execute
+	out, err := uc.Execute(ctx, StreamEventsInput{Follow: true, Tail: 1})
+
+	// Assert: history is written, then cancellation ends following without error
+	require.NoError(t, err)
+	assert.Equal(t, 1, out.Count)
+}
+
+func TestFormatEvent_StallResumed(t *testing.T) {
+	event := domain.Event{Time: time.Now(), Type: domain.EventStall, Old: string(domain.StallIdle), TaskID: 1}
+
+	assert.Contains(t, FormatEvent(event), "#1  stall  idle -> resumed")
+}
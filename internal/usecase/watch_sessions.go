@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/usecase/shared"
+)
+
+// watchPeekLines is the number of screen lines compared between polls.
+const watchPeekLines = 50
+
+// WatchSessionsInput contains the parameters for watching running sessions.
+// Zero values fall back to the [watch] config section.
+// Fields are ordered to minimize memory padding.
+type WatchSessionsInput struct {
+	Nudge             string // Message sent to sessions stalled on an unchanged screen (optional)
+	Interval          int    // Polling interval in seconds (optional)
+	IdleTimeout       int    // Seconds without screen changes before a session stalls (optional)
+	PermissionTimeout int    // Seconds in awaiting_permission before a session stalls (optional)
+}
+
+// WatchSessionsOutput contains the result of watching running sessions.
+type WatchSessionsOutput struct {
+	Stalled []int // IDs of tasks detected as stalled, in detection order
+}
+
+// WatchSessions is the use case for detecting stalled worker sessions.
+// A running session stalls when its screen has not changed for the idle timeout,
+// or when it has been awaiting permission for the permission timeout.
+// Stalled tasks are marked (which fires the on_stalled hook) and optionally
+// nudged with a configured message. The mark is cleared once the session moves again.
+// Fields are ordered to minimize memory padding.
+type WatchSessions struct {
+	tasks        domain.TaskRepository
+	sessions     domain.SessionManager
+	configLoader domain.ConfigLoader
+	clock        domain.Clock
+	logger       domain.Logger
+	stdout       io.Writer
+	activity     map[int]*domain.SessionActivity
+}
+
+// NewWatchSessions creates a new WatchSessions use case.
+func NewWatchSessions(
+	tasks domain.TaskRepository,
+	sessions domain.SessionManager,
+	configLoader domain.ConfigLoader,
+	clock domain.Clock,
+	logger domain.Logger,
+	stdout io.Writer,
+) *WatchSessions {
+	return &WatchSessions{
+		tasks:        tasks,
+		sessions:     sessions,
+		configLoader: configLoader,
+		clock:        clock,
+		logger:       logger,
+		stdout:       stdout,
+		activity:     make(map[int]*domain.SessionActivity),
+	}
+}
+
+// watchSettings holds the resolved watch settings for a run.
+// Fields are ordered to minimize memory padding.
+type watchSettings struct {
+	nudge             string
+	permissionNudge   string
+	interval          time.Duration
+	idleTimeout       time.Duration
+	permissionTimeout time.Duration
+}
+
+// Execute watches running sessions until the context is cancelled.
+func (uc *WatchSessions) Execute(ctx context.Context, in WatchSessionsInput) (*WatchSessionsOutput, error) {
+	cfg, err := uc.configLoader.Load()
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+
+	settings := resolveWatchSettings(cfg, in)
+	if settings.idleTimeout <= 0 && settings.permissionTimeout <= 0 {
+		return nil, domain.ErrNoStallTimeout
+	}
+
+	out := &WatchSessionsOutput{}
+	ticker := time.NewTicker(settings.interval)
+	defer ticker.Stop()
+
+	for {
+		stalled, err := uc.check(settings)
+		out.Stalled = append(out.Stalled, stalled...)
+		if err != nil {
+			return out, err
+		}
+
+		select {
+		case <-ctx.Done():
+			// Context cancellation (Ctrl+C, SIGTERM) is normal exit for the daemon
+			return out, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// resolveWatchSettings merges input overrides with the [watch] config.
+func resolveWatchSettings(cfg *domain.Config, in WatchSessionsInput) watchSettings {
+	interval := cfg.Watch.Interval
+	idle := cfg.Watch.IdleTimeout
+	permission := cfg.Watch.PermissionTimeout
+	settings := watchSettings{
+		nudge:           cfg.Watch.Nudge,
+		permissionNudge: cfg.Watch.PermissionNudge,
+	}
+	if in.Interval > 0 {
+		interval = in.Interval
+	}
+	if in.IdleTimeout > 0 {
+		idle = in.IdleTimeout
+	}
+	if in.PermissionTimeout > 0 {
+		permission = in.PermissionTimeout
+	}
+	if in.Nudge != "" {
+		settings.nudge = in.Nudge
+	}
+	if interval <= 0 {
+		interval = domain.DefaultWatchInterval
+	}
+	settings.interval = time.Duration(interval) * time.Second
+	settings.idleTimeout = time.Duration(idle) * time.Second
+	settings.permissionTimeout = time.Duration(permission) * time.Second
+	return settings
+}
+
+// check polls every running session once and returns the IDs of tasks that
+// stalled since the previous poll. Failing to handle an individual task is
+// logged and does not stop the pass.
+func (uc *WatchSessions) check(settings watchSettings) ([]int, error) {
+	tasks, err := uc.tasks.List(domain.TaskFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("list tasks: %w", err)
+	}
+
+	now := uc.clock.Now()
+	watched := make(map[int]bool)
+	var stalled []int
+	for _, t := range tasks {
+		if t.Status != domain.StatusInProgress || !t.IsRunning() {
+			continue
+		}
+		screen, err := uc.sessions.Peek(t.Session, watchPeekLines, false)
+		if err != nil {
+			// The session may have ended since the task was listed
+			continue
+		}
+		watched[t.ID] = true
+
+		activity, ok := uc.activity[t.ID]
+		if !ok {
+			activity = &domain.SessionActivity{}
+			uc.activity[t.ID] = activity
+		}
+		activity.Observe(t, screen, now)
+		reason := activity.Stalled(now, settings.idleTimeout, settings.permissionTimeout)
+		if reason == t.Stalled {
+			continue
+		}
+
+		if err := uc.mark(t.ID, reason); err != nil {
+			uc.warn(t.ID, fmt.Sprintf("failed to mark stalled: %v", err))
+			continue
+		}
+		if reason == "" {
+			uc.info(t.ID, "session resumed")
+			_, _ = fmt.Fprintf(uc.stdout, "Task #%d resumed\n", t.ID)
+			continue
+		}
+
+		stalled = append(stalled, t.ID)
+		uc.warn(t.ID, stallMessage(reason, now, activity))
+		_, _ = fmt.Fprintf(uc.stdout, "Task #%d stalled: %s\n", t.ID, stallMessage(reason, now, activity))
+		uc.nudge(t.ID, reason, settings)
+	}
+
+	// Forget sessions that are gone
+	for id := range uc.activity {
+		if !watched[id] {
+			delete(uc.activity, id)
+		}
+	}
+	return stalled, nil
+}
+
+// mark records the stall reason on the task, re-reading it first so that
+// changes made since it was listed are kept.
+func (uc *WatchSessions) mark(taskID int, reason domain.StallReason) error {
+	task, err := shared.GetTask(uc.tasks, taskID)
+	if err != nil {
+		return err
+	}
+	if reason != "" && !task.IsRunning() {
+		// The session ended in the meantime
+		return nil
+	}
+	task.Stalled = reason
+	if err := uc.tasks.Save(task); err != nil {
+		return fmt.Errorf("save task: %w", err)
+	}
+	return nil
+}
+
+// nudge sends the configured message for the stall reason, if any.
+func (uc *WatchSessions) nudge(taskID int, reason domain.StallReason, settings watchSettings) {
+	message := settings.nudge
+	if reason == domain.StallPermission {
+		message = settings.permissionNudge
+	}
+	if message == "" {
+		return
+	}
+	if err := shared.SendSessionNotification(uc.sessions, taskID, message); err != nil {
+		uc.warn(taskID, fmt.Sprintf("failed to nudge: %v", err))
+		return
+	}
+	uc.info(taskID, "nudged stalled session")
+}
+
+// stallMessage describes how long the session has been stalled.
+func stallMessage(reason domain.StallReason, now time.Time, activity *domain.SessionActivity) string {
+	if reason == domain.StallPermission {
+		return fmt.Sprintf("awaiting permission for %s", now.Sub(activity.SubstateChanged).Round(time.Second))
+	}
+	return fmt.Sprintf("screen unchanged for %s", now.Sub(activity.ScreenChanged).Round(time.Second))
+}
+
+func (uc *WatchSessions) info(taskID int, msg string) {
+	if uc.logger != nil {
+		uc.logger.Info(taskID, "watch", msg)
+	}
+}
+
+func (uc *WatchSessions) warn(taskID int, msg string) {
+	if uc.logger != nil {
+		uc.logger.Warn(taskID, "watch", msg)
+	}
+}
@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/runoshun/git-crew/v2/internal/domain"
+	"github.com/runoshun/git-crew/v2/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupWatchSessions creates a WatchSessions use case watching a running task #1.
+func setupWatchSessions(substate domain.ExecutionSubstate) (*WatchSessions, *testutil.MockTaskRepository, *testutil.MockSessionManager, *testutil.MockClock, *[]string) {
+	start := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	repo := testutil.NewMockTaskRepository()
+	repo.Tasks[1] = &domain.Task{
+		ID:                1,
+		Title:             "Test task",
+		Status:            domain.StatusInProgress,
+		Agent:             "claude",
+		Session:           "crew-1",
+		Started:           start,
+		ExecutionSubstate: substate,
+	}
+	repo.Tasks[2] = &domain.Task{ID: 2, Title: "Not running", Status: domain.StatusTodo}
+
+	var sent []string
+	sessions := testutil.NewMockSessionManager()
+	sessions.IsRunningVal = true
+	sessions.PeekOutput = "working"
+	sessions.SendFunc = func(_ string, keys string) error {
+		sent = append(sent, keys)
+		return nil
+	}
+	clock := &testutil.MockClock{NowTime: start}
+	uc := NewWatchSessions(repo, sessions, testutil.NewMockConfigLoader(), clock, nil, io.Discard)
+	return uc, repo, sessions, clock, &sent
+}
+
+func TestWatchSessions_Execute_NoTimeout(t *testing.T) {
+	// Setup
+	uc, _, sessions, _, _ := setupWatchSessions(domain.SubstateRunning)
+
+	// Execute
+	_, err := uc.Execute(context.Background(), WatchSessionsInput{})
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrNoStallTimeout)
+	assert.False(t, sessions.PeekCalled)
+}
+
+func TestWatchSessions_Execute_StopsOnCancel(t *testing.T) {
+	// Setup
+	uc, repo, sessions, _, _ := setupWatchSessions(domain.SubstateRunning)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Execute
+	out, err := uc.Execute(ctx, WatchSessionsInput{IdleTimeout: 60})
+
+	// Assert - a single pass is made
+	require.NoError(t, err)
+	assert.Empty(t, out.Stalled)
+	assert.True(t, sessions.PeekCalled)
+	assert.Empty(t, repo.Tasks[1].Stalled)
+}
+
+func TestWatchSessions_Check_IdleStallAndResume(t *testing.T) {
+	// Setup
+	uc, repo, sessions, clock, sent := setupWatchSessions(domain.SubstateRunning)
+	settings := watchSettings{idleTimeout: 10 * time.Minute, nudge: "Keep going"}
+
+	// Execute - the screen does not change for 10 minutes
+	stalled, err := uc.check(settings)
+	require.NoError(t, err)
+	assert.Empty(t, stalled)
+	clock.NowTime = clock.NowTime.Add(10 * time.Minute)
+	stalled, err = uc.check(settings)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, stalled)
+	assert.Equal(t, domain.StallIdle, repo.Tasks[1].Stalled)
+	assert.Equal(t, []string{"Keep going", "Enter"}, *sent)
+
+	// Execute - already stalled: no new detection and no new nudge
+	clock.NowTime = clock.NowTime.Add(time.Minute)
+	stalled, err = uc.check(settings)
+	require.NoError(t, err)
+	assert.Empty(t, stalled)
+	assert.Len(t, *sent, 2)
+
+	// Execute - the screen changes
+	sessions.PeekOutput = "working again"
+	_, err = uc.check(settings)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, repo.Tasks[1].Stalled)
+}
+
+func TestWatchSessions_Check_RestartKeepsStallMark(t *testing.T) {
+	// Setup - a previous watcher marked the task stalled
+	uc, repo, sessions, clock, sent := setupWatchSessions(domain.SubstateRunning)
+	repo.Tasks[1].Stalled = domain.StallIdle
+	settings := watchSettings{idleTimeout: 10 * time.Minute, nudge: "Keep going"}
+
+	// Execute - the new watcher sees the same screen
+	stalled, err := uc.check(settings)
+	require.NoError(t, err)
+	clock.NowTime = clock.NowTime.Add(time.Minute)
+	stalled2, err := uc.check(settings)
+	require.NoError(t, err)
+
+	// Assert - the mark is kept and not reported again
+	assert.Empty(t, stalled)
+	assert.Empty(t, stalled2)
+	assert.Equal(t, domain.StallIdle, repo.Tasks[1].Stalled)
+	assert.Empty(t, *sent)
+
+	// Execute - the screen changes
+	sessions.PeekOutput = "working again"
+	_, err = uc.check(settings)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, repo.Tasks[1].Stalled)
+}
+
+func TestWatchSessions_Check_PermissionStall(t *testing.T) {
+	// Setup
+	uc, repo, _, clock, sent := setupWatchSessions(domain.SubstateAwaitingPermission)
+	settings := watchSettings{
+		idleTimeout:       time.Hour,
+		permissionTimeout: 5 * time.Minute,
+		nudge:             "Keep going",
+	}
+
+	// Execute
+	_, err := uc.check(settings)
+	require.NoError(t, err)
+	clock.NowTime = clock.NowTime.Add(5 * time.Minute)
+	stalled, err := uc.check(settings)
+
+	// Assert - no permission_nudge configured, so nothing is typed into the prompt
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, stalled)
+	assert.Equal(t, domain.StallPermission, repo.Tasks[1].Stalled)
+	assert.Empty(t, *sent)
+}
+
+func TestWatchSessions_Check_SkipsEndedSessions(t *testing.T) {
+	// Setup
+	uc, repo, sessions, clock, _ := setupWatchSessions(domain.SubstateRunning)
+	settings := watchSettings{idleTimeout: time.Minute}
+	_, err := uc.check(settings)
+	require.NoError(t, err)
+
+	// Execute - the session is gone
+	sessions.PeekErr = domain.ErrNoSession
+	clock.NowTime = clock.NowTime.Add(time.Hour)
+	stalled, err := uc.check(settings)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, stalled)
+	assert.Empty(t, repo.Tasks[1].Stalled)
+	assert.Empty(t, uc.activity)
+}
+
+func TestResolveWatchSettings(t *testing.T) {
+	cfg := domain.NewDefaultConfig()
+	cfg.Watch.IdleTimeout = 600
+	cfg.Watch.Nudge = "from config"
+	cfg.Watch.PermissionNudge = "1"
+
+	settings := resolveWatchSettings(cfg, WatchSessionsInput{PermissionTimeout: 120, Nudge: "from flag"})
+
+	assert.Equal(t, watchSettings{
+		nudge:             "from flag",
+		permissionNudge:   "1",
+		interval:          domain.DefaultWatchInterval * time.Second,
+		idleTimeout:       10 * time.Minute,
+		permissionTimeout: 2 * time.Minute,
+	}, settings)
+}